@@ -33,6 +33,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/scanoss/scanoss.cc/backend/entities"
 	"github.com/scanoss/scanoss.cc/backend/service"
+	"github.com/scanoss/scanoss.cc/internal/archive"
 	"github.com/scanoss/scanoss.cc/internal/config"
 	"github.com/scanoss/scanoss.cc/internal/utils"
 	"github.com/wailsapp/wails/v2/pkg/menu"
@@ -107,7 +108,7 @@ func (a *App) BeforeClose(ctx context.Context) (prevent bool) {
 	return false
 }
 
-func (a *App) BuildMenu(keyboardService service.KeyboardService) *menu.Menu {
+func (a *App) BuildMenu(keyboardService service.KeyboardService, cryptographyService service.CryptographyService) *menu.Menu {
 	AppMenu := menu.NewMenu()
 
 	if goRuntime.GOOS == "darwin" {
@@ -132,6 +133,11 @@ func (a *App) BuildMenu(keyboardService service.KeyboardService) *menu.Menu {
 		})
 	}
 
+	FileMenu.AddSeparator()
+	FileMenu.AddText("Export Crypto Inventory (CBOM)...", nil, func(cd *menu.CallbackData) {
+		a.exportCBOM(cryptographyService)
+	})
+
 	// Actions menu with submenus
 	ActionsMenu := AppMenu.AddSubmenu("Actions")
 
@@ -214,6 +220,32 @@ func (a *App) BuildMenu(keyboardService service.KeyboardService) *menu.Menu {
 	return AppMenu
 }
 
+func (a *App) exportCBOM(cryptographyService service.CryptographyService) {
+	filePath, err := runtime.SaveFileDialog(a.ctx, runtime.SaveDialogOptions{
+		Title:            "Export Crypto Inventory",
+		DefaultDirectory: a.cfg.GetScanRoot(),
+		DefaultFilename:  "cbom.json",
+		Filters:          []runtime.FileFilter{{DisplayName: "CycloneDX JSON (*.json)", Pattern: "*.json"}},
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("Error selecting CBOM export file")
+		return
+	}
+	if filePath == "" {
+		return
+	}
+
+	if err := cryptographyService.ExportCBOM(filePath); err != nil {
+		if _, dialogErr := runtime.MessageDialog(a.ctx, runtime.MessageDialogOptions{
+			Type:    runtime.ErrorDialog,
+			Title:   "Export Failed",
+			Message: err.Error(),
+		}); dialogErr != nil {
+			log.Error().Err(dialogErr).Msg("Error showing dialog")
+		}
+	}
+}
+
 func (a *App) SelectDirectory() (string, error) {
 	dirPath, err := runtime.OpenDirectoryDialog(a.ctx, runtime.OpenDialogOptions{
 		Title:                "Select Directory",
@@ -230,6 +262,23 @@ func (a *App) SelectDirectory() (string, error) {
 	return dirPath, nil
 }
 
+// SelectArchive lets the user pick a source archive to use as scan root.
+func (a *App) SelectArchive() (string, error) {
+	filePath, err := runtime.OpenFileDialog(a.ctx, runtime.OpenDialogOptions{
+		Title:            "Select Archive",
+		DefaultDirectory: filepath.Dir(a.cfg.GetScanRoot()),
+		Filters: []runtime.FileFilter{
+			{DisplayName: "Source archives", Pattern: archive.FilePatterns},
+		},
+	})
+	if err != nil {
+		log.Error().Err(err).Msgf("error selecting archive %v", err.Error())
+		return "", err
+	}
+
+	return filePath, nil
+}
+
 func (a *App) SelectFile(defaultDir string) (string, error) {
 	filePath, err := runtime.OpenFileDialog(a.ctx, runtime.OpenDialogOptions{
 		Title:                "Select File",
@@ -253,6 +302,11 @@ func (a *App) GetScanRoot() (string, error) {
 	return a.cfg.GetScanRoot(), nil
 }
 
+// GetScanner returns the scan engine selected with --scanner, so the scan dialog calls the matching service.
+func (a *App) GetScanner() string {
+	return a.cfg.GetScanner()
+}
+
 func (a *App) GetRecentScanRoots() ([]string, error) {
 	return a.cfg.GetRecentScanRoots(), nil
 }
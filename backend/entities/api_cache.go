@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+var ErrAPICacheMiss = errors.New("not in the API cache")
+
+// APICacheEntry is a cached API response, keyed by the URL it was fetched from.
+type APICacheEntry struct {
+	Endpoint  string    `json:"endpoint"`
+	Key       string    `json:"key"`
+	StoredAt  time.Time `json:"stored_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Body      string    `json:"body"`
+}
+
+func (e APICacheEntry) IsExpired(now time.Time) bool {
+	return !now.Before(e.ExpiresAt)
+}
+
+// APICacheStats describes what the API cache holds on disk.
+type APICacheStats struct {
+	Folder       string         `json:"folder"`
+	Entries      int            `json:"entries"`
+	Expired      int            `json:"expired"`
+	SizeBytes    int64          `json:"size_bytes"`
+	MaxSizeBytes int64          `json:"max_size_bytes"` // 0 for no limit
+	Endpoints    map[string]int `json:"endpoints"`      // Entries by endpoint
+}
+
+func (s APICacheStats) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Folder: %s\n", s.Folder)
+	fmt.Fprintf(&b, "Entries: %d (%d expired)\n", s.Entries, s.Expired)
+	if s.MaxSizeBytes > 0 {
+		fmt.Fprintf(&b, "Size: %s of %s\n", formatBytes(s.SizeBytes), formatBytes(s.MaxSizeBytes))
+	} else {
+		fmt.Fprintf(&b, "Size: %s\n", formatBytes(s.SizeBytes))
+	}
+	for _, endpoint := range slices.Sorted(maps.Keys(s.Endpoints)) {
+		fmt.Fprintf(&b, "  %s: %d\n", endpoint, s.Endpoints[endpoint])
+	}
+	return b.String()
+}
+
+func formatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const CBOMSpecVersion = "1.6"
+
+// cryptoPrimitives maps well known algorithms to their CycloneDX cryptographic primitive.
+var cryptoPrimitives = map[string]string{
+	"aes":      "block-cipher",
+	"des":      "block-cipher",
+	"3des":     "block-cipher",
+	"blowfish": "block-cipher",
+	"twofish":  "block-cipher",
+	"camellia": "block-cipher",
+	"rc4":      "stream-cipher",
+	"chacha20": "stream-cipher",
+	"salsa20":  "stream-cipher",
+	"md4":      "hash",
+	"md5":      "hash",
+	"sha1":     "hash",
+	"sha224":   "hash",
+	"sha256":   "hash",
+	"sha384":   "hash",
+	"sha512":   "hash",
+	"sha3":     "hash",
+	"blake2":   "hash",
+	"ripemd":   "hash",
+	"hmac":     "mac",
+	"rsa":      "pke",
+	"elgamal":  "pke",
+	"dsa":      "signature",
+	"ecdsa":    "signature",
+	"ed25519":  "signature",
+	"dh":       "key-agree",
+	"ecdh":     "key-agree",
+	"x25519":   "key-agree",
+	"pbkdf2":   "kdf",
+	"hkdf":     "kdf",
+	"bcrypt":   "kdf",
+	"scrypt":   "kdf",
+}
+
+// CBOM is a CycloneDX document listing the cryptographic assets of the scanned project.
+// Each component declares the algorithms found in its files through the dependency graph.
+type CBOM struct {
+	BomFormat    string           `json:"bomFormat"`
+	SpecVersion  string           `json:"specVersion"`
+	SerialNumber string           `json:"serialNumber"`
+	Version      int              `json:"version"`
+	Metadata     CBOMMetadata     `json:"metadata"`
+	Components   []CBOMComponent  `json:"components"`
+	Dependencies []CBOMDependency `json:"dependencies"`
+}
+
+type CBOMMetadata struct {
+	Timestamp string `json:"timestamp"`
+	Tools     struct {
+		Components []CBOMComponent `json:"components"`
+	} `json:"tools"`
+}
+
+type CBOMComponent struct {
+	Type             string                `json:"type"`
+	BomRef           string                `json:"bom-ref,omitempty"`
+	Name             string                `json:"name"`
+	Version          string                `json:"version,omitempty"`
+	Purl             string                `json:"purl,omitempty"`
+	CryptoProperties *CBOMCryptoProperties `json:"cryptoProperties,omitempty"`
+	Evidence         *CBOMEvidence         `json:"evidence,omitempty"`
+}
+
+type CBOMCryptoProperties struct {
+	AssetType           string                  `json:"assetType"`
+	AlgorithmProperties CBOMAlgorithmProperties `json:"algorithmProperties"`
+}
+
+type CBOMAlgorithmProperties struct {
+	Primitive string `json:"primitive,omitempty"`
+	// ParameterSetIdentifier holds the key or digest size, e.g. "256" for AES-256
+	ParameterSetIdentifier string `json:"parameterSetIdentifier,omitempty"`
+}
+
+type CBOMEvidence struct {
+	Occurrences []CBOMOccurrence `json:"occurrences"`
+}
+
+type CBOMOccurrence struct {
+	Location string `json:"location"`
+}
+
+type CBOMDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+// NewCBOM builds a CycloneDX CBOM from the crypto inventory. Algorithms found in several
+// components are declared once, with the files of every component as occurrences.
+func NewCBOM(inventory []CryptographyInventoryItem, serialNumber string, timestamp time.Time) CBOM {
+	cbom := CBOM{
+		BomFormat:    "CycloneDX",
+		SpecVersion:  CBOMSpecVersion,
+		SerialNumber: "urn:uuid:" + serialNumber,
+		Version:      1,
+		Components:   []CBOMComponent{},
+		Dependencies: []CBOMDependency{},
+	}
+	cbom.Metadata.Timestamp = timestamp.UTC().Format(time.RFC3339)
+	cbom.Metadata.Tools.Components = []CBOMComponent{{Type: "application", Name: "scanoss-cc", Version: AppVersion}}
+
+	assets := make(map[string]int)
+	for _, item := range inventory {
+		cbom.Components = append(cbom.Components, CBOMComponent{
+			Type:    "library",
+			BomRef:  item.Purl,
+			Name:    item.Component,
+			Version: item.Version,
+			Purl:    item.Purl,
+		})
+
+		dependency := CBOMDependency{Ref: item.Purl, DependsOn: []string{}}
+		for _, asset := range item.Assets {
+			ref := asset.bomRef()
+			dependency.DependsOn = append(dependency.DependsOn, ref)
+
+			index, ok := assets[ref]
+			if !ok {
+				index = len(cbom.Components)
+				assets[ref] = index
+				cbom.Components = append(cbom.Components, asset.toCBOMComponent())
+			}
+			for _, file := range asset.Files {
+				cbom.Components[index].Evidence.Occurrences = append(cbom.Components[index].Evidence.Occurrences, CBOMOccurrence{Location: file})
+			}
+		}
+		cbom.Dependencies = append(cbom.Dependencies, dependency)
+	}
+
+	return cbom
+}
+
+func (a CryptographyAsset) bomRef() string {
+	if a.Strength > 0 {
+		return fmt.Sprintf("crypto/algorithm/%s@%d", a.Algorithm, a.Strength)
+	}
+	return "crypto/algorithm/" + a.Algorithm
+}
+
+func (a CryptographyAsset) toCBOMComponent() CBOMComponent {
+	return CBOMComponent{
+		Type:   "cryptographic-asset",
+		BomRef: a.bomRef(),
+		Name:   a.Algorithm,
+		CryptoProperties: &CBOMCryptoProperties{
+			AssetType: "algorithm",
+			AlgorithmProperties: CBOMAlgorithmProperties{
+				Primitive:              cryptoPrimitives[a.Algorithm],
+				ParameterSetIdentifier: parameterSetIdentifier(a.Strength),
+			},
+		},
+		Evidence: &CBOMEvidence{Occurrences: []CBOMOccurrence{}},
+	}
+}
+
+func parameterSetIdentifier(strength int) string {
+	if strength <= 0 {
+		return ""
+	}
+	return strconv.Itoa(strength)
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DefaultStaleComponentYears is the number of years without a push after which
+// a component is flagged as stale.
+const DefaultStaleComponentYears = 2
+
+type ComponentHealth struct {
+	CreationDate string `json:"creation_date"`
+	LastUpdate   string `json:"last_update"`
+	LastPush     string `json:"last_push"`
+	Stars        int    `json:"stars"`
+	Issues       int    `json:"issues"`
+	Forks        int    `json:"forks"`
+}
+
+type ComponentCopyright struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+}
+
+// ComponentIndicators are derived from the health and copyright data so the
+// UI doesn't need to recompute them.
+type ComponentIndicators struct {
+	YearsSinceLastPush         int  `json:"years_since_last_push,omitempty"`
+	IsStale                    bool `json:"is_stale"`
+	CopyrightDiffersFromVendor bool `json:"copyright_differs_from_vendor"`
+}
+
+var healthDateLayouts = []string{
+	time.DateOnly,
+	time.RFC3339,
+	time.DateTime,
+}
+
+// GetLastPush returns the parsed last push date of the component repository.
+func (c *Component) GetLastPush() (time.Time, bool) {
+	value := strings.TrimSpace(c.Health.LastPush)
+	if value == "" {
+		return time.Time{}, false
+	}
+
+	for _, layout := range healthDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// YearsSinceLastPush returns the number of full years elapsed since the last
+// push, or -1 if the component has no known last push.
+func (c *Component) YearsSinceLastPush(now time.Time) int {
+	lastPush, ok := c.GetLastPush()
+	if !ok {
+		return -1
+	}
+
+	years := now.Year() - lastPush.Year()
+	if now.YearDay() < lastPush.YearDay() {
+		years--
+	}
+	if years < 0 {
+		return 0
+	}
+
+	return years
+}
+
+// IsLastPushOlderThan reports whether the last push happened more than the
+// given number of years before now.
+func (c *Component) IsLastPushOlderThan(years int, now time.Time) bool {
+	lastPush, ok := c.GetLastPush()
+	if !ok {
+		return false
+	}
+
+	return lastPush.Before(now.AddDate(-years, 0, 0))
+}
+
+// HasCopyrightDifferentFromVendor reports whether any copyright holder does not
+// mention the component vendor, which usually means third party code was
+// vendored into the component.
+func (c *Component) HasCopyrightDifferentFromVendor() bool {
+	vendor := normalizeHolder(c.Vendor)
+	if vendor == "" {
+		return false
+	}
+
+	for _, copyright := range c.Copyrights {
+		holder := normalizeHolder(copyright.Name)
+		if holder != "" && !strings.Contains(holder, vendor) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Component) GetIndicators(now time.Time) ComponentIndicators {
+	indicators := ComponentIndicators{
+		IsStale:                    c.IsLastPushOlderThan(DefaultStaleComponentYears, now),
+		CopyrightDiffersFromVendor: c.HasCopyrightDifferentFromVendor(),
+	}
+
+	if years := c.YearsSinceLastPush(now); years > 0 {
+		indicators.YearsSinceLastPush = years
+	}
+
+	return indicators
+}
+
+func normalizeHolder(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, value)
+}
@@ -0,0 +1,88 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComponent_Indicators(t *testing.T) {
+	now := time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		component Component
+		expected  ComponentIndicators
+	}{
+		{
+			name: "recently pushed, copyright held by vendor",
+			component: Component{
+				Vendor:     "facebook",
+				Health:     ComponentHealth{LastPush: "2026-02-10"},
+				Copyrights: []ComponentCopyright{{Name: "Copyright (c) Facebook, Inc. and its affiliates."}},
+			},
+			expected: ComponentIndicators{},
+		},
+		{
+			name: "stale component with third party copyright",
+			component: Component{
+				Vendor:     "scanoss",
+				Health:     ComponentHealth{LastPush: "2021-09-30T10:00:00Z"},
+				Copyrights: []ComponentCopyright{{Name: "SCANOSS"}, {Name: "Copyright 2009 The Go Authors"}},
+			},
+			expected: ComponentIndicators{
+				YearsSinceLastPush:         4,
+				IsStale:                    true,
+				CopyrightDiffersFromVendor: true,
+			},
+		},
+		{
+			name: "missing health and vendor",
+			component: Component{
+				Copyrights: []ComponentCopyright{{Name: "Someone"}},
+			},
+			expected: ComponentIndicators{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.component.GetIndicators(now))
+		})
+	}
+}
+
+func TestComponent_IsLastPushOlderThan(t *testing.T) {
+	now := time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC)
+	component := Component{Health: ComponentHealth{LastPush: "2023-05-31"}}
+
+	assert.True(t, component.IsLastPushOlderThan(3, now))
+	assert.False(t, component.IsLastPushOlderThan(4, now))
+	assert.False(t, (&Component{}).IsLastPushOlderThan(1, now))
+}
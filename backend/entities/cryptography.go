@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Cryptography is a cryptographic algorithm detected in a matched file, as reported by the
+// SCANOSS engine in the "cryptography" field of each match.
+type Cryptography struct {
+	Algorithm string `json:"algorithm"`
+	// Strength is the key or digest size in bits, 0 when unknown
+	Strength int    `json:"strength,omitempty"`
+	Category string `json:"category,omitempty"`
+}
+
+// UnmarshalJSON accepts the strength either as a number or as the string the engine emits, e.g. "256".
+func (c *Cryptography) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Algorithm string          `json:"algorithm"`
+		Strength  json.RawMessage `json:"strength"`
+		Category  string          `json:"category"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	c.Algorithm = strings.ToLower(strings.TrimSpace(raw.Algorithm))
+	c.Category = raw.Category
+	c.Strength = parseCryptoStrength(raw.Strength)
+	return nil
+}
+
+func parseCryptoStrength(raw json.RawMessage) int {
+	if len(raw) == 0 {
+		return 0
+	}
+
+	var number float64
+	if err := json.Unmarshal(raw, &number); err == nil {
+		return int(number)
+	}
+
+	var text string
+	if err := json.Unmarshal(raw, &text); err != nil {
+		return 0
+	}
+	strength, err := strconv.Atoi(strings.TrimSpace(text))
+	if err != nil {
+		return 0
+	}
+	return strength
+}
+
+func (c Cryptography) key() string {
+	return c.Algorithm + "@" + strconv.Itoa(c.Strength)
+}
+
+// GetCryptography returns the algorithms detected in any match of the result, without duplicates.
+func (r *Result) GetCryptography() []Cryptography {
+	var cryptography []Cryptography
+	seen := make(map[string]struct{})
+
+	for _, match := range r.Matches {
+		for _, c := range match.Cryptography {
+			if _, ok := seen[c.key()]; ok {
+				continue
+			}
+			seen[c.key()] = struct{}{}
+			cryptography = append(cryptography, c)
+		}
+	}
+
+	return cryptography
+}
+
+func (r *Result) HasCryptography() bool {
+	for _, match := range r.Matches {
+		if len(match.Cryptography) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetCryptoAlgorithms returns the sorted names of the algorithms detected in the result.
+func (r *Result) GetCryptoAlgorithms() []string {
+	var algorithms []string
+	for _, c := range r.GetCryptography() {
+		if !slices.Contains(algorithms, c.Algorithm) {
+			algorithms = append(algorithms, c.Algorithm)
+		}
+	}
+	sort.Strings(algorithms)
+	return algorithms
+}
+
+type RequestCryptographyDTO struct {
+	Algorithm   string `json:"algorithm,omitempty"`
+	MinStrength int    `json:"min_strength,omitempty" validate:"omitempty,min=1"`
+}
+
+// Matches reports whether a detected algorithm passes the request filters. A nil request matches everything.
+func (dto *RequestCryptographyDTO) Matches(c Cryptography) bool {
+	if dto == nil {
+		return true
+	}
+	if dto.Algorithm != "" && !strings.EqualFold(dto.Algorithm, c.Algorithm) {
+		return false
+	}
+	return c.Strength >= dto.MinStrength
+}
+
+// CryptographyAsset is an algorithm of the crypto inventory, with the files where it was found.
+type CryptographyAsset struct {
+	Cryptography
+	Files []string `json:"files"`
+}
+
+// CryptographyInventoryItem groups the cryptographic assets detected in the files matched to a component.
+type CryptographyInventoryItem struct {
+	Purl      string              `json:"purl"`
+	Component string              `json:"component"`
+	Version   string              `json:"version,omitempty"`
+	Assets    []CryptographyAsset `json:"assets"`
+}
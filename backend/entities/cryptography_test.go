@@ -0,0 +1,82 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCryptography_UnmarshalJSON(t *testing.T) {
+	var component Component
+	err := json.Unmarshal([]byte(`{"id": "file", "cryptography": [
+		{"algorithm": "AES", "strength": "256"},
+		{"algorithm": "sha1", "strength": 160},
+		{"algorithm": "crc32", "strength": ""}
+	]}`), &component)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Cryptography{
+		{Algorithm: "aes", Strength: 256},
+		{Algorithm: "sha1", Strength: 160},
+		{Algorithm: "crc32"},
+	}, component.Cryptography)
+}
+
+func TestResultFilterCryptography(t *testing.T) {
+	result := Result{Matches: []Component{
+		{Cryptography: []Cryptography{{Algorithm: "md5", Strength: 128}}},
+		{Cryptography: []Cryptography{{Algorithm: "rsa", Strength: 2048}, {Algorithm: "md5", Strength: 128}}},
+	}}
+
+	assert.Len(t, result.GetCryptography(), 2)
+	assert.Equal(t, []string{"md5", "rsa"}, result.GetCryptoAlgorithms())
+
+	tests := []struct {
+		name     string
+		dto      RequestResultDTO
+		expected bool
+	}{
+		{name: "has cryptography", dto: RequestResultDTO{HasCryptography: true}, expected: true},
+		{name: "algorithm is case insensitive", dto: RequestResultDTO{CryptoAlgorithm: "RSA"}, expected: true},
+		{name: "unknown algorithm", dto: RequestResultDTO{CryptoAlgorithm: "aes"}, expected: false},
+		{name: "strong enough", dto: RequestResultDTO{MinCryptoStrength: 1024}, expected: true},
+		{name: "algorithm and strength must match together", dto: RequestResultDTO{CryptoAlgorithm: "md5", MinCryptoStrength: 1024}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := NewResultFilterFactory().Create(&tt.dto)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, filter.IsValid(result))
+		})
+	}
+
+	assert.False(t, NewResultFilterCryptography("", 0).IsValid(Result{}))
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import "strings"
+
+// Dependency is a single package declared in a manifest file, as reported by
+// scanoss-py when scanning with --dependencies.
+type Dependency struct {
+	Component   string              `json:"component"`
+	Purl        string              `json:"purl"`
+	Requirement string              `json:"requirement,omitempty"`
+	Version     string              `json:"version,omitempty"`
+	Scope       string              `json:"scope,omitempty"`
+	URL         string              `json:"url,omitempty"`
+	Licenses    []DependencyLicense `json:"licenses,omitempty"`
+}
+
+type DependencyLicense struct {
+	Name           string `json:"name"`
+	SpdxID         string `json:"spdx_id,omitempty"`
+	IsSpdxApproved bool   `json:"is_spdx_approved,omitempty"`
+	URL            string `json:"url,omitempty"`
+}
+
+// DeclaredDependency ties a dependency to the manifest file that declares it.
+type DeclaredDependency struct {
+	DeclaredFile string
+	Dependency
+}
+
+// ToResult builds a synthetic result so BOM entries can be matched against a
+// declared dependency the same way they are matched against file results.
+func (d DeclaredDependency) ToResult() Result {
+	purls := []string{d.Purl}
+	return Result{
+		Path:          d.DeclaredFile,
+		MatchType:     MatchTypeDependency,
+		Purl:          &purls,
+		ComponentName: d.Component,
+	}
+}
+
+type DependencyDTO struct {
+	DeclaredFile     string              `json:"declared_file"`
+	Component        string              `json:"component"`
+	Purl             string              `json:"purl"`
+	PurlUrl          string              `json:"purl_url,omitempty"`
+	Requirement      string              `json:"requirement,omitempty"`
+	Version          string              `json:"version,omitempty"`
+	Scope            string              `json:"scope,omitempty"`
+	URL              string              `json:"url,omitempty"`
+	Licenses         []DependencyLicense `json:"licenses,omitempty"`
+	WorkflowState    WorkflowState       `json:"workflow_state,omitempty"`
+	FilterConfig     FilterConfig        `json:"filter_config,omitempty"`
+	Comment          string              `json:"comment,omitempty"`
+	ConcludedPurl    string              `json:"concluded_purl,omitempty"`
+	ConcludedPurlUrl string              `json:"concluded_purl_url,omitempty"`
+	ConcludedName    string              `json:"concluded_name,omitempty"`
+}
+
+type RequestDependencyDTO struct {
+	Query         string        `json:"query,omitempty"`
+	Scope         string        `json:"scope,omitempty"`
+	WorkflowState WorkflowState `json:"workflow_state,omitempty" validate:"omitempty,eq=pending|eq=completed"`
+}
+
+// Matches reports whether the dependency satisfies the request filters.
+func (r *RequestDependencyDTO) Matches(dto DependencyDTO) bool {
+	if r == nil {
+		return true
+	}
+
+	if r.Scope != "" && !strings.EqualFold(r.Scope, dto.Scope) {
+		return false
+	}
+
+	if r.WorkflowState != "" && r.WorkflowState != dto.WorkflowState {
+		return false
+	}
+
+	if r.Query != "" {
+		query := strings.ToLower(r.Query)
+		return strings.Contains(strings.ToLower(dto.DeclaredFile), query) ||
+			strings.Contains(strings.ToLower(dto.Purl), query) ||
+			strings.Contains(strings.ToLower(dto.Component), query)
+	}
+
+	return true
+}
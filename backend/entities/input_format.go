@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// InputFormat identifies the format of the results file loaded by the app.
+type InputFormat string
+
+const (
+	InputFormatAuto      InputFormat = "auto"
+	InputFormatScanoss   InputFormat = "scanoss"
+	InputFormatCycloneDX InputFormat = "cyclonedx"
+	InputFormatSPDX      InputFormat = "spdx"
+	InputFormatScanCode  InputFormat = "scancode"
+)
+
+var ErrUnsupportedInputFormat = errors.New("unsupported input format")
+
+var SupportedInputFormats = []InputFormat{
+	InputFormatAuto,
+	InputFormatScanoss,
+	InputFormatCycloneDX,
+	InputFormatSPDX,
+	InputFormatScanCode,
+}
+
+// ParseInputFormat validates a user supplied format name. An empty value means auto detection.
+func ParseInputFormat(value string) (InputFormat, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return InputFormatAuto, nil
+	}
+
+	for _, format := range SupportedInputFormats {
+		if string(format) == value {
+			return format, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrUnsupportedInputFormat, value)
+}
+
+// DetectInputFormat sniffs the top level keys of a results file to guess its format.
+// Anything that is not recognised as CycloneDX, SPDX or ScanCode is treated as SCANOSS output.
+func DetectInputFormat(data []byte) InputFormat {
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(data, &document); err != nil {
+		return InputFormatScanoss
+	}
+
+	if raw, ok := document["bomFormat"]; ok {
+		var bomFormat string
+		if err := json.Unmarshal(raw, &bomFormat); err == nil && strings.EqualFold(bomFormat, "CycloneDX") {
+			return InputFormatCycloneDX
+		}
+	}
+
+	if _, ok := document["spdxVersion"]; ok {
+		return InputFormatSPDX
+	}
+
+	if isScanCodeDocument(document) {
+		return InputFormatScanCode
+	}
+
+	return InputFormatScanoss
+}
+
+// isScanCodeDocument reports whether the document has the ScanCode headers and files. SCANOSS results are keyed
+// by file path, so a project with files named headers and files has both keys too; only ScanCode headers name
+// the tool that wrote them.
+func isScanCodeDocument(document map[string]json.RawMessage) bool {
+	var headers []map[string]json.RawMessage
+	if err := json.Unmarshal(document["headers"], &headers); err != nil || len(headers) == 0 {
+		return false
+	}
+	for _, header := range headers {
+		var toolName string
+		if err := json.Unmarshal(header["tool_name"], &toolName); err != nil || toolName == "" {
+			return false
+		}
+	}
+
+	var files []json.RawMessage
+	return json.Unmarshal(document["files"], &files) == nil
+}
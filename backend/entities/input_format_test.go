@@ -0,0 +1,59 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectInputFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want InputFormat
+	}{
+		{"CycloneDX", `{"bomFormat": "CycloneDX", "specVersion": "1.5"}`, InputFormatCycloneDX},
+		{"SPDX", `{"spdxVersion": "SPDX-2.3", "packages": []}`, InputFormatSPDX},
+		{"ScanCode", `{"headers": [{"tool_name": "scancode-toolkit", "tool_version": "32.0.8"}], "files": []}`, InputFormatScanCode},
+		{"SCANOSS", `{"src/main.c": [{"id": "none"}]}`, InputFormatScanoss},
+		{
+			"SCANOSS with files named headers and files",
+			`{"headers": [{"id": "file", "server": {"version": "5.4.0"}}], "files": [{"id": "none"}]}`,
+			InputFormatScanoss,
+		},
+		{"ScanCode headers without files", `{"headers": [{"tool_name": "scancode-toolkit"}]}`, InputFormatScanoss},
+		{"empty headers", `{"headers": [], "files": []}`, InputFormatScanoss},
+		{"not JSON", `not json`, InputFormatScanoss},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DetectInputFormat([]byte(tt.data)))
+		})
+	}
+}
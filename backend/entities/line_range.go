@@ -0,0 +1,245 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// AllLines is the value the engine reports in lines and oss_lines for full file matches.
+const AllLines = "all"
+
+var ErrInvalidLineRange = errors.New("invalid line range")
+
+// LineRange is an inclusive, 1-based range of lines.
+type LineRange struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+func (r LineRange) Len() int {
+	return r.End - r.Start + 1
+}
+
+// ParseLineRanges parses the engine line ranges, e.g. "12-40,55-80". Single lines such as "7" are
+// accepted as one line ranges. The "all" value returns no ranges and all set to true.
+func ParseLineRanges(value string) (ranges []LineRange, all bool, err error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, false, nil
+	}
+	if strings.EqualFold(value, AllLines) {
+		return nil, true, nil
+	}
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		startValue, endValue, isRange := strings.Cut(part, "-")
+		if !isRange {
+			endValue = startValue
+		}
+		start, startErr := strconv.Atoi(strings.TrimSpace(startValue))
+		end, endErr := strconv.Atoi(strings.TrimSpace(endValue))
+		if startErr != nil || endErr != nil || start < 1 || end < start {
+			return nil, false, fmt.Errorf("%w: %q", ErrInvalidLineRange, part)
+		}
+
+		ranges = append(ranges, LineRange{Start: start, End: end})
+	}
+
+	return ranges, false, nil
+}
+
+// CountLines returns the number of lines of a file content, ignoring the trailing newline.
+func CountLines(content []byte) int {
+	if len(content) == 0 {
+		return 0
+	}
+	lines := strings.Count(string(content), "\n")
+	if content[len(content)-1] != '\n' {
+		lines++
+	}
+	return lines
+}
+
+// mergeLineRanges returns the sorted union of the ranges.
+func mergeLineRanges(ranges []LineRange) []LineRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	sorted := make([]LineRange, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start < sorted[j].Start
+	})
+
+	merged := []LineRange{sorted[0]}
+	for _, r := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if r.Start <= last.End+1 {
+			last.End = max(last.End, r.End)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// countMatchedLines returns the number of distinct lines covered by the ranges.
+func countMatchedLines(ranges []LineRange) int {
+	total := 0
+	for _, r := range mergeLineRanges(ranges) {
+		total += r.Len()
+	}
+	return total
+}
+
+// clampLineRanges drops or trims the ranges that go beyond the end of a file with totalLines lines.
+// A totalLines of 0 means the length is unknown and the ranges are kept as they are.
+func clampLineRanges(ranges []LineRange, totalLines int) (clamped []LineRange, outOfBounds bool) {
+	if totalLines <= 0 {
+		return ranges, false
+	}
+
+	for _, r := range ranges {
+		if r.Start > totalLines {
+			outOfBounds = true
+			continue
+		}
+		if r.End > totalLines {
+			outOfBounds = true
+			r.End = totalLines
+		}
+		clamped = append(clamped, r)
+	}
+	return clamped, outOfBounds
+}
+
+// LineRangePair links a range of the local file to the range of the remote file it matches.
+type LineRangePair struct {
+	Local  LineRange `json:"local"`
+	Remote LineRange `json:"remote"`
+}
+
+type MatchCoverage struct {
+	MatchedLines       int     `json:"matched_lines"`
+	LocalTotalLines    int     `json:"local_total_lines"`
+	LocalPercentage    float64 `json:"local_percentage"`
+	RemoteMatchedLines int     `json:"remote_matched_lines"`
+	RemoteTotalLines   int     `json:"remote_total_lines"`
+	RemotePercentage   float64 `json:"remote_percentage"`
+}
+
+// MatchRangesDTO holds the validated ranges of a match, ready for synchronized scrolling and highlighting.
+type MatchRangesDTO struct {
+	Local    []LineRange     `json:"local"`
+	Remote   []LineRange     `json:"remote"`
+	Pairs    []LineRangePair `json:"pairs"`
+	Coverage MatchCoverage   `json:"coverage"`
+	Warnings []string        `json:"warnings,omitempty"`
+}
+
+// NewMatchRanges parses and validates the lines and oss_lines of a match against the length of the
+// local and remote files. Lengths of 0 are treated as unknown and skip the validation of that side.
+func NewMatchRanges(lines, ossLines string, localTotalLines, remoteTotalLines int) MatchRangesDTO {
+	dto := MatchRangesDTO{
+		Local:  []LineRange{},
+		Remote: []LineRange{},
+		Pairs:  []LineRangePair{},
+	}
+
+	local, localAll, err := ParseLineRanges(lines)
+	if err != nil {
+		dto.Warnings = append(dto.Warnings, fmt.Sprintf("local ranges: %v", err))
+	}
+	remote, remoteAll, err := ParseLineRanges(ossLines)
+	if err != nil {
+		dto.Warnings = append(dto.Warnings, fmt.Sprintf("remote ranges: %v", err))
+	}
+
+	if localAll && localTotalLines > 0 {
+		local = []LineRange{{Start: 1, End: localTotalLines}}
+	}
+	if remoteAll && remoteTotalLines > 0 {
+		remote = []LineRange{{Start: 1, End: remoteTotalLines}}
+	}
+
+	if len(local) != len(remote) {
+		dto.Warnings = append(dto.Warnings, fmt.Sprintf("local and remote ranges do not pair up: %d local, %d remote", len(local), len(remote)))
+	}
+
+	// Ranges are clamped pairwise so a dropped local range does not shift the remote ones
+	for i := 0; i < min(len(local), len(remote)); i++ {
+
+		l, localOut := clampLineRanges(local[i:i+1], localTotalLines)
+		r, remoteOut := clampLineRanges(remote[i:i+1], remoteTotalLines)
+		if localOut {
+			dto.Warnings = append(dto.Warnings, fmt.Sprintf("local range %d-%d exceeds the file length of %d lines", local[i].Start, local[i].End, localTotalLines))
+		}
+		if remoteOut {
+			dto.Warnings = append(dto.Warnings, fmt.Sprintf("remote range %d-%d exceeds the file length of %d lines", remote[i].Start, remote[i].End, remoteTotalLines))
+		}
+		if len(l) == 0 || len(r) == 0 {
+			continue
+		}
+
+		dto.Local = append(dto.Local, l[0])
+		dto.Remote = append(dto.Remote, r[0])
+		dto.Pairs = append(dto.Pairs, LineRangePair{Local: l[0], Remote: r[0]})
+	}
+
+	// Ranges without a counterpart are not paired but are still highlighted
+	extraLocal, _ := clampLineRanges(local[min(len(local), len(remote)):], localTotalLines)
+	extraRemote, _ := clampLineRanges(remote[min(len(local), len(remote)):], remoteTotalLines)
+	dto.Local = append(dto.Local, extraLocal...)
+	dto.Remote = append(dto.Remote, extraRemote...)
+
+	dto.Coverage = MatchCoverage{
+		MatchedLines:       countMatchedLines(dto.Local),
+		LocalTotalLines:    localTotalLines,
+		RemoteMatchedLines: countMatchedLines(dto.Remote),
+		RemoteTotalLines:   remoteTotalLines,
+	}
+	dto.Coverage.LocalPercentage = percentage(dto.Coverage.MatchedLines, localTotalLines)
+	dto.Coverage.RemotePercentage = percentage(dto.Coverage.RemoteMatchedLines, remoteTotalLines)
+
+	return dto
+}
+
+func percentage(part, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return math.Round(float64(part)/float64(total)*10000) / 100
+}
@@ -0,0 +1,99 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLineRanges(t *testing.T) {
+	ranges, all, err := ParseLineRanges("12-40, 55-80,7")
+	require.NoError(t, err)
+	assert.False(t, all)
+	assert.Equal(t, []LineRange{{Start: 12, End: 40}, {Start: 55, End: 80}, {Start: 7, End: 7}}, ranges)
+
+	ranges, all, err = ParseLineRanges("all")
+	require.NoError(t, err)
+	assert.True(t, all)
+	assert.Empty(t, ranges)
+
+	for _, invalid := range []string{"40-12", "a-b", "0-3"} {
+		_, _, err = ParseLineRanges(invalid)
+		assert.ErrorIs(t, err, ErrInvalidLineRange, invalid)
+	}
+}
+
+func TestNewMatchRanges(t *testing.T) {
+	t.Run("full file match", func(t *testing.T) {
+		ranges := NewMatchRanges("all", "all", 30, 32)
+
+		assert.Equal(t, []LineRangePair{{Local: LineRange{Start: 1, End: 30}, Remote: LineRange{Start: 1, End: 32}}}, ranges.Pairs)
+		assert.Equal(t, 100.0, ranges.Coverage.LocalPercentage)
+		assert.Empty(t, ranges.Warnings)
+	})
+
+	t.Run("full local file matching part of the remote file", func(t *testing.T) {
+		ranges := NewMatchRanges("all", "10-39", 30, 120)
+
+		assert.Equal(t, []LineRangePair{{Local: LineRange{Start: 1, End: 30}, Remote: LineRange{Start: 10, End: 39}}}, ranges.Pairs)
+		assert.Equal(t, 100.0, ranges.Coverage.LocalPercentage)
+		assert.Equal(t, 25.0, ranges.Coverage.RemotePercentage)
+		assert.Empty(t, ranges.Warnings)
+	})
+
+	t.Run("unpaired ranges are highlighted but not paired", func(t *testing.T) {
+		ranges := NewMatchRanges("all", "10-39,50-60", 30, 120)
+
+		assert.Len(t, ranges.Pairs, 1)
+		assert.Equal(t, []LineRange{{Start: 10, End: 39}, {Start: 50, End: 60}}, ranges.Remote)
+		assert.Len(t, ranges.Warnings, 1)
+	})
+
+	t.Run("overlapping ranges are counted once", func(t *testing.T) {
+		ranges := NewMatchRanges("1-10,5-15", "1-10,20-30", 60, 0)
+
+		assert.Equal(t, 15, ranges.Coverage.MatchedLines)
+		assert.Equal(t, 25.0, ranges.Coverage.LocalPercentage)
+		assert.Equal(t, 0.0, ranges.Coverage.RemotePercentage)
+	})
+
+	t.Run("ranges beyond the file are dropped with a warning", func(t *testing.T) {
+		ranges := NewMatchRanges("1-5,90-95", "1-5,10-15,20-25", 20, 30)
+
+		assert.Equal(t, []LineRange{{Start: 1, End: 5}}, ranges.Local)
+		assert.Equal(t, []LineRange{{Start: 1, End: 5}, {Start: 20, End: 25}}, ranges.Remote)
+		assert.Len(t, ranges.Warnings, 2)
+	})
+}
+
+func TestCountLines(t *testing.T) {
+	assert.Equal(t, 0, CountLines(nil))
+	assert.Equal(t, 1, CountLines([]byte("one")))
+	assert.Equal(t, 2, CountLines([]byte("one\ntwo\n")))
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidPostScanHook    = errors.New("invalid post-scan hook")
+	ErrNoProjectPostScanHooks = errors.New("project has no post-scan hooks file")
+	ErrUnsafePostScanHookPath = errors.New("project post-scan hook path must stay inside the scan root")
+	// ErrPostScanHookNotInSession is returned by built in actions when the review session is on another folder
+	ErrPostScanHookNotInSession = errors.New("the review session is not open on the scanned folder")
+)
+
+// PostScanHookEvent carries the PostScanHookResult of each hook, PostScanHooksCompleteEvent all of them once the
+// last hook finished. ResultsReloadedEvent tells the UI the results were read again from disk.
+const (
+	PostScanHookEvent          = "postScanHook"
+	PostScanHooksCompleteEvent = "postScanHooksComplete"
+	ResultsReloadedEvent       = "resultsReloaded"
+)
+
+// PostScanHookAction is what a hook does once a scan completes.
+type PostScanHookAction string
+
+const (
+	// PostScanHookReload reads the results file again, so the hooks after it see the new results
+	PostScanHookReload PostScanHookAction = "reload"
+	// PostScanHookApplyRules applies the component decisions listed in a rules file
+	PostScanHookApplyRules PostScanHookAction = "apply-rules"
+	// PostScanHookExport writes the results in one of the ExportFormats
+	PostScanHookExport PostScanHookAction = "export"
+	// PostScanHookCommand runs an external program
+	PostScanHookCommand PostScanHookAction = "command"
+)
+
+var PostScanHookActions = []PostScanHookAction{PostScanHookReload, PostScanHookApplyRules, PostScanHookExport, PostScanHookCommand}
+
+// ExportFormat is a file format the export hook can write.
+type ExportFormat string
+
+const (
+	// ExportFormatCycloneDX is a CycloneDX SBOM of the identified components
+	ExportFormatCycloneDX ExportFormat = "cyclonedx"
+	// ExportFormatCBOM is the crypto inventory as a CycloneDX CBOM
+	ExportFormatCBOM ExportFormat = "cbom"
+	// ExportFormatSummary is a JSON count of results by workflow state and match type
+	ExportFormatSummary ExportFormat = "summary"
+)
+
+var ExportFormats = []ExportFormat{ExportFormatCycloneDX, ExportFormatCBOM, ExportFormatSummary}
+
+// DefaultPostScanHookTimeoutSeconds limits command hooks that set no timeout.
+const DefaultPostScanHookTimeoutSeconds = 300
+
+// PostScanHook is one step run after a scan completes. Relative paths are resolved against the scan root.
+type PostScanHook struct {
+	Name   string             `json:"name"`
+	Action PostScanHookAction `json:"action"`
+	// File is the rules file of apply-rules, a JSON list of component decisions
+	File string `json:"file,omitempty"`
+	// Format and Output select what export writes and where
+	Format ExportFormat `json:"format,omitempty"`
+	Output string       `json:"output,omitempty"`
+	// Command is the program and its arguments, run without a shell
+	Command        []string        `json:"command,omitempty"`
+	TimeoutSeconds int             `json:"timeout_seconds,omitempty"`
+	Scope          ScanPresetScope `json:"scope,omitempty"` // Set when the hook is read, not stored
+	// Trusted is set when the hook is read, not stored. User hooks are always trusted, project hooks once the user
+	// trusted that exact hooks file of the project.
+	Trusted bool `json:"trusted,omitempty"`
+}
+
+type PostScanHooksFile struct {
+	Hooks []PostScanHook `json:"hooks"`
+}
+
+// Validate checks the hook has the settings its action needs.
+func (h PostScanHook) Validate() error {
+	if strings.TrimSpace(h.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidPostScanHook)
+	}
+
+	switch h.Action {
+	case PostScanHookReload:
+	case PostScanHookApplyRules:
+		if h.File == "" {
+			return fmt.Errorf("%w: %s needs the rules file to apply", ErrInvalidPostScanHook, h.Name)
+		}
+	case PostScanHookExport:
+		if !slices.Contains(ExportFormats, h.Format) {
+			return fmt.Errorf("%w: %s has unknown export format %q, expected one of %v", ErrInvalidPostScanHook, h.Name, h.Format, ExportFormats)
+		}
+		if h.Output == "" {
+			return fmt.Errorf("%w: %s needs the output file to export to", ErrInvalidPostScanHook, h.Name)
+		}
+	case PostScanHookCommand:
+		if len(h.Command) == 0 || strings.TrimSpace(h.Command[0]) == "" {
+			return fmt.Errorf("%w: %s needs the command to run", ErrInvalidPostScanHook, h.Name)
+		}
+		if h.TimeoutSeconds < 0 {
+			return fmt.Errorf("%w: %s timeout must not be negative", ErrInvalidPostScanHook, h.Name)
+		}
+	default:
+		return fmt.Errorf("%w: %s has unknown action %q, expected one of %v", ErrInvalidPostScanHook, h.Name, h.Action, PostScanHookActions)
+	}
+
+	return nil
+}
+
+// PostScanHookStatus is the outcome of a hook.
+type PostScanHookStatus string
+
+const (
+	PostScanHookSucceeded PostScanHookStatus = "succeeded"
+	PostScanHookFailed    PostScanHookStatus = "failed"
+	// PostScanHookSkipped is a hook of a project whose hooks file the user has not trusted
+	PostScanHookSkipped PostScanHookStatus = "skipped"
+)
+
+type PostScanHookResult struct {
+	Name       string             `json:"name"`
+	Action     PostScanHookAction `json:"action"`
+	Status     PostScanHookStatus `json:"status"`
+	Error      string             `json:"error,omitempty"`
+	Output     string             `json:"output,omitempty"`
+	DurationMs int64              `json:"duration_ms"`
+}
+
+// TrustedPostScanHooks is a project hooks file the user allowed to run commands. Trust is tied to the sha256 of
+// the file, so any edit to it needs trusting again.
+type TrustedPostScanHooks struct {
+	ProjectRoot string    `json:"project_root"`
+	SHA256      string    `json:"sha256"`
+	TrustedAt   time.Time `json:"trusted_at"`
+}
+
+type TrustedPostScanHooksFile struct {
+	Projects []TrustedPostScanHooks `json:"projects"`
+}
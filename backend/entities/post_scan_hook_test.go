@@ -0,0 +1,63 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostScanHookValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		hook    PostScanHook
+		wantErr bool
+	}{
+		{"reload", PostScanHook{Name: "reload", Action: PostScanHookReload}, false},
+		{"apply rules", PostScanHook{Name: "rules", Action: PostScanHookApplyRules, File: "rules.json"}, false},
+		{"export", PostScanHook{Name: "sbom", Action: PostScanHookExport, Format: ExportFormatCycloneDX, Output: "sbom.json"}, false},
+		{"command", PostScanHook{Name: "notify", Action: PostScanHookCommand, Command: []string{"notify-send", "done"}}, false},
+		{"missing name", PostScanHook{Action: PostScanHookReload}, true},
+		{"unknown action", PostScanHook{Name: "x", Action: "upload"}, true},
+		{"apply rules without file", PostScanHook{Name: "rules", Action: PostScanHookApplyRules}, true},
+		{"unknown export format", PostScanHook{Name: "sbom", Action: PostScanHookExport, Format: "xlsx", Output: "out.xlsx"}, true},
+		{"export without output", PostScanHook{Name: "sbom", Action: PostScanHookExport, Format: ExportFormatSummary}, true},
+		{"command without program", PostScanHook{Name: "notify", Action: PostScanHookCommand, Command: []string{""}}, true},
+		{"negative timeout", PostScanHook{Name: "notify", Action: PostScanHookCommand, Command: []string{"true"}, TimeoutSeconds: -1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.hook.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidPostScanHook)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -40,14 +40,16 @@ var (
 )
 
 type Result struct {
-	Path          string      `json:"path"`
-	MatchType     string      `json:"match_type"`
+	Path      string `json:"path"`
+	MatchType string `json:"match_type"`
 	// TODO: Consider changing Purl from *[]string to []string.
 	// The pointer adds unnecessary complexity (nil checks everywhere).
 	// []string with omitempty already omits empty slices from JSON.
 	Purl          *[]string   `json:"purl,omitempty"`
 	ComponentName string      `json:"component"`
 	Matches       []Component `json:"matches,omitempty"`
+	// Origin is the results file this result was loaded from, relative to the scan root when possible
+	Origin string `json:"origin,omitempty"`
 }
 
 func NewResult() *Result {
@@ -133,21 +135,60 @@ const (
 )
 
 type ResultDTO struct {
-	Path             string        `json:"path"`
-	MatchType        MatchType     `json:"match_type"`
-	WorkflowState    WorkflowState `json:"workflow_state,omitempty"`
-	FilterConfig     FilterConfig  `json:"filter_config,omitempty"`
-	Comment          string        `json:"comment,omitempty"`
-	DetectedPurl     string        `json:"detected_purl,omitempty"`
-	DetectedPurlUrl  string        `json:"detected_purl_url,omitempty"`
-	DetectedName     string        `json:"detected_name,omitempty"`
-	ConcludedPurl    string        `json:"concluded_purl,omitempty"`
-	ConcludedPurlUrl string        `json:"concluded_purl_url,omitempty"`
-	ConcludedName    string        `json:"concluded_name,omitempty"`
+	Path               string                `json:"path"`
+	MatchType          MatchType             `json:"match_type"`
+	WorkflowState      WorkflowState         `json:"workflow_state,omitempty"`
+	FilterConfig       FilterConfig          `json:"filter_config,omitempty"`
+	Comment            string                `json:"comment,omitempty"`
+	DetectedPurl       string                `json:"detected_purl,omitempty"`
+	DetectedPurlUrl    string                `json:"detected_purl_url,omitempty"`
+	DetectedName       string                `json:"detected_name,omitempty"`
+	ConcludedPurl      string                `json:"concluded_purl,omitempty"`
+	ConcludedPurlUrl   string                `json:"concluded_purl_url,omitempty"`
+	ConcludedName      string                `json:"concluded_name,omitempty"`
+	VulnerabilityCount int                   `json:"vulnerability_count,omitempty"`
+	MaxSeverity        VulnerabilitySeverity `json:"max_severity,omitempty"`
+	Origin             string                `json:"origin,omitempty"`
+	CryptoAlgorithms   []string              `json:"crypto_algorithms,omitempty"`
 }
 
 type RequestResultDTO struct {
-	MatchType MatchType  `json:"match_type,omitempty" validate:"omitempty,eq=file|eq=snippet"`
-	Query     string     `json:"query,omitempty"`
-	Sort      SortConfig `json:"sort,omitempty" validate:"dive"`
+	MatchType          MatchType             `json:"match_type,omitempty" validate:"omitempty,eq=file|eq=snippet"`
+	Query              string                `json:"query,omitempty"`
+	Sort               SortConfig            `json:"sort,omitempty" validate:"dive"`
+	HasVulnerabilities bool                  `json:"has_vulnerabilities,omitempty"`
+	MinSeverity        VulnerabilitySeverity `json:"min_severity,omitempty" validate:"omitempty,eq=low|eq=medium|eq=high|eq=critical"`
+	// Only keep results whose main component was last pushed more than N years ago
+	LastPushOlderThanYears     int    `json:"last_push_older_than_years,omitempty" validate:"omitempty,min=1"`
+	CopyrightDiffersFromVendor bool   `json:"copyright_differs_from_vendor,omitempty"`
+	HasCryptography            bool   `json:"has_cryptography,omitempty"`
+	CryptoAlgorithm            string `json:"crypto_algorithm,omitempty"`
+	// Minimum key or digest size in bits of the detected algorithms
+	MinCryptoStrength int           `json:"min_crypto_strength,omitempty" validate:"omitempty,min=1"`
+	WorkflowState     WorkflowState `json:"workflow_state,omitempty" validate:"omitempty,eq=pending|eq=completed"`
+}
+
+// RequestResultPageDTO requests one page of the results matching the filter, in the filter's sort order.
+type RequestResultPageDTO struct {
+	Filter RequestResultDTO `json:"filter"`
+	Offset int              `json:"offset" validate:"min=0"`
+	Limit  int              `json:"limit" validate:"min=1,max=5000"`
+}
+
+type ResultPageDTO struct {
+	Results []ResultDTO `json:"results"`
+	Offset  int         `json:"offset"`
+	Limit   int         `json:"limit"`
+	// Total number of results matching the filter, across all pages
+	Total   int  `json:"total"`
+	HasMore bool `json:"has_more"`
+}
+
+// ResultSummaryDTO counts the results matching a filter without mapping them.
+type ResultSummaryDTO struct {
+	Total     int `json:"total"`
+	Pending   int `json:"pending"`
+	Completed int `json:"completed"`
+	File      int `json:"file"`
+	Snippet   int `json:"snippet"`
 }
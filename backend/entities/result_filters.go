@@ -23,7 +23,9 @@
 
 package entities
 
-import "strings"
+import (
+	"time"
+)
 
 type ResultFilterAND struct {
 	filters []ResultFilter
@@ -75,11 +77,103 @@ func (f *ResultFilterMatchType) IsValid(result Result) bool {
 }
 
 func (f *ResultQueryFilter) IsValid(result Result) bool {
-	queryLower := strings.ToLower(f.query)
-	pathLower := strings.ToLower(result.Path)
-	purlLower := strings.ToLower((*result.Purl)[0])
+	return textTerm{value: f.query}.matches(result)
+}
+
+type ResultFilterHasVulnerabilities struct{}
+
+func NewResultFilterHasVulnerabilities() *ResultFilterHasVulnerabilities {
+	return &ResultFilterHasVulnerabilities{}
+}
 
-	return strings.Contains(pathLower, queryLower) || strings.Contains(purlLower, queryLower)
+func (f *ResultFilterHasVulnerabilities) IsValid(result Result) bool {
+	return result.HasVulnerabilities()
+}
+
+// ResultFilterMinSeverity keeps results whose most severe vulnerability is at
+// least the given severity.
+type ResultFilterMinSeverity struct {
+	severity VulnerabilitySeverity
+}
+
+func NewResultFilterMinSeverity(severity VulnerabilitySeverity) *ResultFilterMinSeverity {
+	return &ResultFilterMinSeverity{
+		severity: severity,
+	}
+}
+
+func (f *ResultFilterMinSeverity) IsValid(result Result) bool {
+	return result.HasVulnerabilities() && result.GetMaxVulnerabilitySeverity().Rank() >= f.severity.Rank()
+}
+
+// ResultFilterLastPushOlderThan keeps results whose main component has not
+// been pushed to for more than the given number of years.
+type ResultFilterLastPushOlderThan struct {
+	years int
+	now   time.Time
+}
+
+func NewResultFilterLastPushOlderThan(years int) *ResultFilterLastPushOlderThan {
+	return &ResultFilterLastPushOlderThan{
+		years: years,
+		now:   time.Now(),
+	}
+}
+
+func (f *ResultFilterLastPushOlderThan) IsValid(result Result) bool {
+	if len(result.Matches) == 0 {
+		return false
+	}
+	return result.Matches[0].IsLastPushOlderThan(f.years, f.now)
+}
+
+type ResultFilterCopyrightDiffersFromVendor struct{}
+
+func NewResultFilterCopyrightDiffersFromVendor() *ResultFilterCopyrightDiffersFromVendor {
+	return &ResultFilterCopyrightDiffersFromVendor{}
+}
+
+func (f *ResultFilterCopyrightDiffersFromVendor) IsValid(result Result) bool {
+	if len(result.Matches) == 0 {
+		return false
+	}
+	return result.Matches[0].HasCopyrightDifferentFromVendor()
+}
+
+// ResultFilterCryptography keeps results with at least one detected algorithm
+// matching the given algorithm name and minimum strength.
+type ResultFilterCryptography struct {
+	criteria RequestCryptographyDTO
+}
+
+func NewResultFilterCryptography(algorithm string, minStrength int) *ResultFilterCryptography {
+	return &ResultFilterCryptography{
+		criteria: RequestCryptographyDTO{Algorithm: algorithm, MinStrength: minStrength},
+	}
+}
+
+func (f *ResultFilterCryptography) IsValid(result Result) bool {
+	for _, c := range result.GetCryptography() {
+		if f.criteria.Matches(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// ResultFilterWorkflowState keeps results in the given workflow state according to the loaded settings file.
+type ResultFilterWorkflowState struct {
+	state WorkflowState
+}
+
+func NewResultFilterWorkflowState(state WorkflowState) *ResultFilterWorkflowState {
+	return &ResultFilterWorkflowState{
+		state: state,
+	}
+}
+
+func (f *ResultFilterWorkflowState) IsValid(result Result) bool {
+	return resultSettings().GetResultWorkflowState(result) == f.state
 }
 
 type ResultFilterFactory struct {
@@ -89,9 +183,19 @@ func NewResultFilterFactory() *ResultFilterFactory {
 	return &ResultFilterFactory{}
 }
 
-func (f *ResultFilterFactory) Create(dto *RequestResultDTO) ResultFilter {
+// newQueryFilter parses queries using field syntax and returns their syntax errors. Anything else is
+// searched as plain text on path and purl.
+func newQueryFilter(query string) (ResultFilter, error) {
+	if IsFieldQuery(query) {
+		return ParseResultQuery(query)
+	}
+	return NewResultQueryFilter(query), nil
+}
+
+// Create builds the filter of a request. It fails when the query uses field syntax and cannot be parsed.
+func (f *ResultFilterFactory) Create(dto *RequestResultDTO) (ResultFilter, error) {
 	if dto == nil {
-		return nil
+		return nil, nil
 	}
 	filterAND := NewResultFilterAND()
 
@@ -100,8 +204,36 @@ func (f *ResultFilterFactory) Create(dto *RequestResultDTO) ResultFilter {
 	}
 
 	if dto.Query != "" {
-		filterAND.AddFilter(NewResultQueryFilter(dto.Query))
+		queryFilter, err := newQueryFilter(dto.Query)
+		if err != nil {
+			return nil, err
+		}
+		filterAND.AddFilter(queryFilter)
+	}
+
+	if dto.WorkflowState != "" {
+		filterAND.AddFilter(NewResultFilterWorkflowState(dto.WorkflowState))
+	}
+
+	if dto.HasVulnerabilities {
+		filterAND.AddFilter(NewResultFilterHasVulnerabilities())
+	}
+
+	if dto.MinSeverity != "" {
+		filterAND.AddFilter(NewResultFilterMinSeverity(dto.MinSeverity))
+	}
+
+	if dto.LastPushOlderThanYears > 0 {
+		filterAND.AddFilter(NewResultFilterLastPushOlderThan(dto.LastPushOlderThanYears))
+	}
+
+	if dto.CopyrightDiffersFromVendor {
+		filterAND.AddFilter(NewResultFilterCopyrightDiffersFromVendor())
+	}
+
+	if dto.HasCryptography || dto.CryptoAlgorithm != "" || dto.MinCryptoStrength > 0 {
+		filterAND.AddFilter(NewResultFilterCryptography(dto.CryptoAlgorithm, dto.MinCryptoStrength))
 	}
 
-	return filterAND
+	return filterAND, nil
 }
@@ -0,0 +1,529 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Result queries combine field terms with AND, OR, NOT and parentheses, e.g.
+//
+//	path:src/** purl:pkg:npm/* license:GPL-2.0 state:pending match:>=80 type:snippet comment:"vendored"
+//
+// Terms next to each other are joined with AND, which binds tighter than OR. Values may be quoted
+// to include spaces. Terms without a field keep the plain search behaviour on path and purl.
+
+const (
+	QueryFieldPath      = "path"
+	QueryFieldPurl      = "purl"
+	QueryFieldComponent = "component"
+	QueryFieldLicense   = "license"
+	QueryFieldState     = "state"
+	QueryFieldDecision  = "decision"
+	QueryFieldMatch     = "match"
+	QueryFieldType      = "type"
+	QueryFieldComment   = "comment"
+)
+
+var queryFields = []string{
+	QueryFieldPath,
+	QueryFieldPurl,
+	QueryFieldComponent,
+	QueryFieldLicense,
+	QueryFieldState,
+	QueryFieldDecision,
+	QueryFieldMatch,
+	QueryFieldType,
+	QueryFieldComment,
+}
+
+var fieldQueryPattern = regexp.MustCompile(`(?i)(^|[\s(])(` + strings.Join(queryFields, "|") + `):`)
+
+// QuerySyntaxError reports an invalid query. Position is the 1-based character where the problem starts.
+type QuerySyntaxError struct {
+	Position int
+	Message  string
+}
+
+func (e *QuerySyntaxError) Error() string {
+	return fmt.Sprintf("invalid query at position %d: %s", e.Position, e.Message)
+}
+
+// IsFieldQuery reports whether the query uses field syntax, as opposed to a plain search text.
+func IsFieldQuery(query string) bool {
+	return fieldQueryPattern.MatchString(query)
+}
+
+// ResultQuery is a parsed query that can be evaluated against results.
+type ResultQuery struct {
+	root queryNode
+}
+
+func (q *ResultQuery) IsValid(result Result) bool {
+	return q.root.matches(result)
+}
+
+// ParseResultQuery parses a query. Errors are of type *QuerySyntaxError.
+func ParseResultQuery(query string) (*ResultQuery, error) {
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &queryParser{tokens: tokens, end: len([]rune(query)) + 1}
+	if p.done() {
+		return nil, &QuerySyntaxError{Position: 1, Message: "empty query"}
+	}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.done() {
+		t := p.peek()
+		return nil, &QuerySyntaxError{Position: t.pos, Message: fmt.Sprintf("unexpected %q", t.text)}
+	}
+
+	return &ResultQuery{root: root}, nil
+}
+
+type queryTokenKind int
+
+const (
+	tokenWord queryTokenKind = iota
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenOpen
+	tokenClose
+)
+
+type queryToken struct {
+	kind queryTokenKind
+	text string
+	// raw is the word as typed, used to tell field terms from quoted text containing a colon
+	raw string
+	pos int
+}
+
+func tokenizeQuery(query string) ([]queryToken, error) {
+	var tokens []queryToken
+	runes := []rune(query)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, queryToken{kind: tokenOpen, text: "(", pos: i + 1})
+			i++
+		case r == ')':
+			tokens = append(tokens, queryToken{kind: tokenClose, text: ")", pos: i + 1})
+			i++
+		default:
+			start := i
+			var text, raw strings.Builder
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && runes[i] != '(' && runes[i] != ')' {
+				if runes[i] != '"' {
+					text.WriteRune(runes[i])
+					raw.WriteRune(runes[i])
+					i++
+					continue
+				}
+
+				quoteStart := i
+				raw.WriteRune('"')
+				i++
+				for i < len(runes) && runes[i] != '"' {
+					if runes[i] == '\\' && i+1 < len(runes) {
+						i++
+					}
+					text.WriteRune(runes[i])
+					raw.WriteRune('_')
+					i++
+				}
+				if i >= len(runes) {
+					return nil, &QuerySyntaxError{Position: quoteStart + 1, Message: "unterminated quote"}
+				}
+				raw.WriteRune('"')
+				i++
+			}
+
+			token := queryToken{kind: tokenWord, text: text.String(), raw: raw.String(), pos: start + 1}
+			switch raw.String() {
+			case "AND":
+				token.kind = tokenAnd
+			case "OR":
+				token.kind = tokenOr
+			case "NOT":
+				token.kind = tokenNot
+			}
+			tokens = append(tokens, token)
+		}
+	}
+
+	return tokens, nil
+}
+
+type queryParser struct {
+	tokens []queryToken
+	index  int
+	end    int
+}
+
+func (p *queryParser) done() bool {
+	return p.index >= len(p.tokens)
+}
+
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.index]
+}
+
+func (p *queryParser) next() queryToken {
+	t := p.tokens[p.index]
+	p.index++
+	return t
+}
+
+func (p *queryParser) position() int {
+	if p.done() {
+		return p.end
+	}
+	return p.peek().pos
+}
+
+func (p *queryParser) parseOr() (queryNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []queryNode{left}
+	for !p.done() && p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, right)
+	}
+
+	if len(nodes) == 1 {
+		return left, nil
+	}
+	return orNode(nodes), nil
+}
+
+func (p *queryParser) parseAnd() (queryNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []queryNode{left}
+	for !p.done() {
+		switch p.peek().kind {
+		case tokenAnd:
+			p.next()
+		case tokenWord, tokenNot, tokenOpen:
+			// Juxtaposed terms are an implicit AND
+		default:
+			return p.andOf(nodes), nil
+		}
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, right)
+	}
+
+	return p.andOf(nodes), nil
+}
+
+func (p *queryParser) andOf(nodes []queryNode) queryNode {
+	if len(nodes) == 1 {
+		return nodes[0]
+	}
+	return andNode(nodes)
+}
+
+func (p *queryParser) parseUnary() (queryNode, error) {
+	if p.done() {
+		return nil, &QuerySyntaxError{Position: p.end, Message: "expected a search term"}
+	}
+
+	if p.peek().kind == tokenNot {
+		p.next()
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{node: node}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *queryParser) parsePrimary() (queryNode, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenOpen:
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.done() || p.peek().kind != tokenClose {
+			return nil, &QuerySyntaxError{Position: p.position(), Message: fmt.Sprintf("missing ')' for '(' at position %d", t.pos)}
+		}
+		p.next()
+		return node, nil
+	case tokenWord:
+		return newQueryTerm(t)
+	default:
+		return nil, &QuerySyntaxError{Position: t.pos, Message: fmt.Sprintf("expected a search term, found %q", t.text)}
+	}
+}
+
+type queryNode interface {
+	matches(result Result) bool
+}
+
+type andNode []queryNode
+
+func (n andNode) matches(result Result) bool {
+	for _, node := range n {
+		if !node.matches(result) {
+			return false
+		}
+	}
+	return true
+}
+
+type orNode []queryNode
+
+func (n orNode) matches(result Result) bool {
+	for _, node := range n {
+		if node.matches(result) {
+			return true
+		}
+	}
+	return false
+}
+
+type notNode struct {
+	node queryNode
+}
+
+func (n notNode) matches(result Result) bool {
+	return !n.node.matches(result)
+}
+
+// textTerm is a term without a field, it keeps the plain search behaviour.
+type textTerm struct {
+	value string
+}
+
+func (t textTerm) matches(result Result) bool {
+	value := strings.ToLower(t.value)
+	if strings.Contains(strings.ToLower(result.Path), value) {
+		return true
+	}
+	return result.Purl != nil && len(*result.Purl) > 0 && strings.Contains(strings.ToLower((*result.Purl)[0]), value)
+}
+
+type fieldTerm struct {
+	match func(result Result) bool
+}
+
+func (t fieldTerm) matches(result Result) bool {
+	return t.match(result)
+}
+
+func newQueryTerm(t queryToken) (queryNode, error) {
+	rawField, _, hasField := strings.Cut(t.raw, ":")
+	if !hasField || strings.Contains(rawField, `"`) {
+		return textTerm{value: t.text}, nil
+	}
+
+	field, value, _ := strings.Cut(t.text, ":")
+	field = strings.ToLower(field)
+	valuePos := t.pos + len([]rune(rawField)) + 1
+	if value == "" {
+		return nil, &QuerySyntaxError{Position: valuePos, Message: fmt.Sprintf("missing value for %q", field)}
+	}
+
+	switch field {
+	case QueryFieldPath:
+		matcher := newQueryValueMatcher(value, true)
+		return fieldTerm{match: func(r Result) bool { return matcher(r.Path) }}, nil
+	case QueryFieldPurl:
+		matcher := newQueryValueMatcher(value, false)
+		return fieldTerm{match: func(r Result) bool { return r.Purl != nil && anyMatches(*r.Purl, matcher) }}, nil
+	case QueryFieldComponent:
+		matcher := newQueryValueMatcher(value, false)
+		return fieldTerm{match: func(r Result) bool { return matcher(r.ComponentName) }}, nil
+	case QueryFieldLicense:
+		matcher := newQueryExactMatcher(value)
+		return fieldTerm{match: func(r Result) bool { return anyMatches(resultLicenses(r), matcher) }}, nil
+	case QueryFieldComment:
+		matcher := newQueryValueMatcher(value, false)
+		return fieldTerm{match: func(r Result) bool { return matcher(resultSettings().GetBomEntryFromResult(r).Comment) }}, nil
+	case QueryFieldState:
+		state := WorkflowState(strings.ToLower(value))
+		if state != Pending && state != Completed {
+			return nil, &QuerySyntaxError{Position: valuePos, Message: fmt.Sprintf("unknown state %q, expected pending or completed", value)}
+		}
+		return fieldTerm{match: func(r Result) bool { return resultSettings().GetResultWorkflowState(r) == state }}, nil
+	case QueryFieldDecision:
+		action := FilterAction(strings.ToLower(value))
+		if action != Include && action != Remove && action != Replace {
+			return nil, &QuerySyntaxError{Position: valuePos, Message: fmt.Sprintf("unknown decision %q, expected include, remove or replace", value)}
+		}
+		return fieldTerm{match: func(r Result) bool { return resultSettings().GetResultFilterConfig(r).Action == action }}, nil
+	case QueryFieldType:
+		matchType := strings.ToLower(value)
+		switch matchType {
+		case string(MatchTypeFile), string(MatchTypeSnippet), MatchTypeDependency, MatchTypeNone:
+		default:
+			return nil, &QuerySyntaxError{Position: valuePos, Message: fmt.Sprintf("unknown match type %q, expected file or snippet", value)}
+		}
+		return fieldTerm{match: func(r Result) bool { return r.MatchType == matchType }}, nil
+	case QueryFieldMatch:
+		compare, err := newQueryNumberComparison(value)
+		if err != nil {
+			return nil, &QuerySyntaxError{Position: valuePos, Message: err.Error()}
+		}
+		return fieldTerm{match: func(r Result) bool { return compare(r.GetMatchPercentage()) }}, nil
+	default:
+		return nil, &QuerySyntaxError{Position: t.pos, Message: fmt.Sprintf("unknown field %q, expected one of %s", field, strings.Join(queryFields, ", "))}
+	}
+}
+
+// newQueryValueMatcher matches values case-insensitively. Values with wildcards must match as a whole,
+// with "*" stopping at "/" for paths and "**" matching across folders. Other values match as a substring.
+func newQueryValueMatcher(value string, isPath bool) func(string) bool {
+	value = strings.ToLower(value)
+	if !strings.ContainsAny(value, "*?") {
+		return func(s string) bool { return strings.Contains(strings.ToLower(s), value) }
+	}
+
+	pattern := globToRegexp(value, isPath)
+	return func(s string) bool { return pattern.MatchString(strings.ToLower(s)) }
+}
+
+// newQueryExactMatcher matches whole values case-insensitively, with optional wildcards.
+func newQueryExactMatcher(value string) func(string) bool {
+	pattern := globToRegexp(strings.ToLower(value), false)
+	return func(s string) bool { return pattern.MatchString(strings.ToLower(s)) }
+}
+
+func globToRegexp(glob string, isPath bool) *regexp.Regexp {
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	runes := []rune(glob)
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case runes[i] == '*' && i+1 < len(runes) && runes[i+1] == '*':
+			// "**/" also matches no folder at all, so src/**/*.go matches src/a.go
+			if i+2 < len(runes) && runes[i+2] == '/' {
+				pattern.WriteString("(?:.*/)?")
+				i += 2
+			} else {
+				pattern.WriteString(".*")
+				i++
+			}
+		case runes[i] == '*' && isPath:
+			pattern.WriteString("[^/]*")
+		case runes[i] == '*':
+			pattern.WriteString(".*")
+		case runes[i] == '?':
+			pattern.WriteString(".")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(runes[i])))
+		}
+	}
+	pattern.WriteString("$")
+	return regexp.MustCompile(pattern.String())
+}
+
+func newQueryNumberComparison(value string) (func(float64) bool, error) {
+	operator := ""
+	for _, op := range []string{">=", "<=", ">", "<", "="} {
+		if strings.HasPrefix(value, op) {
+			operator = op
+			break
+		}
+	}
+
+	number, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimPrefix(value, operator), "%"), 64)
+	if err != nil {
+		return nil, fmt.Errorf("expected a number such as >=80, found %q", value)
+	}
+
+	switch operator {
+	case ">=":
+		return func(v float64) bool { return v >= number }, nil
+	case "<=":
+		return func(v float64) bool { return v <= number }, nil
+	case ">":
+		return func(v float64) bool { return v > number }, nil
+	case "<":
+		return func(v float64) bool { return v < number }, nil
+	default:
+		return func(v float64) bool { return v == number }, nil
+	}
+}
+
+func anyMatches(values []string, matcher func(string) bool) bool {
+	for _, v := range values {
+		if matcher(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func resultLicenses(result Result) []string {
+	var licenses []string
+	for _, match := range result.Matches {
+		for _, license := range match.Licenses {
+			licenses = append(licenses, license.Name)
+		}
+	}
+	return licenses
+}
+
+// resultSettings returns the loaded settings file, or an empty one so decisions evaluate as pending.
+func resultSettings() *SettingsFile {
+	if ScanossSettingsJson == nil || ScanossSettingsJson.SettingsFile == nil {
+		return &SettingsFile{}
+	}
+	return ScanossSettingsJson.SettingsFile
+}
@@ -0,0 +1,181 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResultQuery(t *testing.T) {
+	npmPurl := []string{"pkg:npm/lodash@4.17.21"}
+	githubPurl := []string{"pkg:github/torvalds/linux"}
+
+	vendored := Result{
+		Path:      "src/vendor/lodash.js",
+		MatchType: string(MatchTypeFile),
+		Purl:      &npmPurl,
+		Matches:   []Component{{Licenses: []MatchLicense{{Name: "MIT"}}}},
+	}
+	snippet := Result{
+		Path:      "src/kernel/sched.c",
+		MatchType: string(MatchTypeSnippet),
+		Purl:      &githubPurl,
+		Matches:   []Component{{Matched: "85%", Licenses: []MatchLicense{{Name: "GPL-2.0-only"}}}},
+	}
+	lowSnippet := Result{
+		Path:      "docs/readme.md",
+		MatchType: string(MatchTypeSnippet),
+		Purl:      &githubPurl,
+		Matches:   []Component{{Matched: "20%", Licenses: []MatchLicense{{Name: "GPL-2.0-only"}}}},
+	}
+	noPurl := Result{Path: "src/main.go", MatchType: MatchTypeNone}
+	results := []Result{vendored, snippet, lowSnippet, noPurl}
+
+	previous := ScanossSettingsJson
+	ScanossSettingsJson = &ScanossSettings{SettingsFile: &SettingsFile{
+		Bom: Bom{Include: []ComponentFilter{{Path: vendored.Path, Purl: npmPurl[0], Comment: "Vendored copy"}}},
+	}}
+	t.Cleanup(func() { ScanossSettingsJson = previous })
+
+	tests := []struct {
+		query    string
+		expected []Result
+	}{
+		{`path:src/**`, []Result{vendored, snippet, noPurl}},
+		{`path:src/*.go`, []Result{noPurl}},
+		{`path:kernel`, []Result{snippet}},
+		{`purl:pkg:npm/*`, []Result{vendored}},
+		{`license:gpl-2.0*`, []Result{snippet, lowSnippet}},
+		{`license:GPL-2.0`, nil},
+		{`state:pending`, []Result{snippet, lowSnippet, noPurl}},
+		{`decision:include`, []Result{vendored}},
+		{`match:>=80`, []Result{vendored, snippet}},
+		{`match:<50 type:snippet`, []Result{lowSnippet}},
+		{`comment:"vendored copy"`, []Result{vendored}},
+		{`type:file OR path:docs/**`, []Result{vendored, lowSnippet}},
+		{`type:snippet AND NOT (match:>80 OR path:docs/*)`, nil},
+		{`NOT type:snippet`, []Result{vendored, noPurl}},
+		{`lodash type:file`, []Result{vendored}},
+		{`"src/main" OR path:docs/**`, []Result{lowSnippet, noPurl}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			query, err := ParseResultQuery(tt.query)
+			require.NoError(t, err)
+
+			var matched []Result
+			for _, result := range results {
+				if query.IsValid(result) {
+					matched = append(matched, result)
+				}
+			}
+			assert.Equal(t, tt.expected, matched)
+		})
+	}
+}
+
+func TestParseResultQuery_Errors(t *testing.T) {
+	tests := []struct {
+		query    string
+		position int
+		message  string
+	}{
+		{``, 1, "empty query"},
+		{`owner:me`, 1, `unknown field "owner"`},
+		{`state:done`, 7, `unknown state "done"`},
+		{`path:src match:high`, 16, "expected a number"},
+		{`path:`, 6, "missing value"},
+		{`(type:file OR path:x`, 21, "missing ')' for '(' at position 1"},
+		{`type:file AND`, 14, "expected a search term"},
+		{`type:file )`, 11, `unexpected ")"`},
+		{`comment:"vendored`, 9, "unterminated quote"},
+		{`OR type:file`, 1, `expected a search term, found "OR"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			_, err := ParseResultQuery(tt.query)
+
+			var syntaxErr *QuerySyntaxError
+			require.ErrorAs(t, err, &syntaxErr)
+			assert.Equal(t, tt.position, syntaxErr.Position)
+			assert.Contains(t, syntaxErr.Message, tt.message)
+		})
+	}
+}
+
+func TestIsFieldQuery(t *testing.T) {
+	assert.True(t, IsFieldQuery("path:src/**"))
+	assert.True(t, IsFieldQuery("lodash (TYPE:file)"))
+	assert.False(t, IsFieldQuery("lodash"))
+	assert.False(t, IsFieldQuery("pkg:npm/lodash"))
+}
+
+func TestQueryValueMatcher_Globs(t *testing.T) {
+	tests := []struct {
+		glob    string
+		path    string
+		matches bool
+	}{
+		{`dé*`, "dé.go", true},
+		{`caf?.go`, "café.go", true},
+		{`src/naïve/*.go`, "src/NAÏVE/main.go", true},
+		{`src/**/*.go`, "src/a.go", true},
+		{`src/**/*.go`, "src/pkg/sub/a.go", true},
+		{`src/**/*.go`, "src/a.c", false},
+		{`src/**/*.go`, "other/src/a.go", false},
+		{`**/*.go`, "a.go", true},
+		{`src/**`, "src/pkg/a.go", true},
+		{`src/*.go`, "src/pkg/a.go", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.glob+" "+tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.matches, newQueryValueMatcher(tt.glob, true)(tt.path))
+		})
+	}
+}
+
+func TestResultFilterFactory_Query(t *testing.T) {
+	purl := []string{"pkg:npm/lodash@4.17.21"}
+	result := Result{Path: "src/lodash.js", MatchType: string(MatchTypeFile), Purl: &purl}
+
+	filter, err := NewResultFilterFactory().Create(&RequestResultDTO{Query: "path:src/** type:snippet"})
+	require.NoError(t, err)
+	assert.False(t, filter.IsValid(result))
+
+	filter, err = NewResultFilterFactory().Create(&RequestResultDTO{Query: "pkg:npm/lodash"})
+	require.NoError(t, err)
+	assert.True(t, filter.IsValid(result))
+
+	_, err = NewResultFilterFactory().Create(&RequestResultDTO{Query: "path:"})
+	var syntaxErr *QuerySyntaxError
+	assert.ErrorAs(t, err, &syntaxErr)
+}
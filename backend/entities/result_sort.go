@@ -0,0 +1,176 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// ResultComparator compares the results at positions i and j, returning a negative number when i sorts first.
+type ResultComparator func(i, j int) int
+
+// Then returns a comparator that falls back to next when c considers both results equal.
+func (c ResultComparator) Then(next ResultComparator) ResultComparator {
+	return func(i, j int) int {
+		if result := c(i, j); result != 0 {
+			return result
+		}
+		return next(i, j)
+	}
+}
+
+// resultSortValue reads the value a result is sorted by, either as a number or as text.
+type resultSortValue struct {
+	number func(r *Result) float64
+	text   func(r *Result) string
+}
+
+var resultSortValues = map[SortOption]resultSortValue{
+	SortByMatchPercentage: {number: (*Result).GetMatchPercentage},
+	SortByPath:            {text: func(r *Result) string { return r.Path }},
+	SortBySeverity: {number: func(r *Result) float64 {
+		return float64(r.GetMaxVulnerabilitySeverity().Rank())
+	}},
+	SortByComponent: {text: func(r *Result) string {
+		if len(r.Matches) > 0 && r.Matches[0].Component != "" {
+			return strings.ToLower(r.Matches[0].Component)
+		}
+		return strings.ToLower(r.ComponentName)
+	}},
+	SortByVendor: {text: func(r *Result) string {
+		if len(r.Matches) == 0 {
+			return ""
+		}
+		return strings.ToLower(r.Matches[0].Vendor)
+	}},
+	SortByLicense: {text: func(r *Result) string {
+		if len(r.Matches) == 0 || len(r.Matches[0].Licenses) == 0 {
+			return ""
+		}
+		return strings.ToLower(r.Matches[0].Licenses[0].Name)
+	}},
+	SortByMatchedLines: {number: func(r *Result) float64 {
+		if len(r.Matches) == 0 {
+			return 0
+		}
+		ranges, _, err := ParseLineRanges(r.Matches[0].Lines)
+		if err != nil {
+			return 0
+		}
+		return float64(countMatchedLines(ranges))
+	}},
+	SortByWorkflowState: {text: func(r *Result) string {
+		return string(resultSettings().GetResultWorkflowState(*r))
+	}},
+	SortByDecision: {text: func(r *Result) string {
+		return string(resultSettings().GetResultFilterConfig(*r).Action)
+	}},
+	SortByReleaseDate: {text: func(r *Result) string {
+		if len(r.Matches) == 0 {
+			return ""
+		}
+		return r.Matches[0].ReleaseDate
+	}},
+	SortByFolderDepth: {number: func(r *Result) float64 {
+		return float64(strings.Count(strings.Trim(r.Path, "/"), "/"))
+	}},
+}
+
+// NewResultComparator builds a comparator over results for every sort key, breaking remaining ties by path.
+// Sort values are read once per result up front, so comparisons stay cheap on large result sets.
+// Unknown options sort by match percentage.
+func NewResultComparator(results []Result, config SortConfig) ResultComparator {
+	var comparator ResultComparator
+	for _, key := range config.Keys() {
+		next := newResultKeyComparator(results, key)
+		if comparator == nil {
+			comparator = next
+			continue
+		}
+		comparator = comparator.Then(next)
+	}
+
+	return comparator.Then(func(i, j int) int {
+		return strings.Compare(results[i].Path, results[j].Path)
+	})
+}
+
+func newResultKeyComparator(results []Result, key SortKey) ResultComparator {
+	value, ok := resultSortValues[key.Option]
+	if !ok {
+		value = resultSortValues[SortByMatchPercentage]
+	}
+	descending := key.Order != SortOrderAsc
+
+	if value.number != nil {
+		numbers := make([]float64, len(results))
+		for i := range results {
+			numbers[i] = value.number(&results[i])
+		}
+		return func(i, j int) int {
+			if descending {
+				return cmp.Compare(numbers[j], numbers[i])
+			}
+			return cmp.Compare(numbers[i], numbers[j])
+		}
+	}
+
+	texts := make([]string, len(results))
+	for i := range results {
+		texts[i] = value.text(&results[i])
+	}
+	return func(i, j int) int {
+		// Results without a value go last in either order
+		if (texts[i] == "") != (texts[j] == "") {
+			if texts[i] == "" {
+				return 1
+			}
+			return -1
+		}
+		if descending {
+			return strings.Compare(texts[j], texts[i])
+		}
+		return strings.Compare(texts[i], texts[j])
+	}
+}
+
+// SortResults sorts results in place by the given sort config.
+func SortResults(results []Result, config SortConfig) {
+	comparator := NewResultComparator(results, config)
+
+	order := make([]int, len(results))
+	for i := range order {
+		order[i] = i
+	}
+	// Falling back to the original position keeps equal results in input order without a slower stable sort
+	slices.SortFunc(order, comparator.Then(cmp.Compare[int]))
+
+	sorted := make([]Result, len(results))
+	for i, index := range order {
+		sorted[i] = results[index]
+	}
+	copy(results, sorted)
+}
@@ -0,0 +1,136 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func resultPaths(results []Result) []string {
+	paths := make([]string, len(results))
+	for i, r := range results {
+		paths[i] = r.Path
+	}
+	return paths
+}
+
+func TestSortResults(t *testing.T) {
+	results := []Result{
+		{Path: "b/deep/x.c", MatchType: string(MatchTypeSnippet), Matches: []Component{{Matched: "80%", Vendor: "gnu", Lines: "1-10", ReleaseDate: "2020-01-01"}}},
+		{Path: "a.c", MatchType: string(MatchTypeFile), Matches: []Component{{Vendor: "Apache", Lines: "all", ReleaseDate: "2023-05-01"}}},
+		{Path: "c/y.c", MatchType: string(MatchTypeSnippet), Matches: []Component{{Matched: "80%", Vendor: "gnu", Lines: "1-40"}}},
+		{Path: "d.c", MatchType: MatchTypeNone},
+	}
+
+	tests := []struct {
+		name     string
+		config   SortConfig
+		expected []string
+	}{
+		{"default", SortConfig{}, []string{"a.c", "b/deep/x.c", "c/y.c", "d.c"}},
+		{"path ascending", SortConfig{Option: SortByPath, Order: SortOrderAsc}, []string{"a.c", "b/deep/x.c", "c/y.c", "d.c"}},
+		{"path descending", SortConfig{Option: SortByPath, Order: SortOrderDesc}, []string{"d.c", "c/y.c", "b/deep/x.c", "a.c"}},
+		{"vendor ignores case and keeps empty values last", SortConfig{Option: SortByVendor, Order: SortOrderAsc}, []string{"a.c", "b/deep/x.c", "c/y.c", "d.c"}},
+		{"release date descending", SortConfig{Option: SortByReleaseDate, Order: SortOrderDesc}, []string{"a.c", "b/deep/x.c", "c/y.c", "d.c"}},
+		{"folder depth", SortConfig{Option: SortByFolderDepth, Order: SortOrderDesc}, []string{"b/deep/x.c", "c/y.c", "a.c", "d.c"}},
+		{
+			"multiple keys",
+			SortConfig{
+				Option: SortByVendor,
+				Order:  SortOrderDesc,
+				ThenBy: []SortKey{{Option: SortByMatchedLines, Order: SortOrderDesc}},
+			},
+			[]string{"c/y.c", "b/deep/x.c", "a.c", "d.c"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted := append([]Result(nil), results...)
+			SortResults(sorted, tt.config)
+			assert.Equal(t, tt.expected, resultPaths(sorted))
+		})
+	}
+}
+
+func TestSortResults_WorkflowStateAndDecision(t *testing.T) {
+	purl := []string{"pkg:npm/lodash"}
+	results := []Result{
+		{Path: "pending.js", Purl: &purl},
+		{Path: "removed.js", Purl: &purl},
+		{Path: "included.js", Purl: &purl},
+	}
+
+	previous := ScanossSettingsJson
+	ScanossSettingsJson = &ScanossSettings{SettingsFile: &SettingsFile{Bom: Bom{
+		Include: []ComponentFilter{{Path: "included.js", Purl: purl[0]}},
+		Remove:  []ComponentFilter{{Path: "removed.js", Purl: purl[0]}},
+	}}}
+	t.Cleanup(func() { ScanossSettingsJson = previous })
+
+	SortResults(results, SortConfig{Option: SortByWorkflowState, Order: SortOrderDesc})
+	assert.Equal(t, []string{"pending.js", "included.js", "removed.js"}, resultPaths(results))
+
+	SortResults(results, SortConfig{Option: SortByDecision, Order: SortOrderAsc})
+	assert.Equal(t, []string{"included.js", "removed.js", "pending.js"}, resultPaths(results))
+}
+
+func BenchmarkSortResults(b *testing.B) {
+	licenses := []string{"MIT", "Apache-2.0", "GPL-2.0-only", "BSD-3-Clause"}
+	results := make([]Result, 100_000)
+	for i := range results {
+		results[i] = Result{
+			Path:      fmt.Sprintf("src/module%d/pkg%d/file%d.go", i%97, i%13, i),
+			MatchType: string(MatchTypeSnippet),
+			Matches: []Component{{
+				Matched:     fmt.Sprintf("%d%%", i%100),
+				Vendor:      fmt.Sprintf("vendor%d", i%50),
+				Lines:       fmt.Sprintf("1-%d", i%300+1),
+				ReleaseDate: fmt.Sprintf("20%02d-01-01", i%25),
+				Licenses:    []MatchLicense{{Name: licenses[i%len(licenses)]}},
+			}},
+		}
+	}
+	config := SortConfig{
+		Option: SortByLicense,
+		Order:  SortOrderAsc,
+		ThenBy: []SortKey{
+			{Option: SortByMatchPercentage, Order: SortOrderDesc},
+			{Option: SortByMatchedLines, Order: SortOrderDesc},
+		},
+	}
+
+	b.ResetTimer()
+	for b.Loop() {
+		b.StopTimer()
+		input := append([]Result(nil), results...)
+		b.StartTimer()
+		SortResults(input, config)
+	}
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// SBOMSpecVersion is the CycloneDX version of the exported SBOM, the same as the CBOM.
+const SBOMSpecVersion = CBOMSpecVersion
+
+// SBOM is a CycloneDX document listing the components identified in the scanned project.
+type SBOM struct {
+	BomFormat    string          `json:"bomFormat"`
+	SpecVersion  string          `json:"specVersion"`
+	SerialNumber string          `json:"serialNumber"`
+	Version      int             `json:"version"`
+	Metadata     CBOMMetadata    `json:"metadata"`
+	Components   []SBOMComponent `json:"components"`
+}
+
+type SBOMComponent struct {
+	Type     string        `json:"type"`
+	BomRef   string        `json:"bom-ref"`
+	Name     string        `json:"name"`
+	Version  string        `json:"version,omitempty"`
+	Purl     string        `json:"purl"`
+	Licenses []SBOMLicense `json:"licenses,omitempty"`
+	Evidence *CBOMEvidence `json:"evidence,omitempty"`
+}
+
+type SBOMLicense struct {
+	License SBOMLicenseChoice `json:"license"`
+}
+
+// SBOMLicenseChoice holds an SPDX ID, or a name for licenses that cannot be one.
+type SBOMLicenseChoice struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// SBOMEntry is an identified component with the files it was found in.
+type SBOMEntry struct {
+	Purl     string
+	Name     string
+	Version  string
+	Licenses []string
+	Files    []string
+}
+
+// NewSBOM builds a CycloneDX SBOM with one library component per purl, sorted by purl.
+func NewSBOM(entries []SBOMEntry, serialNumber string, timestamp time.Time) SBOM {
+	sbom := SBOM{
+		BomFormat:    "CycloneDX",
+		SpecVersion:  SBOMSpecVersion,
+		SerialNumber: "urn:uuid:" + serialNumber,
+		Version:      1,
+		Components:   []SBOMComponent{},
+	}
+	sbom.Metadata.Timestamp = timestamp.UTC().Format(time.RFC3339)
+	sbom.Metadata.Tools.Components = []CBOMComponent{{Type: "application", Name: "scanoss-cc", Version: AppVersion}}
+
+	for _, entry := range entries {
+		version := entry.Version
+		if version == "" {
+			version = purlVersion(entry.Purl)
+		}
+		component := SBOMComponent{
+			Type:     "library",
+			BomRef:   entry.Purl,
+			Name:     entry.Name,
+			Version:  version,
+			Purl:     entry.Purl,
+			Evidence: &CBOMEvidence{Occurrences: []CBOMOccurrence{}},
+		}
+		for _, license := range entry.Licenses {
+			component.Licenses = append(component.Licenses, newSBOMLicense(license))
+		}
+		for _, file := range entry.Files {
+			component.Evidence.Occurrences = append(component.Evidence.Occurrences, CBOMOccurrence{Location: file})
+		}
+		sbom.Components = append(sbom.Components, component)
+	}
+	sort.Slice(sbom.Components, func(i, j int) bool {
+		return sbom.Components[i].Purl < sbom.Components[j].Purl
+	})
+
+	return sbom
+}
+
+func newSBOMLicense(license string) SBOMLicense {
+	if strings.ContainsAny(license, " ()") {
+		return SBOMLicense{License: SBOMLicenseChoice{Name: license}}
+	}
+	return SBOMLicense{License: SBOMLicenseChoice{ID: license}}
+}
+
+// purlVersion returns the version of a purl such as pkg:npm/react@18.2.0, empty when it has none.
+func purlVersion(purl string) string {
+	purl, _, _ = strings.Cut(purl, "?")
+	purl, _, _ = strings.Cut(purl, "#")
+	index := strings.LastIndex(purl, "@")
+	if index == -1 {
+		return ""
+	}
+	return purl[index+1:]
+}
@@ -0,0 +1,62 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSBOM(t *testing.T) {
+	timestamp := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	entries := []SBOMEntry{
+		{Purl: "pkg:npm/react@18.2.0", Name: "react", Licenses: []string{"MIT"}, Files: []string{"src/react.js"}},
+		{Purl: "pkg:github/madler/zlib", Name: "zlib", Version: "1.3", Licenses: []string{"Zlib", "GPL-2.0-only WITH Classpath-exception-2.0"}, Files: []string{"zlib/inflate.c", "zlib/deflate.c"}},
+	}
+
+	sbom := NewSBOM(entries, "1234", timestamp)
+
+	assert.Equal(t, "CycloneDX", sbom.BomFormat)
+	assert.Equal(t, "urn:uuid:1234", sbom.SerialNumber)
+	assert.Equal(t, "2026-05-01T12:00:00Z", sbom.Metadata.Timestamp)
+	require.Len(t, sbom.Components, 2)
+
+	zlib := sbom.Components[0]
+	assert.Equal(t, "pkg:github/madler/zlib", zlib.Purl)
+	assert.Equal(t, "1.3", zlib.Version)
+	assert.Equal(t, []SBOMLicense{
+		{License: SBOMLicenseChoice{ID: "Zlib"}},
+		{License: SBOMLicenseChoice{Name: "GPL-2.0-only WITH Classpath-exception-2.0"}},
+	}, zlib.Licenses)
+	assert.Len(t, zlib.Evidence.Occurrences, 2)
+
+	react := sbom.Components[1]
+	assert.Equal(t, "18.2.0", react.Version, "version is taken from the purl when not known")
+	assert.Equal(t, "pkg:npm/react@18.2.0", react.BomRef)
+}
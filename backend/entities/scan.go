@@ -23,6 +23,36 @@
 
 package entities
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Scanner identifies the scan engine used to produce results.json.
+type Scanner string
+
+const (
+	// ScannerPython runs the scanoss-py command line tool
+	ScannerPython Scanner = "python"
+	// ScannerNative fingerprints and posts files from Go, without a Python install
+	ScannerNative Scanner = "native"
+)
+
+var ErrUnsupportedScanner = errors.New("unsupported scanner")
+
+// ParseScanner validates a user supplied scanner name. An empty value means the scanoss-py scanner.
+func ParseScanner(value string) (Scanner, error) {
+	switch Scanner(strings.ToLower(strings.TrimSpace(value))) {
+	case "", ScannerPython:
+		return ScannerPython, nil
+	case ScannerNative:
+		return ScannerNative, nil
+	default:
+		return "", fmt.Errorf("%w: %q, expected python or native", ErrUnsupportedScanner, value)
+	}
+}
+
 type ScanResponse struct {
 	Output    string `json:"output,omitempty"`
 	ErrOutput string `json:"error_output,omitempty"`
@@ -0,0 +1,376 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidScanArgs = errors.New("invalid scan arguments")
+
+// scanArgRange bounds an int argument. A zero max means no upper bound.
+type scanArgRange struct {
+	min int
+	max int
+}
+
+var scanArgRanges = map[string]scanArgRange{
+	"threads":    {min: 1, max: 64},
+	"post-size":  {min: 1, max: 1024},
+	"timeout":    {min: 1},
+	"sc-timeout": {min: 1},
+}
+
+var scanArgChoices = map[string][]string{
+	"dep-scope": {"dev", "prod"},
+}
+
+// scanArgConflicts are pairs of arguments that cannot be used together.
+var scanArgConflicts = [][2]string{
+	{"wfp", "dep"},
+	{"wfp", "stdin"},
+	{"wfp", "files"},
+	{"dep", "stdin"},
+	{"dep", "files"},
+	{"stdin", "files"},
+	{"dependencies-only", "wfp"},
+	{"dependencies-only", "stdin"},
+	{"dep-scope", "dep-scope-inc"},
+	{"dep-scope", "dep-scope-exc"},
+	{"settings", "skip-settings-file"},
+}
+
+// scanInputArgs replace the scanned folder.
+var scanInputArgs = []string{"wfp", "dep", "stdin"}
+
+// ScanRequest is a scan described with typed options keyed by ScanArgDef name, as sent by the scan dialog.
+// ExtraArgs are command line style arguments typed by hand, parsed and validated like the options.
+type ScanRequest struct {
+	Path      string         `json:"path"`
+	Options   map[string]any `json:"options"`
+	ExtraArgs []string       `json:"extra_args,omitempty"`
+}
+
+// Args validates the request and returns the scanner arguments. Extra arguments take precedence over options.
+func (r ScanRequest) Args() ([]string, error) {
+	path, extra, err := ParseScanArgs(r.ExtraArgs)
+	if err != nil {
+		return nil, err
+	}
+	if path != "" && r.Path != "" && path != r.Path {
+		return nil, fmt.Errorf("%w: extra arguments cannot scan another folder (%s)", ErrInvalidScanArgs, path)
+	}
+	if r.Path != "" {
+		path = r.Path
+	}
+
+	options := make(map[string]any, len(r.Options)+len(extra))
+	for name, value := range r.Options {
+		options[name] = value
+	}
+	for name, value := range extra {
+		options[name] = value
+	}
+
+	if path == "" && !slices.ContainsFunc(append(scanInputArgs, "files"), func(name string) bool { return options[name] != nil }) {
+		return nil, fmt.Errorf("%w: a folder to scan is required", ErrInvalidScanArgs)
+	}
+
+	return BuildScanArgs(path, options)
+}
+
+// BuildScanArgs validates typed options keyed by ScanArgDef name and returns the scanner arguments, the folder
+// first and then the options in ScanArguments order. Options left at their default value are not passed.
+// Every problem found is reported, joined in a single error wrapping ErrInvalidScanArgs.
+func BuildScanArgs(path string, options map[string]any) ([]string, error) {
+	var errs []error
+	invalid := func(format string, a ...any) {
+		errs = append(errs, fmt.Errorf("%w: %s", ErrInvalidScanArgs, fmt.Sprintf(format, a...)))
+	}
+
+	values := make(map[string]any, len(options))
+	for name, value := range options {
+		def, ok := findScanArgDef(name)
+		if !ok {
+			invalid("unknown scan argument --%s", name)
+			continue
+		}
+
+		normalized, err := normalizeScanArgValue(def, value)
+		if err != nil {
+			invalid("%s", err)
+			continue
+		}
+		if isDefaultScanArgValue(def, normalized) {
+			continue
+		}
+		values[name] = normalized
+	}
+
+	for name, value := range values {
+		if r, ok := scanArgRanges[name]; ok {
+			if v := value.(int); v < r.min || (r.max > 0 && v > r.max) {
+				if r.max > 0 {
+					invalid("--%s must be between %d and %d, got %d", name, r.min, r.max, v)
+				} else {
+					invalid("--%s must be at least %d, got %d", name, r.min, v)
+				}
+			}
+		}
+		if choices, ok := scanArgChoices[name]; ok && !slices.Contains(choices, value.(string)) {
+			invalid("--%s must be one of %s, got %q", name, strings.Join(choices, ", "), value)
+		}
+	}
+
+	for _, conflict := range scanArgConflicts {
+		_, first := values[conflict[0]]
+		_, second := values[conflict[1]]
+		if first && second {
+			invalid("--%s cannot be combined with --%s", conflict[0], conflict[1])
+		}
+	}
+	if path != "" {
+		for _, name := range scanInputArgs {
+			if _, ok := values[name]; ok {
+				invalid("--%s cannot be combined with a folder to scan", name)
+			}
+		}
+	}
+
+	// The output file is created by the scan, every other selected file must exist
+	for _, def := range ScanArguments {
+		value, ok := values[def.Name]
+		if !ok || !def.IsFileSelector || def.Name == "output" {
+			continue
+		}
+		if _, err := os.Stat(value.(string)); err != nil {
+			invalid("--%s file %s does not exist", def.Name, value)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	args := make([]string, 0, len(values)*2+1)
+	if path != "" {
+		args = append(args, path)
+	}
+	for _, def := range ScanArguments {
+		value, ok := values[def.Name]
+		if !ok {
+			continue
+		}
+		switch v := value.(type) {
+		case bool:
+			args = append(args, "--"+def.Name)
+		case []string:
+			args = append(args, "--"+def.Name, strings.Join(v, ","))
+		default:
+			args = append(args, "--"+def.Name, fmt.Sprint(v))
+		}
+	}
+
+	return args, nil
+}
+
+// ParseScanArgs reads command line style scanner arguments into typed options. Long and short names are
+// accepted, with the value as the next argument or after "=". An argument not starting with a dash is the
+// folder to scan.
+func ParseScanArgs(args []string) (string, map[string]any, error) {
+	path := ""
+	options := make(map[string]any)
+
+	for i := 0; i < len(args); i++ {
+		arg := strings.TrimSpace(args[i])
+		if arg == "" {
+			continue
+		}
+		if !strings.HasPrefix(arg, "-") {
+			if path != "" {
+				return "", nil, fmt.Errorf("%w: unexpected argument %q", ErrInvalidScanArgs, arg)
+			}
+			path = arg
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		def, ok := findScanArgDef(name)
+		if !ok && !strings.HasPrefix(arg, "--") {
+			def, ok = findScanArgDefByShorthand(name)
+		}
+		if !ok {
+			return "", nil, fmt.Errorf("%w: unknown scan argument %s", ErrInvalidScanArgs, arg)
+		}
+
+		if isBoolScanArg(def) {
+			if !hasValue {
+				options[def.Name] = true
+				continue
+			}
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return "", nil, fmt.Errorf("%w: --%s expects a boolean, got %q", ErrInvalidScanArgs, def.Name, value)
+			}
+			options[def.Name] = b
+			continue
+		}
+
+		if !hasValue {
+			if i+1 >= len(args) {
+				return "", nil, fmt.Errorf("%w: missing value for --%s", ErrInvalidScanArgs, def.Name)
+			}
+			i++
+			value = args[i]
+		}
+
+		switch def.Type {
+		case "int":
+			v, err := strconv.Atoi(value)
+			if err != nil {
+				return "", nil, fmt.Errorf("%w: --%s expects an integer, got %q", ErrInvalidScanArgs, def.Name, value)
+			}
+			options[def.Name] = v
+		case "stringSlice":
+			existing, _ := options[def.Name].([]string)
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					existing = append(existing, item)
+				}
+			}
+			options[def.Name] = existing
+		default:
+			options[def.Name] = value
+		}
+	}
+
+	return path, options, nil
+}
+
+// normalizeScanArgValue checks a value against the argument type and returns it as a bool, int, string or
+// []string. Numbers decoded from JSON are accepted for int arguments when they are whole.
+func normalizeScanArgValue(def ScanArgDef, value any) (any, error) {
+	switch {
+	case isBoolScanArg(def):
+		if b, ok := value.(bool); ok {
+			return b, nil
+		}
+	case def.Type == "int":
+		switch v := value.(type) {
+		case int:
+			if v >= 0 {
+				return v, nil
+			}
+		case float64:
+			if v >= 0 && v == math.Trunc(v) {
+				return int(v), nil
+			}
+		}
+		return nil, fmt.Errorf("--%s expects a non negative integer, got %v", def.Name, value)
+	case def.Type == "string":
+		if s, ok := value.(string); ok {
+			return strings.TrimSpace(s), nil
+		}
+	case def.Type == "stringSlice":
+		switch v := value.(type) {
+		case []string:
+			return v, nil
+		case []any:
+			items := make([]string, 0, len(v))
+			for _, item := range v {
+				s, ok := item.(string)
+				if !ok {
+					return nil, fmt.Errorf("--%s expects a list of strings, got %v", def.Name, value)
+				}
+				items = append(items, s)
+			}
+			return items, nil
+		}
+	}
+
+	return nil, fmt.Errorf("--%s expects a %s, got %v", def.Name, scanArgTypeName(def), value)
+}
+
+// formatScanArgValue checks a value against the argument type and formats it as a command line flag value.
+func formatScanArgValue(def ScanArgDef, value any) (string, error) {
+	normalized, err := normalizeScanArgValue(def, value)
+	if err != nil {
+		return "", err
+	}
+	if items, ok := normalized.([]string); ok {
+		return strings.Join(items, ","), nil
+	}
+	return fmt.Sprint(normalized), nil
+}
+
+func isDefaultScanArgValue(def ScanArgDef, value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return !v
+	case string:
+		return v == "" || v == def.Default
+	case []string:
+		return len(v) == 0
+	default:
+		return value == def.Default
+	}
+}
+
+func findScanArgDef(name string) (ScanArgDef, bool) {
+	index := slices.IndexFunc(ScanArguments, func(def ScanArgDef) bool { return def.Name == name })
+	if index == -1 {
+		return ScanArgDef{}, false
+	}
+	return ScanArguments[index], true
+}
+
+func findScanArgDefByShorthand(shorthand string) (ScanArgDef, bool) {
+	index := slices.IndexFunc(ScanArguments, func(def ScanArgDef) bool { return def.Shorthand != "" && def.Shorthand == shorthand })
+	if index == -1 {
+		return ScanArgDef{}, false
+	}
+	return ScanArguments[index], true
+}
+
+// isBoolScanArg reports whether the argument is a switch. Untyped arguments such as --skip-settings-file are switches too.
+func isBoolScanArg(def ScanArgDef) bool {
+	return def.Type == "bool" || def.Type == ""
+}
+
+func scanArgTypeName(def ScanArgDef) string {
+	switch {
+	case isBoolScanArg(def):
+		return "boolean"
+	case def.Type == "stringSlice":
+		return "list of strings"
+	default:
+		return def.Type
+	}
+}
@@ -0,0 +1,133 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildScanArgs(t *testing.T) {
+	settings := filepath.Join(t.TempDir(), "scanoss.json")
+	require.NoError(t, os.WriteFile(settings, []byte(`{}`), 0o644))
+
+	args, err := BuildScanArgs("/src", map[string]any{
+		"settings":     settings,
+		"threads":      float64(10),
+		"timeout":      180, // default, not passed
+		"format":       "plain",
+		"dependencies": true,
+		"debug":        false,
+		"output":       "/src/.scanoss/results.json",
+		"files":        []string{},
+		"dep-scope":    "prod",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"/src",
+		"--output", "/src/.scanoss/results.json",
+		"--threads", "10",
+		"--dependencies",
+		"--dep-scope", "prod",
+		"--settings", settings,
+	}, args)
+}
+
+func TestBuildScanArgsValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		options map[string]any
+		wantErr []string
+	}{
+		{name: "Unknown argument", path: ".", options: map[string]any{"turbo": true}, wantErr: []string{"unknown scan argument --turbo"}},
+		{name: "Wrong type", path: ".", options: map[string]any{"threads": "10"}, wantErr: []string{"--threads expects a non negative integer"}},
+		{name: "Out of range", path: ".", options: map[string]any{"threads": 0, "post-size": 4096}, wantErr: []string{
+			"--threads must be between 1 and 64, got 0",
+			"--post-size must be between 1 and 1024, got 4096",
+		}},
+		{name: "Invalid choice", path: ".", options: map[string]any{"dep-scope": "test"}, wantErr: []string{"--dep-scope must be one of dev, prod"}},
+		{name: "Mutually exclusive", options: map[string]any{"dependencies-only": true, "wfp": "scan.wfp"}, wantErr: []string{
+			"--dependencies-only cannot be combined with --wfp",
+			"--wfp file scan.wfp does not exist",
+		}},
+		{name: "Input argument with a folder", path: ".", options: map[string]any{"stdin": "main.c"}, wantErr: []string{"--stdin cannot be combined with a folder to scan"}},
+		{name: "Missing settings file", path: ".", options: map[string]any{"settings": "/does/not/exist.json"}, wantErr: []string{"--settings file /does/not/exist.json does not exist"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := BuildScanArgs(tt.path, tt.options)
+			require.ErrorIs(t, err, ErrInvalidScanArgs)
+			for _, want := range tt.wantErr {
+				assert.ErrorContains(t, err, want)
+			}
+		})
+	}
+}
+
+func TestParseScanArgs(t *testing.T) {
+	path, options, err := ParseScanArgs([]string{"src", "--threads", "8", "-M", "60", "--debug", "--format=json", "--files", "a.c,b.c", "", "--skip-settings-file"})
+	require.NoError(t, err)
+	assert.Equal(t, "src", path)
+	assert.Equal(t, map[string]any{
+		"threads":            8,
+		"timeout":            60,
+		"debug":              true,
+		"format":             "json",
+		"files":              []string{"a.c", "b.c"},
+		"skip-settings-file": true,
+	}, options)
+
+	_, _, err = ParseScanArgs([]string{"--threads"})
+	assert.ErrorContains(t, err, "missing value for --threads")
+
+	_, _, err = ParseScanArgs([]string{"--threads", "many"})
+	assert.ErrorContains(t, err, "--threads expects an integer")
+
+	_, _, err = ParseScanArgs([]string{"--nope"})
+	assert.ErrorContains(t, err, "unknown scan argument --nope")
+}
+
+func TestScanRequestArgs(t *testing.T) {
+	args, err := ScanRequest{
+		Path:      "/src",
+		Options:   map[string]any{"threads": float64(5), "retry": float64(2)},
+		ExtraArgs: []string{"--retry", "3", "--trace"},
+	}.Args()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/src", "--retry", "3", "--trace"}, args)
+
+	_, err = ScanRequest{Options: map[string]any{"debug": true}}.Args()
+	assert.ErrorContains(t, err, "a folder to scan is required")
+
+	_, err = ScanRequest{Path: "/src", ExtraArgs: []string{"/other"}}.Args()
+	assert.ErrorContains(t, err, "extra arguments cannot scan another folder")
+}
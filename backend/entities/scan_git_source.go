@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrScanGitSourceNotFound = errors.New("scan git source not found")
+
+// ScanGitSource records the git commit a results file was scanned from, so file contents can be read from the
+// git object store instead of the working tree.
+type ScanGitSource struct {
+	Ref       string    `json:"ref"`
+	Commit    string    `json:"commit"`
+	Path      string    `json:"path,omitempty"` // Scanned folder relative to the repository root, empty for the root
+	ScannedAt time.Time `json:"scanned_at"`
+}
+
+// GitRefScanRequest describes a scan of a folder as it was at a git ref.
+type GitRefScanRequest struct {
+	Root   string   `json:"root" validate:"required"`
+	Ref    string   `json:"ref" validate:"required"`
+	Output string   `json:"output,omitempty"` // Defaults to <root>/.scanoss/results-<short sha>.json
+	Args   []string `json:"args,omitempty"`   // Other scan arguments, passed through to the scanner
+}
+
+// GitRefScan is the outcome of a git ref scan: the results file written and the commit it was scanned from.
+type GitRefScan struct {
+	Output string        `json:"output"`
+	Source ScanGitSource `json:"source"`
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrScanRunNotFound = errors.New("scan run not found")
+	ErrReadOnlyResults = errors.New("results of a past scan are read-only")
+)
+
+// ScanRun is a snapshot of a results file kept in the scan history.
+type ScanRun struct {
+	ID           string    `json:"id"`
+	CreatedAt    time.Time `json:"created_at"`
+	Scanner      string    `json:"scanner"`
+	Args         []string  `json:"args"`
+	SettingsHash string    `json:"settings_hash,omitempty"` // SHA-256 of the scanoss.json used for the scan, empty without one
+	ResultCount  int       `json:"result_count"`
+	ResultsFile  string    `json:"results_file"` // Path of the snapshot, filled in when the run is read
+}
+
+// ScanHistoryRetention limits how many runs are kept. Zero disables a limit.
+type ScanHistoryRetention struct {
+	MaxRuns    int `json:"max_runs"`
+	MaxAgeDays int `json:"max_age_days"`
+}
+
+// Expired returns the runs the policy removes, given runs sorted from newest to oldest.
+func (r ScanHistoryRetention) Expired(runs []ScanRun, now time.Time) []ScanRun {
+	var expired []ScanRun
+	for i, run := range runs {
+		tooMany := r.MaxRuns > 0 && i >= r.MaxRuns
+		tooOld := r.MaxAgeDays > 0 && now.Sub(run.CreatedAt) > time.Duration(r.MaxAgeDays)*24*time.Hour
+		if tooMany || tooOld {
+			expired = append(expired, run)
+		}
+	}
+	return expired
+}
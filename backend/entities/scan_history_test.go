@@ -0,0 +1,68 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanHistoryRetentionExpired(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	runs := []ScanRun{
+		{ID: "today", CreatedAt: now.Add(-time.Hour)},
+		{ID: "yesterday", CreatedAt: now.Add(-24 * time.Hour)},
+		{ID: "last-week", CreatedAt: now.Add(-7 * 24 * time.Hour)},
+		{ID: "last-month", CreatedAt: now.Add(-30 * 24 * time.Hour)},
+	}
+
+	ids := func(runs []ScanRun) []string {
+		result := []string{}
+		for _, run := range runs {
+			result = append(result, run.ID)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name      string
+		retention ScanHistoryRetention
+		expected  []string
+	}{
+		{name: "No limits", retention: ScanHistoryRetention{}, expected: []string{}},
+		{name: "Max runs", retention: ScanHistoryRetention{MaxRuns: 2}, expected: []string{"last-week", "last-month"}},
+		{name: "Max age", retention: ScanHistoryRetention{MaxAgeDays: 3}, expected: []string{"last-week", "last-month"}},
+		{name: "Both limits", retention: ScanHistoryRetention{MaxRuns: 3, MaxAgeDays: 10}, expected: []string{"last-month"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ids(tt.retention.Expired(runs, now)))
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrScanManifestNotFound = errors.New("scan manifest not found")
+
+// ScanManifestEntry is what an incremental scan remembers about a scanned file. The MD5 is only recomputed when
+// the size or modification time changed.
+type ScanManifestEntry struct {
+	MD5     string    `json:"md5"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+}
+
+// ScanManifest lists the files behind a results file, keyed by their path relative to the scan root.
+type ScanManifest struct {
+	ScannedAt time.Time                    `json:"scanned_at"`
+	Files     map[string]ScanManifestEntry `json:"files"`
+}
+
+// ScanChanges are the files an incremental scan has to rescan and the files to drop from the results,
+// relative to the scan root.
+type ScanChanges struct {
+	Changed []string `json:"changed"`
+	Deleted []string `json:"deleted"`
+}
+
+func (c ScanChanges) IsEmpty() bool {
+	return len(c.Changed) == 0 && len(c.Deleted) == 0
+}
+
+// IncrementalScanRequest describes a rescan of the changed files of a folder into an existing results file.
+type IncrementalScanRequest struct {
+	Root   string   `json:"root" validate:"required"`
+	Output string   `json:"output" validate:"required"`
+	Since  string   `json:"since,omitempty"` // Git ref to diff against, empty to compare with the scan manifest
+	Args   []string `json:"args,omitempty"`  // Other scan arguments, passed through to the scanner
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// scanOutputRule maps a line printed by the scanner to a progress update.
+type scanOutputRule struct {
+	pattern *regexp.Regexp
+	apply   func(tracker *ScanProgressTracker, match []string)
+}
+
+// scanOutputRules recognise the messages scanoss-py prints while scanning. Its output is not a stable format,
+// so unknown lines are ignored and only reach the UI as raw output.
+var scanOutputRules = []scanOutputRule{
+	{
+		pattern: regexp.MustCompile(`(?i)^Searching .+ for files to fingerprint`),
+		apply: func(tracker *ScanProgressTracker, _ []string) {
+			tracker.SetPhase(ScanPhaseCollecting)
+		},
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)\b(?:Found|Fingerprinting) (\d+) files\b`),
+		apply: func(tracker *ScanProgressTracker, match []string) {
+			tracker.SetFilesFound(atoiOrZero(match[1]))
+			tracker.SetPhase(ScanPhaseFingerprinting)
+		},
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)\b(?:Posting|process|Processing) (\d+) requests\b`),
+		apply: func(tracker *ScanProgressTracker, match []string) {
+			tracker.SetBatchesTotal(atoiOrZero(match[1]))
+			tracker.SetPhase(ScanPhaseScanning)
+		},
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)^Sending request\b`),
+		apply: func(tracker *ScanProgressTracker, _ []string) {
+			tracker.BatchSent()
+		},
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)^Scanned request (\d+) of (\d+)`),
+		apply: func(tracker *ScanProgressTracker, match []string) {
+			tracker.BatchCompleted()
+		},
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)^(?:ERROR|Warning):? .*(?:request|scan|timeout|response)`),
+		apply: func(tracker *ScanProgressTracker, match []string) {
+			tracker.BatchFailed(0, 0, match[0])
+		},
+	},
+}
+
+// ParseScanOutputLine updates tracker from one line of scanner output. It reports whether the line was recognised.
+func ParseScanOutputLine(tracker *ScanProgressTracker, line string) bool {
+	line = strings.TrimSpace(line)
+	for _, rule := range scanOutputRules {
+		if match := rule.pattern.FindStringSubmatch(line); match != nil {
+			rule.apply(tracker, match)
+			return true
+		}
+	}
+	return false
+}
+
+func atoiOrZero(value string) int {
+	n, _ := strconv.Atoi(value)
+	return n
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrScanPresetNotFound = errors.New("scan preset not found")
+	ErrInvalidScanPreset  = errors.New("invalid scan preset")
+)
+
+// ScanPresetScope is where a preset is stored. Project presets override user presets with the same name.
+type ScanPresetScope string
+
+const (
+	ScanPresetScopeUser    ScanPresetScope = "user"
+	ScanPresetScopeProject ScanPresetScope = "project"
+)
+
+// This is necessary to bind the enum in main.go
+var AllScanPresetScopes = []struct {
+	Value  ScanPresetScope
+	TSName string
+}{
+	{ScanPresetScopeUser, "User"},
+	{ScanPresetScopeProject, "Project"},
+}
+
+// scanPresetExcludedArgs select what is scanned rather than how, so they do not belong in a preset.
+var scanPresetExcludedArgs = []string{"wfp", "dep", "stdin", "files"}
+
+// ScanPreset is a named set of scan arguments, keyed by ScanArgDef name.
+type ScanPreset struct {
+	Name        string          `json:"name" validate:"required"`
+	Description string          `json:"description,omitempty"`
+	Args        map[string]any  `json:"args"`
+	Scope       ScanPresetScope `json:"scope,omitempty"` // Set when the preset is read, not stored
+}
+
+type ScanPresetsFile struct {
+	Presets []ScanPreset `json:"presets"`
+}
+
+// Validate checks every argument against its ScanArgDef type.
+func (p ScanPreset) Validate() error {
+	_, err := p.FlagValues()
+	return err
+}
+
+// FlagValues returns the preset arguments formatted as command line flag values.
+func (p ScanPreset) FlagValues() (map[string]string, error) {
+	if strings.TrimSpace(p.Name) == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidScanPreset)
+	}
+
+	values := make(map[string]string, len(p.Args))
+	for name, value := range p.Args {
+		def, ok := findScanArgDef(name)
+		if !ok {
+			return nil, fmt.Errorf("%w %q: unknown scan argument %q", ErrInvalidScanPreset, p.Name, name)
+		}
+		if slices.Contains(scanPresetExcludedArgs, name) {
+			return nil, fmt.Errorf("%w %q: --%s cannot be set in a preset", ErrInvalidScanPreset, p.Name, name)
+		}
+
+		formatted, err := formatScanArgValue(def, value)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrInvalidScanPreset, p.Name, err)
+		}
+		values[name] = formatted
+	}
+
+	return values, nil
+}
+
+// ScanArgs returns the preset as scanner arguments, in ScanArguments order.
+func (p ScanPreset) ScanArgs() ([]string, error) {
+	values, err := p.FlagValues()
+	if err != nil {
+		return nil, err
+	}
+
+	args := make([]string, 0, len(values)*2)
+	for _, def := range ScanArguments {
+		value, ok := values[def.Name]
+		if !ok {
+			continue
+		}
+		if isBoolScanArg(def) {
+			if value == "true" {
+				args = append(args, "--"+def.Name)
+			}
+			continue
+		}
+		args = append(args, "--"+def.Name, value)
+	}
+
+	return args, nil
+}
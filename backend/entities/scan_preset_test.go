@@ -0,0 +1,84 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanPresetScanArgs(t *testing.T) {
+	var preset ScanPreset
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "deep",
+		"args": {
+			"timeout": 600,
+			"threads": 10,
+			"dep-scope": "prod",
+			"dependencies": true,
+			"debug": false,
+			"skip-settings-file": true
+		}
+	}`), &preset))
+
+	args, err := preset.ScanArgs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"--threads", "10", "--timeout", "600", "--dependencies", "--dep-scope", "prod", "--skip-settings-file"}, args)
+}
+
+func TestScanPresetValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]any
+		wantErr string
+	}{
+		{name: "Valid", args: map[string]any{"threads": float64(8), "format": "json", "quiet": true}},
+		{name: "Unknown argument", args: map[string]any{"turbo": true}, wantErr: `unknown scan argument "turbo"`},
+		{name: "Input argument", args: map[string]any{"files": []any{"a.c"}}, wantErr: "--files cannot be set in a preset"},
+		{name: "Fractional int", args: map[string]any{"threads": 2.5}, wantErr: "--threads expects a non negative integer"},
+		{name: "Negative int", args: map[string]any{"timeout": float64(-1)}, wantErr: "--timeout expects a non negative integer"},
+		{name: "String for int", args: map[string]any{"post-size": "64"}, wantErr: "--post-size expects a non negative integer"},
+		{name: "String for bool", args: map[string]any{"dependencies": "yes"}, wantErr: "--dependencies expects a boolean"},
+		{name: "Number for string", args: map[string]any{"dep-scope": float64(1)}, wantErr: "--dep-scope expects a string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ScanPreset{Name: "preset", Args: tt.args}.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrInvalidScanPreset)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+
+	assert.ErrorIs(t, ScanPreset{Name: " "}.Validate(), ErrInvalidScanPreset)
+}
@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"sync"
+	"time"
+)
+
+// ScanPhase is the stage a running scan is in.
+type ScanPhase string
+
+const (
+	ScanPhaseCollecting     ScanPhase = "collecting"
+	ScanPhaseFingerprinting ScanPhase = "fingerprinting"
+	ScanPhaseScanning       ScanPhase = "scanning"
+	ScanPhaseWriting        ScanPhase = "writing"
+	ScanPhaseDone           ScanPhase = "done"
+)
+
+// ScanProgressEvent is the name of the event carrying a ScanProgress snapshot to the UI.
+const ScanProgressEvent = "scanProgress"
+
+// scanProgressInterval throttles updates that happen once per file, so large scans do not flood the UI.
+const scanProgressInterval = 100 * time.Millisecond
+
+// ScanBatchError is a failed attempt at posting one batch of fingerprints. Batch and Attempt are 1-based,
+// 0 when the scanner did not report them.
+type ScanBatchError struct {
+	Batch   int    `json:"batch"`
+	Attempt int    `json:"attempt"`
+	Message string `json:"message"`
+}
+
+// ScanProgress is a snapshot of a running scan. Counters the scanner does not report stay at zero.
+type ScanProgress struct {
+	Phase              ScanPhase        `json:"phase"`
+	FilesFound         int              `json:"files_found"`
+	FilesFingerprinted int              `json:"files_fingerprinted"`
+	BatchesTotal       int              `json:"batches_total"`
+	BatchesSent        int              `json:"batches_sent"`
+	BatchesCompleted   int              `json:"batches_completed"`
+	ElapsedSeconds     float64          `json:"elapsed_seconds"`
+	EtaSeconds         *float64         `json:"eta_seconds,omitempty"` // Nil until at least one batch completed
+	Errors             []ScanBatchError `json:"errors"`
+}
+
+// ScanProgressTracker accumulates scan progress from concurrent workers and reports snapshots to onChange.
+// Phase and batch changes are reported immediately, per file changes at most every 100ms. Snapshots are
+// reported in the order they were taken, so counters never go backwards in the UI.
+type ScanProgressTracker struct {
+	// emitMu is held from taking a snapshot until onChange returns. It is always taken before mu.
+	emitMu        sync.Mutex
+	mu            sync.Mutex
+	progress      ScanProgress
+	startedAt     time.Time
+	scanStartedAt time.Time
+	lastReport    time.Time
+	onChange      func(ScanProgress)
+	now           func() time.Time
+}
+
+func NewScanProgressTracker(onChange func(ScanProgress)) *ScanProgressTracker {
+	t := &ScanProgressTracker{
+		onChange: onChange,
+		now:      time.Now,
+	}
+	t.startedAt = t.now()
+	t.progress.Phase = ScanPhaseCollecting
+	return t
+}
+
+// SetPhase moves the scan to the given phase.
+func (t *ScanProgressTracker) SetPhase(phase ScanPhase) {
+	t.update(true, func(p *ScanProgress) {
+		p.Phase = phase
+		if phase == ScanPhaseScanning && t.scanStartedAt.IsZero() {
+			t.scanStartedAt = t.now()
+		}
+	})
+}
+
+// SetFilesFound records how many files will be fingerprinted.
+func (t *ScanProgressTracker) SetFilesFound(n int) {
+	t.update(true, func(p *ScanProgress) { p.FilesFound = n })
+}
+
+// FileFingerprinted counts one more fingerprinted file.
+func (t *ScanProgressTracker) FileFingerprinted() {
+	t.update(false, func(p *ScanProgress) { p.FilesFingerprinted++ })
+}
+
+// SetBatchesTotal records how many batches will be posted.
+func (t *ScanProgressTracker) SetBatchesTotal(n int) {
+	t.update(true, func(p *ScanProgress) { p.BatchesTotal = n })
+}
+
+// BatchSent counts one more batch posted to the API, retries excluded.
+func (t *ScanProgressTracker) BatchSent() {
+	t.update(true, func(p *ScanProgress) {
+		p.BatchesSent++
+		if t.scanStartedAt.IsZero() {
+			t.scanStartedAt = t.now()
+		}
+	})
+}
+
+// BatchCompleted counts one more batch answered by the API.
+func (t *ScanProgressTracker) BatchCompleted() {
+	t.update(true, func(p *ScanProgress) { p.BatchesCompleted++ })
+}
+
+// BatchFailed records a failed attempt at posting a batch.
+func (t *ScanProgressTracker) BatchFailed(batch, attempt int, message string) {
+	t.update(true, func(p *ScanProgress) {
+		p.Errors = append(p.Errors, ScanBatchError{Batch: batch, Attempt: attempt, Message: message})
+	})
+}
+
+// Snapshot returns the current progress.
+func (t *ScanProgressTracker) Snapshot() ScanProgress {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.snapshot()
+}
+
+func (t *ScanProgressTracker) update(force bool, apply func(p *ScanProgress)) {
+	t.emitMu.Lock()
+	defer t.emitMu.Unlock()
+
+	t.mu.Lock()
+	apply(&t.progress)
+	now := t.now()
+	if !force && now.Sub(t.lastReport) < scanProgressInterval {
+		t.mu.Unlock()
+		return
+	}
+	t.lastReport = now
+	snapshot := t.snapshot()
+	t.mu.Unlock()
+
+	if t.onChange != nil {
+		t.onChange(snapshot)
+	}
+}
+
+// snapshot copies the progress and computes the timings. The caller must hold t.mu.
+func (t *ScanProgressTracker) snapshot() ScanProgress {
+	p := t.progress
+	p.Errors = append([]ScanBatchError{}, t.progress.Errors...)
+
+	now := t.now()
+	p.ElapsedSeconds = now.Sub(t.startedAt).Seconds()
+
+	// The ETA extrapolates the average time per completed batch to the remaining ones
+	if p.Phase == ScanPhaseDone {
+		eta := 0.0
+		p.EtaSeconds = &eta
+	} else if p.BatchesCompleted > 0 && p.BatchesTotal >= p.BatchesCompleted && !t.scanStartedAt.IsZero() {
+		perBatch := now.Sub(t.scanStartedAt).Seconds() / float64(p.BatchesCompleted)
+		eta := perBatch * float64(p.BatchesTotal-p.BatchesCompleted)
+		p.EtaSeconds = &eta
+	}
+
+	return p
+}
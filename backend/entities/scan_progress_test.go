@@ -0,0 +1,196 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a manually advanced clock for tracker timings.
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newTestTracker() (*ScanProgressTracker, *fakeClock, *[]ScanProgress) {
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	var reports []ScanProgress
+	tracker := NewScanProgressTracker(func(p ScanProgress) { reports = append(reports, p) })
+	tracker.now = clock.Now
+	tracker.startedAt = clock.Now()
+	return tracker, clock, &reports
+}
+
+func TestScanProgressTracker(t *testing.T) {
+	t.Run("Counts files and batches", func(t *testing.T) {
+		tracker, clock, _ := newTestTracker()
+
+		tracker.SetFilesFound(3)
+		tracker.SetPhase(ScanPhaseFingerprinting)
+		for range 3 {
+			tracker.FileFingerprinted()
+		}
+		tracker.SetBatchesTotal(4)
+		tracker.SetPhase(ScanPhaseScanning)
+		tracker.BatchSent()
+		tracker.BatchSent()
+		clock.Advance(10 * time.Second)
+		tracker.BatchCompleted()
+		tracker.BatchFailed(2, 1, "status 503")
+
+		p := tracker.Snapshot()
+		assert.Equal(t, ScanPhaseScanning, p.Phase)
+		assert.Equal(t, 3, p.FilesFound)
+		assert.Equal(t, 3, p.FilesFingerprinted)
+		assert.Equal(t, 4, p.BatchesTotal)
+		assert.Equal(t, 2, p.BatchesSent)
+		assert.Equal(t, 1, p.BatchesCompleted)
+		assert.Equal(t, []ScanBatchError{{Batch: 2, Attempt: 1, Message: "status 503"}}, p.Errors)
+		assert.Equal(t, 10.0, p.ElapsedSeconds)
+		require.NotNil(t, p.EtaSeconds)
+		assert.Equal(t, 30.0, *p.EtaSeconds, "three batches left at ten seconds each")
+	})
+
+	t.Run("Has no ETA before a batch completes", func(t *testing.T) {
+		tracker, _, _ := newTestTracker()
+		tracker.SetBatchesTotal(2)
+		tracker.BatchSent()
+
+		assert.Nil(t, tracker.Snapshot().EtaSeconds)
+	})
+
+	t.Run("Has a zero ETA once done", func(t *testing.T) {
+		tracker, _, _ := newTestTracker()
+		tracker.SetPhase(ScanPhaseDone)
+
+		p := tracker.Snapshot()
+		require.NotNil(t, p.EtaSeconds)
+		assert.Zero(t, *p.EtaSeconds)
+	})
+
+	t.Run("Throttles per file updates", func(t *testing.T) {
+		tracker, clock, reports := newTestTracker()
+
+		tracker.SetPhase(ScanPhaseFingerprinting)
+		for range 10 {
+			tracker.FileFingerprinted()
+		}
+		assert.Len(t, *reports, 1, "file updates within the interval are not reported")
+
+		clock.Advance(scanProgressInterval)
+		tracker.FileFingerprinted()
+		require.Len(t, *reports, 2)
+		assert.Equal(t, 11, (*reports)[1].FilesFingerprinted)
+	})
+
+	t.Run("Snapshots do not share errors", func(t *testing.T) {
+		tracker, _, reports := newTestTracker()
+		tracker.BatchFailed(1, 1, "first")
+		tracker.BatchFailed(1, 2, "second")
+
+		assert.Len(t, (*reports)[0].Errors, 1)
+		assert.Len(t, (*reports)[1].Errors, 2)
+	})
+
+	t.Run("Is safe for concurrent workers", func(t *testing.T) {
+		tracker := NewScanProgressTracker(nil)
+		var wg sync.WaitGroup
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 100 {
+					tracker.BatchSent()
+					tracker.BatchCompleted()
+				}
+			}()
+		}
+		wg.Wait()
+
+		p := tracker.Snapshot()
+		assert.Equal(t, 800, p.BatchesSent)
+		assert.Equal(t, 800, p.BatchesCompleted)
+	})
+
+	t.Run("Reports snapshots in order from concurrent workers", func(t *testing.T) {
+		var reports []ScanProgress
+		tracker := NewScanProgressTracker(func(p ScanProgress) { reports = append(reports, p) })
+
+		var wg sync.WaitGroup
+		for range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 100 {
+					tracker.BatchCompleted()
+				}
+			}()
+		}
+		wg.Wait()
+
+		require.Len(t, reports, 800)
+		for i, report := range reports {
+			assert.Equal(t, i+1, report.BatchesCompleted)
+		}
+	})
+}
+
+func TestParseScanOutputLine(t *testing.T) {
+	tracker := NewScanProgressTracker(nil)
+
+	lines := []string{
+		"Searching /tmp/project for files to fingerprint...",
+		"Found 12 files to process.",
+		"Posting 3 requests to https://api.osskb.org/scan/direct using 5 threads",
+		"Sending request to https://api.osskb.org/scan/direct",
+		"Scanned request 1 of 3",
+		"Warning: Timeout encountered while scanning. Retrying...",
+		"some unrelated output",
+	}
+	recognised := 0
+	for _, line := range lines {
+		if ParseScanOutputLine(tracker, line) {
+			recognised++
+		}
+	}
+
+	p := tracker.Snapshot()
+	assert.Equal(t, 6, recognised)
+	assert.Equal(t, ScanPhaseScanning, p.Phase)
+	assert.Equal(t, 12, p.FilesFound)
+	assert.Equal(t, 3, p.BatchesTotal)
+	assert.Equal(t, 1, p.BatchesSent)
+	assert.Equal(t, 1, p.BatchesCompleted)
+	require.Len(t, p.Errors, 1)
+	assert.Equal(t, 0, p.Errors[0].Batch)
+	assert.Contains(t, p.Errors[0].Message, "Timeout")
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrScannerNotFound = errors.New("scanner not found")
+
+// How the scanner executable was found, in the order the locations are tried.
+const (
+	ScannerSourceConfigBinary = "scanner.binary setting"
+	ScannerSourceConfigPython = "scanner.python setting"
+	ScannerSourceVirtualEnv   = "active virtualenv (VIRTUAL_ENV)"
+	ScannerSourceProjectVenv  = "project virtualenv"
+	ScannerSourcePath         = "PATH"
+	ScannerSourcePipx         = "pipx"
+	ScannerSourceCommonPath   = "common install location"
+	ScannerSourceBuiltIn      = "built in"
+)
+
+// ScannerDiagnostic reports which scanner executable and version a scan will run, and how it was found.
+type ScannerDiagnostic struct {
+	Scanner Scanner `json:"scanner"`
+	Binary  string  `json:"binary,omitempty"`
+	Source  string  `json:"source,omitempty"`
+	Version string  `json:"version,omitempty"`
+	Python  string  `json:"python,omitempty"`
+	// EnvNames lists the extra environment variables passed to the scanner, without their values
+	EnvNames []string `json:"env_names,omitempty"`
+	Error    string   `json:"error,omitempty"`
+}
+
+func (d ScannerDiagnostic) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Scanner: %s\n", d.Scanner)
+	if d.Binary != "" {
+		fmt.Fprintf(&b, "Binary:  %s (found via %s)\n", d.Binary, d.Source)
+	}
+	if d.Version != "" {
+		fmt.Fprintf(&b, "Version: %s\n", d.Version)
+	}
+	if d.Python != "" {
+		fmt.Fprintf(&b, "Python:  %s\n", d.Python)
+	}
+	if len(d.EnvNames) > 0 {
+		fmt.Fprintf(&b, "Env:     %s\n", strings.Join(d.EnvNames, ", "))
+	}
+	if d.Error != "" {
+		fmt.Fprintf(&b, "Error:   %s\n", d.Error)
+	}
+	return b.String()
+}
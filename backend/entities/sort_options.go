@@ -29,12 +29,38 @@ type SortOrder string
 const (
 	SortByMatchPercentage SortOption = "match_percentage"
 	SortByPath            SortOption = "path"
+	SortBySeverity        SortOption = "vulnerability_severity"
+	SortByComponent       SortOption = "component"
+	SortByVendor          SortOption = "vendor"
+	SortByLicense         SortOption = "license"
+	SortByMatchedLines    SortOption = "matched_lines"
+	SortByWorkflowState   SortOption = "workflow_state"
+	SortByDecision        SortOption = "decision"
+	SortByReleaseDate     SortOption = "release_date"
+	SortByFolderDepth     SortOption = "folder_depth"
 
 	SortOrderAsc  SortOrder = "asc"
 	SortOrderDesc SortOrder = "desc"
 )
 
+type SortKey struct {
+	Option SortOption `json:"option"`
+	Order  SortOrder  `json:"order"`
+}
+
+// SortConfig is the primary sort key followed by optional keys used to break ties, in order.
+// Keys without an order sort descending.
 type SortConfig struct {
 	Option SortOption `json:"option"`
 	Order  SortOrder  `json:"order"`
+	ThenBy []SortKey  `json:"then_by,omitempty"`
+}
+
+// Keys returns every sort key in order, defaulting to match percentage when no option is set.
+func (c SortConfig) Keys() []SortKey {
+	primary := SortKey{Option: c.Option, Order: c.Order}
+	if primary.Option == "" {
+		primary.Option = SortByMatchPercentage
+	}
+	return append([]SortKey{primary}, c.ThenBy...)
 }
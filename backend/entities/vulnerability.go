@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+type VulnerabilitySeverity string
+
+const (
+	SeverityUnknown  VulnerabilitySeverity = "unknown"
+	SeverityLow      VulnerabilitySeverity = "low"
+	SeverityMedium   VulnerabilitySeverity = "medium"
+	SeverityHigh     VulnerabilitySeverity = "high"
+	SeverityCritical VulnerabilitySeverity = "critical"
+)
+
+var severityRanks = map[VulnerabilitySeverity]int{
+	SeverityUnknown:  0,
+	SeverityLow:      1,
+	SeverityMedium:   2,
+	SeverityHigh:     3,
+	SeverityCritical: 4,
+}
+
+// ParseVulnerabilitySeverity normalizes the severity labels used by the
+// different advisory sources (e.g. "MODERATE", "High") into a known level.
+func ParseVulnerabilitySeverity(severity string) VulnerabilitySeverity {
+	switch strings.ToLower(strings.TrimSpace(severity)) {
+	case "low":
+		return SeverityLow
+	case "medium", "moderate":
+		return SeverityMedium
+	case "high", "important":
+		return SeverityHigh
+	case "critical":
+		return SeverityCritical
+	default:
+		return SeverityUnknown
+	}
+}
+
+// SeverityFromCVSS maps a CVSS v3 base score to its qualitative rating.
+func SeverityFromCVSS(score float64) VulnerabilitySeverity {
+	switch {
+	case score >= 9.0:
+		return SeverityCritical
+	case score >= 7.0:
+		return SeverityHigh
+	case score >= 4.0:
+		return SeverityMedium
+	case score > 0:
+		return SeverityLow
+	default:
+		return SeverityUnknown
+	}
+}
+
+// Rank returns a comparable weight, higher meaning more severe.
+func (s VulnerabilitySeverity) Rank() int {
+	return severityRanks[s]
+}
+
+type Vulnerability struct {
+	ID         string                `json:"id"`
+	CVE        string                `json:"cve,omitempty"`
+	Severity   VulnerabilitySeverity `json:"severity,omitempty"`
+	CVSS       float64               `json:"cvss,omitempty"`
+	CVSSVector string                `json:"cvss_vector,omitempty"`
+	Introduced string                `json:"introduced,omitempty"`
+	Reported   string                `json:"reported,omitempty"`
+	Patched    string                `json:"patched,omitempty"`
+	Summary    string                `json:"summary,omitempty"`
+	Source     string                `json:"source,omitempty"`
+}
+
+// UnmarshalJSON accepts the vulnerability payloads produced by the SCANOSS
+// engine and API. CVSS is reported either as a plain score, a vector string
+// or a list of {cvss, cvss_score, cvss_severity} objects.
+func (v *Vulnerability) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ID         string          `json:"id"`
+		CVE        string          `json:"cve"`
+		Severity   string          `json:"severity"`
+		CVSS       json.RawMessage `json:"cvss"`
+		CVSSScore  json.RawMessage `json:"cvss_score"`
+		Introduced string          `json:"introduced"`
+		Reported   string          `json:"reported"`
+		Patched    string          `json:"patched"`
+		Summary    string          `json:"summary"`
+		Source     string          `json:"source"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*v = Vulnerability{
+		ID:         raw.ID,
+		CVE:        raw.CVE,
+		Severity:   ParseVulnerabilitySeverity(raw.Severity),
+		Introduced: raw.Introduced,
+		Reported:   raw.Reported,
+		Patched:    raw.Patched,
+		Summary:    raw.Summary,
+		Source:     raw.Source,
+	}
+	if v.ID == "" {
+		v.ID = v.CVE
+	}
+
+	v.parseCVSS(raw.CVSSScore)
+	v.parseCVSS(raw.CVSS)
+
+	if v.Severity == SeverityUnknown {
+		v.Severity = SeverityFromCVSS(v.CVSS)
+	}
+
+	return nil
+}
+
+func (v *Vulnerability) parseCVSS(data json.RawMessage) {
+	if len(data) == 0 || v.CVSS > 0 {
+		return
+	}
+
+	var score float64
+	if err := json.Unmarshal(data, &score); err == nil {
+		v.CVSS = score
+		return
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		if score, err := strconv.ParseFloat(text, 64); err == nil {
+			v.CVSS = score
+		} else {
+			v.CVSSVector = text
+		}
+		return
+	}
+
+	var entries []struct {
+		Vector   string          `json:"cvss"`
+		Score    json.RawMessage `json:"cvss_score"`
+		Severity string          `json:"cvss_severity"`
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return
+	}
+	for _, entry := range entries {
+		v.parseCVSS(entry.Score)
+		if v.CVSSVector == "" {
+			v.CVSSVector = entry.Vector
+		}
+		if v.Severity == SeverityUnknown {
+			v.Severity = ParseVulnerabilitySeverity(entry.Severity)
+		}
+	}
+}
+
+// GetVulnerabilities returns the vulnerabilities reported for every match of
+// the result, without duplicates.
+func (r *Result) GetVulnerabilities() []Vulnerability {
+	var vulnerabilities []Vulnerability
+	seen := make(map[string]struct{})
+
+	for _, match := range r.Matches {
+		for _, vulnerability := range match.Vulnerabilities {
+			if _, ok := seen[vulnerability.ID]; ok && vulnerability.ID != "" {
+				continue
+			}
+			seen[vulnerability.ID] = struct{}{}
+			vulnerabilities = append(vulnerabilities, vulnerability)
+		}
+	}
+
+	return vulnerabilities
+}
+
+func (r *Result) HasVulnerabilities() bool {
+	for _, match := range r.Matches {
+		if len(match.Vulnerabilities) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetMaxVulnerabilitySeverity returns the most severe level reported for the
+// result, or SeverityUnknown if there are no vulnerabilities.
+func (r *Result) GetMaxVulnerabilitySeverity() VulnerabilitySeverity {
+	maxSeverity := SeverityUnknown
+	for _, match := range r.Matches {
+		for _, vulnerability := range match.Vulnerabilities {
+			if vulnerability.Severity.Rank() > maxSeverity.Rank() {
+				maxSeverity = vulnerability.Severity
+			}
+		}
+	}
+	return maxSeverity
+}
@@ -0,0 +1,114 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVulnerability_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  string
+		expected Vulnerability
+	}{
+		{
+			name:    "engine payload with upper case keys",
+			payload: `{"ID": "GHSA-1234", "CVE": "CVE-2021-1234", "severity": "MODERATE", "reported": "2021-03-01", "introduced": "2020-01-01", "source": "github_advisories"}`,
+			expected: Vulnerability{
+				ID:         "GHSA-1234",
+				CVE:        "CVE-2021-1234",
+				Severity:   SeverityMedium,
+				Reported:   "2021-03-01",
+				Introduced: "2020-01-01",
+				Source:     "github_advisories",
+			},
+		},
+		{
+			name:    "plain cvss score derives severity",
+			payload: `{"cve": "CVE-2022-0001", "cvss_score": 9.8}`,
+			expected: Vulnerability{
+				ID:       "CVE-2022-0001",
+				CVE:      "CVE-2022-0001",
+				Severity: SeverityCritical,
+				CVSS:     9.8,
+			},
+		},
+		{
+			name:    "cvss list",
+			payload: `{"id": "CVE-2023-0002", "cvss": [{"cvss": "CVSS:3.1/AV:N/AC:L", "cvss_score": 7.5, "cvss_severity": "High"}]}`,
+			expected: Vulnerability{
+				ID:         "CVE-2023-0002",
+				Severity:   SeverityHigh,
+				CVSS:       7.5,
+				CVSSVector: "CVSS:3.1/AV:N/AC:L",
+			},
+		},
+		{
+			name:    "unknown severity without score",
+			payload: `{"id": "OSV-1", "severity": "n/a"}`,
+			expected: Vulnerability{
+				ID:       "OSV-1",
+				Severity: SeverityUnknown,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var vulnerability Vulnerability
+			require.NoError(t, json.Unmarshal([]byte(tt.payload), &vulnerability))
+			assert.Equal(t, tt.expected, vulnerability)
+		})
+	}
+}
+
+func TestResultFilter_Vulnerabilities(t *testing.T) {
+	vulnerable := Result{
+		Path: "src/vulnerable.c",
+		Matches: []Component{
+			{Vulnerabilities: []Vulnerability{{ID: "CVE-1", Severity: SeverityMedium}}},
+			{Vulnerabilities: []Vulnerability{{ID: "CVE-1", Severity: SeverityMedium}, {ID: "CVE-2", Severity: SeverityHigh}}},
+		},
+	}
+	clean := Result{Path: "src/clean.c", Matches: []Component{{}}}
+
+	assert.Len(t, vulnerable.GetVulnerabilities(), 2)
+	assert.Equal(t, SeverityHigh, vulnerable.GetMaxVulnerabilitySeverity())
+	assert.Equal(t, SeverityUnknown, clean.GetMaxVulnerabilitySeverity())
+
+	hasVulnerabilities := NewResultFilterHasVulnerabilities()
+	assert.True(t, hasVulnerabilities.IsValid(vulnerable))
+	assert.False(t, hasVulnerabilities.IsValid(clean))
+
+	assert.True(t, NewResultFilterMinSeverity(SeverityHigh).IsValid(vulnerable))
+	assert.False(t, NewResultFilterMinSeverity(SeverityCritical).IsValid(vulnerable))
+	assert.False(t, NewResultFilterMinSeverity(SeverityLow).IsValid(clean))
+}
@@ -23,7 +23,13 @@
 
 package mappers
 
-import "github.com/scanoss/scanoss.cc/backend/entities"
+import (
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+)
 
 type ComponentMapperImpl struct{}
 
@@ -64,5 +70,51 @@ func (m *ComponentMapperImpl) MapToComponentDTO(componentEntity entities.Compone
 		dto.Licenses = licenses
 	}
 
+	dto.Vulnerabilities = m.mapVulnerabilities(componentEntity.Vulnerabilities)
+
+	if componentEntity.Health != (entities.ComponentHealth{}) {
+		health := componentEntity.Health
+		dto.Health = &health
+	}
+	dto.Copyrights = componentEntity.Copyrights
+	dto.Indicators = componentEntity.GetIndicators(time.Now())
+	dto.Cryptography = componentEntity.Cryptography
+	m.mapLineRanges(componentEntity, &dto)
+
 	return dto
 }
+
+func (m *ComponentMapperImpl) mapLineRanges(componentEntity entities.Component, dto *entities.ComponentDTO) {
+	lineRanges, allLines, err := entities.ParseLineRanges(componentEntity.Lines)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Invalid lines for component %s", componentEntity.Component)
+	}
+	ossLineRanges, allOssLines, err := entities.ParseLineRanges(componentEntity.OssLines)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Invalid oss_lines for component %s", componentEntity.Component)
+	}
+
+	dto.LineRanges = lineRanges
+	dto.OssLineRanges = ossLineRanges
+	dto.MatchesAllLines = allLines
+	dto.OssMatchesAllLines = allOssLines
+}
+
+// mapVulnerabilities returns the vulnerabilities ordered from most to least severe.
+func (m *ComponentMapperImpl) mapVulnerabilities(vulnerabilities []entities.Vulnerability) []entities.Vulnerability {
+	if len(vulnerabilities) == 0 {
+		return nil
+	}
+
+	sorted := make([]entities.Vulnerability, len(vulnerabilities))
+	copy(sorted, vulnerabilities)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Severity.Rank() != sorted[j].Severity.Rank() {
+			return sorted[i].Severity.Rank() > sorted[j].Severity.Rank()
+		}
+		return sorted[i].CVSS > sorted[j].CVSS
+	})
+
+	return sorted
+}
@@ -1,6 +1,6 @@
 // SPDX-License-Identifier: MIT
 /*
- * Copyright (C) 2018-2024 SCANOSS.COM
+ * Copyright (C) 2018-2026 SCANOSS.COM
  *
  * Permission is hereby granted, free of charge, to any person obtaining a copy
  * of this software and associated documentation files (the "Software"), to deal
@@ -21,13 +21,11 @@
  * SOFTWARE.
  */
 
-import { HighlightRange } from '@/lib/editor';
+package mappers
 
-export const getHighlightLineRanges = (lines: string): HighlightRange[] => {
-  const ranges = lines.split(',').map((range) => range.split('-'));
+import "github.com/scanoss/scanoss.cc/backend/entities"
 
-  return ranges.map((range) => ({
-    start: Number(range[0]),
-    end: Number(range[1]),
-  }));
-};
+type DependencyMapper interface {
+	MapToDependencyDTO(dependency entities.DeclaredDependency) entities.DependencyDTO
+	MapToDependencyDTOList(dependencies []entities.DeclaredDependency) []entities.DependencyDTO
+}
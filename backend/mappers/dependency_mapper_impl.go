@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package mappers
+
+import "github.com/scanoss/scanoss.cc/backend/entities"
+
+type DependencyMapperImpl struct {
+	scanossSettings *entities.ScanossSettings
+	resultMapper    *ResultMapperImpl
+}
+
+func NewDependencyMapper(scanossSettings *entities.ScanossSettings) DependencyMapper {
+	return &DependencyMapperImpl{
+		scanossSettings: scanossSettings,
+		resultMapper:    &ResultMapperImpl{scanossSettings: scanossSettings},
+	}
+}
+
+func (m *DependencyMapperImpl) MapToDependencyDTO(dependency entities.DeclaredDependency) entities.DependencyDTO {
+	// Decisions on dependencies live in the same BOM lists as file decisions,
+	// keyed by the manifest path and the dependency purl.
+	result := dependency.ToResult()
+	settingsFile := m.scanossSettings.SettingsFile
+	bomEntry := settingsFile.GetBomEntryFromResult(result)
+
+	return entities.DependencyDTO{
+		DeclaredFile:     dependency.DeclaredFile,
+		Component:        dependency.Component,
+		Purl:             dependency.Purl,
+		PurlUrl:          m.resultMapper.mapPurlUrl(dependency.Purl),
+		Requirement:      dependency.Requirement,
+		Version:          dependency.Version,
+		Scope:            dependency.Scope,
+		URL:              dependency.URL,
+		Licenses:         dependency.Licenses,
+		WorkflowState:    settingsFile.GetResultWorkflowState(result),
+		FilterConfig:     settingsFile.GetResultFilterConfig(result),
+		Comment:          bomEntry.Comment,
+		ConcludedPurl:    bomEntry.ReplaceWith,
+		ConcludedPurlUrl: m.resultMapper.mapPurlUrl(bomEntry.ReplaceWith),
+		ConcludedName:    m.resultMapper.componentNameFromPurl(bomEntry.ReplaceWith),
+	}
+}
+
+func (m *DependencyMapperImpl) MapToDependencyDTOList(dependencies []entities.DeclaredDependency) []entities.DependencyDTO {
+	output := make([]entities.DependencyDTO, len(dependencies))
+	for i, dependency := range dependencies {
+		output[i] = m.MapToDependencyDTO(dependency)
+	}
+	return output
+}
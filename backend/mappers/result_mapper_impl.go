@@ -101,6 +101,16 @@ func (m *ResultMapperImpl) MapToResultDTO(result entities.Result) entities.Resul
 		WorkflowState:    m.mapWorkflowState(result),
 		FilterConfig:     m.mapFilterConfig(result),
 		Comment:          bomEntry.Comment,
+		Origin:           result.Origin,
+	}
+
+	if result.HasVulnerabilities() {
+		dto.VulnerabilityCount = len(result.GetVulnerabilities())
+		dto.MaxSeverity = result.GetMaxVulnerabilitySeverity()
+	}
+
+	if result.HasCryptography() {
+		dto.CryptoAlgorithms = result.GetCryptoAlgorithms()
 	}
 
 	resultDTOCache.Store(cacheKey, dto)
@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+const apiCacheEntryExt = ".json"
+
+// APICacheRepositoryFileImpl keeps API responses in the cache folder of the app config folder. Once the folder
+// grows over the configured size, the least recently used entries are removed.
+type APICacheRepositoryFileImpl struct {
+	fr    utils.FileReader
+	mutex sync.Mutex
+}
+
+func NewAPICacheRepositoryFileImpl(fr utils.FileReader) APICacheRepository {
+	return &APICacheRepositoryFileImpl{
+		fr: fr,
+	}
+}
+
+func (r *APICacheRepositoryFileImpl) Get(key string) (entities.APICacheEntry, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	path, err := apiCacheEntryPath(key)
+	if err != nil {
+		return entities.APICacheEntry{}, err
+	}
+	data, err := r.fr.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return entities.APICacheEntry{}, entities.ErrAPICacheMiss
+		}
+		return entities.APICacheEntry{}, err
+	}
+
+	entry, err := utils.JSONParse[entities.APICacheEntry](data)
+	if err != nil || entry.Key != key {
+		// A damaged entry, or a hash collision, is a miss that the next response replaces
+		return entities.APICacheEntry{}, entities.ErrAPICacheMiss
+	}
+
+	now := time.Now()
+	if entry.IsExpired(now) {
+		os.Remove(path)
+		return entities.APICacheEntry{}, entities.ErrAPICacheMiss
+	}
+
+	// The modification time tracks the last use, for least recently used eviction
+	if err := os.Chtimes(path, now, now); err != nil {
+		log.Debug().Err(err).Msgf("Error updating the last use of %s", path)
+	}
+
+	return entry, nil
+}
+
+func (r *APICacheRepositoryFileImpl) Save(entry entities.APICacheEntry) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	path, err := apiCacheEntryPath(entry.Key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("error creating API cache folder: %w", err)
+	}
+	if err := utils.WriteJsonFile(path, entry); err != nil {
+		return fmt.Errorf("error writing API cache entry: %w", err)
+	}
+
+	return r.evict()
+}
+
+func (r *APICacheRepositoryFileImpl) Clear() (int, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	files, err := apiCacheFiles()
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, file := range files {
+		if err := os.Remove(file.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+func (r *APICacheRepositoryFileImpl) Stats() (entities.APICacheStats, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	stats := entities.APICacheStats{
+		Folder:       config.GetInstance().GetAPICacheFolder(),
+		MaxSizeBytes: apiCacheMaxSizeBytes(),
+		Endpoints:    map[string]int{},
+	}
+
+	files, err := apiCacheFiles()
+	if err != nil {
+		return stats, err
+	}
+
+	now := time.Now()
+	for _, file := range files {
+		stats.Entries++
+		stats.SizeBytes += file.size
+
+		data, err := r.fr.ReadFile(file.path)
+		if err != nil {
+			continue
+		}
+		entry, err := utils.JSONParse[entities.APICacheEntry](data)
+		if err != nil {
+			continue
+		}
+		stats.Endpoints[entry.Endpoint]++
+		if entry.IsExpired(now) {
+			stats.Expired++
+		}
+	}
+
+	return stats, nil
+}
+
+// evict removes the least recently used entries until the cache fits in its maximum size.
+func (r *APICacheRepositoryFileImpl) evict() error {
+	maxSize := apiCacheMaxSizeBytes()
+	if maxSize <= 0 {
+		return nil
+	}
+
+	files, err := apiCacheFiles()
+	if err != nil {
+		return err
+	}
+
+	var size int64
+	for _, file := range files {
+		size += file.size
+	}
+	if size <= maxSize {
+		return nil
+	}
+
+	slices.SortFunc(files, func(a, b apiCacheFile) int { return a.lastUsed.Compare(b.lastUsed) })
+	for _, file := range files {
+		if size <= maxSize {
+			break
+		}
+		if err := os.Remove(file.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		size -= file.size
+		log.Debug().Msgf("Evicted %s from the API cache", filepath.Base(file.path))
+	}
+	return nil
+}
+
+type apiCacheFile struct {
+	path     string
+	size     int64
+	lastUsed time.Time
+}
+
+func apiCacheFiles() ([]apiCacheFile, error) {
+	folder := config.GetInstance().GetAPICacheFolder()
+	if folder == "" {
+		return nil, fmt.Errorf("no API cache folder")
+	}
+
+	entries, err := os.ReadDir(folder)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	files := make([]apiCacheFile, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), apiCacheEntryExt) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, apiCacheFile{path: filepath.Join(folder, entry.Name()), size: info.Size(), lastUsed: info.ModTime()})
+	}
+	return files, nil
+}
+
+// apiCacheEntryPath returns the file of a key, named after its hash since keys are URLs.
+func apiCacheEntryPath(key string) (string, error) {
+	folder := config.GetInstance().GetAPICacheFolder()
+	if folder == "" {
+		return "", fmt.Errorf("no API cache folder")
+	}
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(folder, hex.EncodeToString(hash[:])+apiCacheEntryExt), nil
+}
+
+func apiCacheMaxSizeBytes() int64 {
+	return int64(config.GetInstance().GetAPICacheSettings().MaxSizeMB) * 1024 * 1024
+}
@@ -0,0 +1,163 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPICacheRepository(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+	t.Setenv("HOME", t.TempDir())
+
+	cfg := config.GetInstance()
+	original := cfg.GetAPICacheSettings()
+	defer cfg.SetAPICacheSettings(original)
+	cfg.SetAPICacheSettings(config.APICacheSettings{Enabled: true, MaxSizeMB: 1, TTLSeconds: original.TTLSeconds})
+
+	repo := repository.NewAPICacheRepositoryFileImpl(utils.NewDefaultFileReader())
+	newEntry := func(key string, body string) entities.APICacheEntry {
+		now := time.Now()
+		return entities.APICacheEntry{
+			Endpoint:  "/v2/licenses/component",
+			Key:       key,
+			StoredAt:  now,
+			ExpiresAt: now.Add(time.Hour),
+			Body:      body,
+		}
+	}
+	entryPath := func(t *testing.T, key string) string {
+		t.Helper()
+		files, err := filepath.Glob(filepath.Join(cfg.GetAPICacheFolder(), "*.json"))
+		require.NoError(t, err)
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			require.NoError(t, err)
+			entry, err := utils.JSONParse[entities.APICacheEntry](data)
+			require.NoError(t, err)
+			if entry.Key == key {
+				return file
+			}
+		}
+		t.Fatalf("no cache entry for %s", key)
+		return ""
+	}
+
+	t.Run("Misses unknown keys", func(t *testing.T) {
+		_, err := repo.Get("https://api.osskb.org/v2/licenses/component?purl=pkg:npm/unknown")
+		assert.ErrorIs(t, err, entities.ErrAPICacheMiss)
+	})
+
+	t.Run("Returns saved entries", func(t *testing.T) {
+		entry := newEntry("https://api.osskb.org/v2/licenses/component?purl=pkg:npm/react", `{"licenses":["MIT"]}`)
+		require.NoError(t, repo.Save(entry))
+
+		saved, err := repo.Get(entry.Key)
+		require.NoError(t, err)
+		assert.Equal(t, entry.Body, saved.Body)
+		assert.Equal(t, entry.Endpoint, saved.Endpoint)
+	})
+
+	t.Run("Drops expired entries", func(t *testing.T) {
+		entry := newEntry("https://api.osskb.org/v2/licenses/component?purl=pkg:npm/old", `{}`)
+		entry.ExpiresAt = time.Now().Add(-time.Minute)
+		require.NoError(t, repo.Save(entry))
+		path := entryPath(t, entry.Key)
+
+		_, err := repo.Get(entry.Key)
+		assert.ErrorIs(t, err, entities.ErrAPICacheMiss)
+		assert.NoFileExists(t, path)
+	})
+
+	t.Run("Evicts the least recently used entries over the size limit", func(t *testing.T) {
+		_, err := repo.Clear()
+		require.NoError(t, err)
+
+		body := strings.Repeat("a", 400*1024)
+		first := newEntry("https://api.osskb.org/v2/components/search?search=first", body)
+		second := newEntry("https://api.osskb.org/v2/components/search?search=second", body)
+		require.NoError(t, repo.Save(first))
+		require.NoError(t, repo.Save(second))
+
+		// The first entry is older but used again, so the second one is the least recently used
+		past := time.Now().Add(-time.Hour)
+		require.NoError(t, os.Chtimes(entryPath(t, first.Key), past, past))
+		require.NoError(t, os.Chtimes(entryPath(t, second.Key), past.Add(time.Minute), past.Add(time.Minute)))
+		_, err = repo.Get(first.Key)
+		require.NoError(t, err)
+
+		third := newEntry("https://api.osskb.org/v2/components/search?search=third", body)
+		require.NoError(t, repo.Save(third))
+
+		_, err = repo.Get(first.Key)
+		assert.NoError(t, err)
+		_, err = repo.Get(second.Key)
+		assert.ErrorIs(t, err, entities.ErrAPICacheMiss)
+		_, err = repo.Get(third.Key)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Reports stats and clears the cache", func(t *testing.T) {
+		_, err := repo.Clear()
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Save(newEntry("https://api.osskb.org/v2/licenses/component?purl=pkg:npm/react", `{}`)))
+		search := newEntry("https://api.osskb.org/v2/components/search?search=react", `{}`)
+		search.Endpoint = "/v2/components/search"
+		search.ExpiresAt = time.Now().Add(-time.Minute)
+		require.NoError(t, repo.Save(search))
+
+		stats, err := repo.Stats()
+		require.NoError(t, err)
+		assert.Equal(t, cfg.GetAPICacheFolder(), stats.Folder)
+		assert.Equal(t, 2, stats.Entries)
+		assert.Equal(t, 1, stats.Expired)
+		assert.Equal(t, int64(1024*1024), stats.MaxSizeBytes)
+		assert.Positive(t, stats.SizeBytes)
+		assert.Equal(t, map[string]int{"/v2/licenses/component": 1, "/v2/components/search": 1}, stats.Endpoints)
+
+		removed, err := repo.Clear()
+		require.NoError(t, err)
+		assert.Equal(t, 2, removed)
+
+		stats, err = repo.Stats()
+		require.NoError(t, err)
+		assert.Zero(t, stats.Entries)
+	})
+}
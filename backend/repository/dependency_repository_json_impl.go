@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository
+
+import (
+	"sort"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+)
+
+// DependencyRepositoryJsonImpl reads declared dependencies from the results
+// already loaded by the result repository. Dependency entries can either be
+// the only match of a manifest file or be appended after a file match.
+type DependencyRepositoryJsonImpl struct {
+	resultsRepository ResultRepository
+}
+
+func NewDependencyRepositoryJsonImpl(resultsRepository ResultRepository) DependencyRepository {
+	return &DependencyRepositoryJsonImpl{
+		resultsRepository: resultsRepository,
+	}
+}
+
+func (r *DependencyRepositoryJsonImpl) GetDependencies() ([]entities.DeclaredDependency, error) {
+	results, err := r.resultsRepository.GetResults(nil)
+	if err != nil {
+		return []entities.DeclaredDependency{}, err
+	}
+
+	dependencies := make([]entities.DeclaredDependency, 0)
+	for _, result := range results {
+		dependencies = append(dependencies, extractDeclaredDependencies(result)...)
+	}
+
+	sort.Slice(dependencies, func(i, j int) bool {
+		if dependencies[i].DeclaredFile != dependencies[j].DeclaredFile {
+			return dependencies[i].DeclaredFile < dependencies[j].DeclaredFile
+		}
+		return dependencies[i].Purl < dependencies[j].Purl
+	})
+
+	return dependencies, nil
+}
+
+func (r *DependencyRepositoryJsonImpl) GetDependency(declaredFile string, purl string) *entities.DeclaredDependency {
+	result := r.resultsRepository.GetResultByPath(declaredFile)
+	if result == nil {
+		return nil
+	}
+
+	for _, dependency := range extractDeclaredDependencies(*result) {
+		if dependency.Purl == purl {
+			return &dependency
+		}
+	}
+
+	return nil
+}
+
+func extractDeclaredDependencies(result entities.Result) []entities.DeclaredDependency {
+	var dependencies []entities.DeclaredDependency
+	for _, match := range result.Matches {
+		if match.ID != entities.MatchTypeDependency {
+			continue
+		}
+		for _, dependency := range match.Dependencies {
+			if dependency.Purl == "" {
+				continue
+			}
+			dependencies = append(dependencies, entities.DeclaredDependency{
+				DeclaredFile: result.Path,
+				Dependency:   dependency,
+			})
+		}
+	}
+	return dependencies
+}
@@ -0,0 +1,124 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities/mocks"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const dependencyResults = `{
+  "package.json": [
+    {
+      "id": "dependency",
+      "status": "pending",
+      "dependencies": [
+        {
+          "component": "react",
+          "purl": "pkg:npm/react",
+          "requirement": "^18.2.0",
+          "version": "18.3.1",
+          "scope": "dependencies",
+          "licenses": [{"name": "MIT", "spdx_id": "MIT", "is_spdx_approved": true}]
+        },
+        {
+          "component": "jest",
+          "purl": "pkg:npm/jest",
+          "requirement": "^29.0.0",
+          "scope": "devDependencies"
+        }
+      ]
+    }
+  ],
+  "go.mod": [
+    {"id": "file", "purl": ["pkg:github/scanoss/scanoss.cc"]},
+    {
+      "id": "dependency",
+      "dependencies": [{"component": "cobra", "purl": "pkg:golang/github.com/spf13/cobra", "requirement": "v1.10.2"}]
+    }
+  ],
+  "src/main.c": [{"id": "snippet", "purl": ["pkg:github/scanoss/engine"]}]
+}`
+
+func TestGetDependencies(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	mu := internal_test.NewMockUtils()
+	mu.On("ReadFile", config.GetInstance().GetResultFilePath()).Return([]byte(dependencyResults), nil)
+
+	resultRepo, err := repository.NewResultRepositoryJsonImpl(mu)
+	require.NoError(t, err)
+
+	repo := repository.NewDependencyRepositoryJsonImpl(resultRepo)
+
+	t.Run("Collects dependencies from every manifest", func(t *testing.T) {
+		dependencies, err := repo.GetDependencies()
+
+		assert.NoError(t, err)
+		require.Len(t, dependencies, 3)
+
+		assert.Equal(t, "go.mod", dependencies[0].DeclaredFile)
+		assert.Equal(t, "pkg:golang/github.com/spf13/cobra", dependencies[0].Purl)
+
+		assert.Equal(t, "package.json", dependencies[1].DeclaredFile)
+		assert.Equal(t, "pkg:npm/jest", dependencies[1].Purl)
+		assert.Equal(t, "devDependencies", dependencies[1].Scope)
+
+		assert.Equal(t, "pkg:npm/react", dependencies[2].Purl)
+		assert.Equal(t, "^18.2.0", dependencies[2].Requirement)
+		require.Len(t, dependencies[2].Licenses, 1)
+		assert.Equal(t, "MIT", dependencies[2].Licenses[0].SpdxID)
+	})
+
+	t.Run("Finds a single declared dependency", func(t *testing.T) {
+		dependency := repo.GetDependency("package.json", "pkg:npm/react")
+
+		require.NotNil(t, dependency)
+		assert.Equal(t, "react", dependency.Component)
+		assert.Nil(t, repo.GetDependency("package.json", "pkg:npm/vue"))
+		assert.Nil(t, repo.GetDependency("missing.json", "pkg:npm/react"))
+	})
+
+	t.Run("Dependency results stay out of the file results list", func(t *testing.T) {
+		filter := mocks.MockResultFilter{}
+		filter.EXPECT().IsValid(mock.Anything).Return(true)
+
+		results, err := resultRepo.GetResults(&filter)
+
+		assert.NoError(t, err)
+		for _, result := range results {
+			assert.False(t, result.IsDependency())
+		}
+	})
+}
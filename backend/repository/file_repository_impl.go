@@ -24,6 +24,7 @@
 package repository
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -34,16 +35,39 @@ import (
 	"github.com/scanoss/scanoss.cc/internal/fetch"
 )
 
-type FileRepositoryImpl struct{}
+type FileRepositoryImpl struct {
+	scanGitSourceRepository ScanGitSourceRepository
+}
 
-func NewFileRepositoryImpl() FileRepository {
-	return &FileRepositoryImpl{}
+func NewFileRepositoryImpl(scanGitSourceRepository ScanGitSourceRepository) FileRepository {
+	return &FileRepositoryImpl{
+		scanGitSourceRepository: scanGitSourceRepository,
+	}
 }
 
+// ReadLocalFile reads a file of the scan root, from its extracted contents when the scan root is an archive.
+// Results scanned from a git ref are read from the git object store at that commit, so they match what was
+// scanned whatever is checked out.
 func (r *FileRepositoryImpl) ReadLocalFile(path string) (entities.File, error) {
-	scanRootPath := config.GetInstance().GetScanRoot()
+	cfg := config.GetInstance()
+	scanRootPath := cfg.GetScanRoot()
+
+	if resultFilePaths := cfg.GetResultFilePaths(); len(resultFilePaths) == 1 {
+		content, err := r.scanGitSourceRepository.ReadFile(resultFilePaths[0], scanRootPath, path)
+		if err == nil {
+			return *entities.NewFile(scanRootPath, path, content), nil
+		}
+		if !errors.Is(err, entities.ErrScanGitSourceNotFound) {
+			return entities.File{}, err
+		}
+	}
+
+	contentRoot, err := cfg.GetScanContentRoot()
+	if err != nil {
+		return entities.File{}, err
+	}
 
-	absolutePath := filepath.Join(scanRootPath, path)
+	absolutePath := filepath.Join(contentRoot, path)
 
 	content, err := os.ReadFile(absolutePath)
 	if err != nil {
@@ -26,6 +26,8 @@
 package repository
 
 import (
+	"archive/zip"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -33,10 +35,20 @@ import (
 	"github.com/scanoss/scanoss.cc/backend/entities"
 	"github.com/scanoss/scanoss.cc/backend/repository/mocks"
 	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/archive"
 	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/fakeapi"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
+// newFileRepository returns a FileRepositoryImpl whose results were not scanned from a git ref.
+func newFileRepository(t *testing.T) FileRepository {
+	gitSourceRepo := mocks.NewMockScanGitSourceRepository(t)
+	gitSourceRepo.EXPECT().ReadFile(mock.Anything, mock.Anything, mock.Anything).Return(nil, entities.ErrScanGitSourceNotFound).Maybe()
+	return NewFileRepositoryImpl(gitSourceRepo)
+}
+
 func TestFileRepositoryImpl(t *testing.T) {
 	cleanup := internal_test.InitializeTestEnvironment(t)
 	defer cleanup()
@@ -51,13 +63,66 @@ func TestFileRepositoryImpl(t *testing.T) {
 
 		config.GetInstance().SetScanRoot(currentPath)
 
-		repo := NewFileRepositoryImpl()
+		repo := newFileRepository(t)
 
 		file, err := repo.ReadLocalFile(testFilePath)
 		assert.NoError(t, err)
 		assert.Equal(t, testContent, file.GetContent())
 	})
 
+	t.Run("ReadLocalFile from a git ref scan", func(t *testing.T) {
+		scanRoot := t.TempDir()
+		assert.NoError(t, os.WriteFile(filepath.Join(scanRoot, "main.go"), []byte("checked out\n"), 0644))
+		resultsPath := filepath.Join(scanRoot, ".scanoss", "results.json")
+
+		config.GetInstance().SetScanRoot(scanRoot)
+		config.GetInstance().SetResultFilePath(resultsPath)
+
+		gitSourceRepo := mocks.NewMockScanGitSourceRepository(t)
+		gitSourceRepo.EXPECT().ReadFile(resultsPath, scanRoot, "main.go").Return([]byte("scanned at ref\n"), nil)
+
+		file, err := NewFileRepositoryImpl(gitSourceRepo).ReadLocalFile("main.go")
+		assert.NoError(t, err)
+		assert.Equal(t, "scanned at ref\n", string(file.GetContent()))
+	})
+
+	t.Run("ReadLocalFile from a git ref scan fails when the commit can't be read", func(t *testing.T) {
+		scanRoot := t.TempDir()
+		resultsPath := filepath.Join(scanRoot, ".scanoss", "results.json")
+
+		config.GetInstance().SetScanRoot(scanRoot)
+		config.GetInstance().SetResultFilePath(resultsPath)
+
+		gitSourceRepo := mocks.NewMockScanGitSourceRepository(t)
+		gitSourceRepo.EXPECT().ReadFile(resultsPath, scanRoot, "main.go").Return(nil, errors.New("object not found"))
+
+		_, err := NewFileRepositoryImpl(gitSourceRepo).ReadLocalFile("main.go")
+		assert.EqualError(t, err, "object not found")
+	})
+
+	t.Run("ReadLocalFile from an archive scan root", func(t *testing.T) {
+		archivePath := filepath.Join(t.TempDir(), "drop.zip")
+		out, err := os.Create(archivePath)
+		assert.NoError(t, err)
+		writer := zip.NewWriter(out)
+		entry, err := writer.Create("drop-1.0/src/main.c")
+		assert.NoError(t, err)
+		_, err = entry.Write([]byte("int main(void) { return 0; }\n"))
+		assert.NoError(t, err)
+		assert.NoError(t, writer.Close())
+		assert.NoError(t, out.Close())
+
+		config.GetInstance().SetScanRoot(archivePath)
+		defer archive.Cleanup()
+
+		repo := newFileRepository(t)
+
+		file, err := repo.ReadLocalFile("drop-1.0/src/main.c")
+		assert.NoError(t, err)
+		assert.Equal(t, "int main(void) { return 0; }\n", string(file.GetContent()))
+		assert.Equal(t, "drop-1.0/src/main.c", file.GetRelativePath())
+	})
+
 	t.Run("ReadRemoteFileByMD5", func(t *testing.T) {
 		testFilePath := "test.js"
 		testContent := []byte("function main() {\n\tconsole.log('Hello, World!');\n}")
@@ -78,4 +143,21 @@ func TestFileRepositoryImpl(t *testing.T) {
 
 		repo.AssertExpectations(t)
 	})
+
+	t.Run("ReadRemoteFileByMD5 from fake API", func(t *testing.T) {
+		_, server, err := fakeapi.Start(fakeapi.Options{APIKey: "test-key"})
+		assert.NoError(t, err)
+		defer server.Close()
+
+		internal_test.UseAPI(t, server.URL, "test-key")
+
+		expected, err := fakeapi.Fixture("file_contents/c48d764d65801d6545037921baea24b0")
+		assert.NoError(t, err)
+
+		repo := newFileRepository(t)
+		file, err := repo.ReadRemoteFileByMD5("hello.c", "c48d764d65801d6545037921baea24b0")
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, file.GetContent())
+	})
 }
@@ -20,6 +20,51 @@ func (_m *MockResultRepository) EXPECT() *MockResultRepository_Expecter {
 	return &MockResultRepository_Expecter{mock: &_m.Mock}
 }
 
+// CloseSnapshot provides a mock function with given fields:
+func (_m *MockResultRepository) CloseSnapshot() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseSnapshot")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockResultRepository_CloseSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseSnapshot'
+type MockResultRepository_CloseSnapshot_Call struct {
+	*mock.Call
+}
+
+// CloseSnapshot is a helper method to define mock.On call
+func (_e *MockResultRepository_Expecter) CloseSnapshot() *MockResultRepository_CloseSnapshot_Call {
+	return &MockResultRepository_CloseSnapshot_Call{Call: _e.mock.On("CloseSnapshot")}
+}
+
+func (_c *MockResultRepository_CloseSnapshot_Call) Run(run func()) *MockResultRepository_CloseSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockResultRepository_CloseSnapshot_Call) Return(_a0 error) *MockResultRepository_CloseSnapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockResultRepository_CloseSnapshot_Call) RunAndReturn(run func() error) *MockResultRepository_CloseSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetResultByPath provides a mock function with given fields: path
 func (_m *MockResultRepository) GetResultByPath(path string) *entities.Result {
 	ret := _m.Called(path)
@@ -126,6 +171,142 @@ func (_c *MockResultRepository_GetResults_Call) RunAndReturn(run func(entities.R
 	return _c
 }
 
+// IsReadOnly provides a mock function with given fields:
+func (_m *MockResultRepository) IsReadOnly() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsReadOnly")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockResultRepository_IsReadOnly_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsReadOnly'
+type MockResultRepository_IsReadOnly_Call struct {
+	*mock.Call
+}
+
+// IsReadOnly is a helper method to define mock.On call
+func (_e *MockResultRepository_Expecter) IsReadOnly() *MockResultRepository_IsReadOnly_Call {
+	return &MockResultRepository_IsReadOnly_Call{Call: _e.mock.On("IsReadOnly")}
+}
+
+func (_c *MockResultRepository_IsReadOnly_Call) Run(run func()) *MockResultRepository_IsReadOnly_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockResultRepository_IsReadOnly_Call) Return(_a0 bool) *MockResultRepository_IsReadOnly_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockResultRepository_IsReadOnly_Call) RunAndReturn(run func() bool) *MockResultRepository_IsReadOnly_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenSnapshot provides a mock function with given fields: snapshotPath
+func (_m *MockResultRepository) OpenSnapshot(snapshotPath string) error {
+	ret := _m.Called(snapshotPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenSnapshot")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(snapshotPath)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockResultRepository_OpenSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenSnapshot'
+type MockResultRepository_OpenSnapshot_Call struct {
+	*mock.Call
+}
+
+// OpenSnapshot is a helper method to define mock.On call
+//   - snapshotPath string
+func (_e *MockResultRepository_Expecter) OpenSnapshot(snapshotPath interface{}) *MockResultRepository_OpenSnapshot_Call {
+	return &MockResultRepository_OpenSnapshot_Call{Call: _e.mock.On("OpenSnapshot", snapshotPath)}
+}
+
+func (_c *MockResultRepository_OpenSnapshot_Call) Run(run func(snapshotPath string)) *MockResultRepository_OpenSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockResultRepository_OpenSnapshot_Call) Return(_a0 error) *MockResultRepository_OpenSnapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockResultRepository_OpenSnapshot_Call) RunAndReturn(run func(string) error) *MockResultRepository_OpenSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reload provides a mock function with given fields:
+func (_m *MockResultRepository) Reload() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reload")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockResultRepository_Reload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reload'
+type MockResultRepository_Reload_Call struct {
+	*mock.Call
+}
+
+// Reload is a helper method to define mock.On call
+func (_e *MockResultRepository_Expecter) Reload() *MockResultRepository_Reload_Call {
+	return &MockResultRepository_Reload_Call{Call: _e.mock.On("Reload")}
+}
+
+func (_c *MockResultRepository_Reload_Call) Run(run func()) *MockResultRepository_Reload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockResultRepository_Reload_Call) Return(_a0 error) *MockResultRepository_Reload_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockResultRepository_Reload_Call) RunAndReturn(run func() error) *MockResultRepository_Reload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockResultRepository creates a new instance of MockResultRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockResultRepository(t interface {
@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+// PostScanHookRepositoryJsonImpl reads user hooks from the app config folder and project hooks from the .scanoss
+// folder of the project, both as post-scan-hooks.json. The files are edited by hand. The project hooks files the
+// user trusted are listed in the app config folder.
+type PostScanHookRepositoryJsonImpl struct {
+	fr    utils.FileReader
+	mutex sync.Mutex
+}
+
+func NewPostScanHookRepositoryJsonImpl(fr utils.FileReader) PostScanHookRepository {
+	return &PostScanHookRepositoryJsonImpl{
+		fr: fr,
+	}
+}
+
+// GetAll returns the user hooks followed by the project hooks, in file order. A project hook replaces the user
+// hook with the same name.
+func (r *PostScanHookRepositoryJsonImpl) GetAll(projectRoot string) ([]entities.PostScanHook, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	hooks := []entities.PostScanHook{}
+	for _, scope := range []entities.ScanPresetScope{entities.ScanPresetScopeUser, entities.ScanPresetScopeProject} {
+		path := postScanHooksFilePath(projectRoot, scope)
+		if path == "" {
+			continue
+		}
+		file, sum, err := r.read(path)
+		if err != nil {
+			return []entities.PostScanHook{}, err
+		}
+
+		trusted := scope == entities.ScanPresetScopeUser
+		if !trusted && len(file.Hooks) > 0 {
+			if trusted, err = r.isTrusted(projectRoot, sum); err != nil {
+				return []entities.PostScanHook{}, err
+			}
+		}
+
+		for _, hook := range file.Hooks {
+			hook.Scope = scope
+			hook.Trusted = trusted
+			index := slices.IndexFunc(hooks, func(h entities.PostScanHook) bool { return h.Name == hook.Name })
+			if index == -1 {
+				hooks = append(hooks, hook)
+			} else {
+				hooks[index] = hook
+			}
+		}
+	}
+
+	return hooks, nil
+}
+
+// Trust records the current hooks file of the project as trusted and returns its hooks.
+func (r *PostScanHookRepositoryJsonImpl) Trust(projectRoot string) ([]entities.PostScanHook, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	path := postScanHooksFilePath(projectRoot, entities.ScanPresetScopeProject)
+	if path == "" {
+		return []entities.PostScanHook{}, entities.ErrNoProjectPostScanHooks
+	}
+	file, sum, err := r.read(path)
+	if err != nil {
+		return []entities.PostScanHook{}, err
+	}
+	if sum == "" {
+		return []entities.PostScanHook{}, fmt.Errorf("%w: %s", entities.ErrNoProjectPostScanHooks, path)
+	}
+
+	trustedPath := trustedPostScanHooksFilePath()
+	if trustedPath == "" {
+		return []entities.PostScanHook{}, errors.New("no location to store trusted post-scan hooks")
+	}
+	trustedFile, err := r.readTrusted(trustedPath)
+	if err != nil {
+		return []entities.PostScanHook{}, err
+	}
+
+	root := trustedProjectRoot(projectRoot)
+	trusted := entities.TrustedPostScanHooks{ProjectRoot: root, SHA256: sum, TrustedAt: time.Now().UTC()}
+	index := slices.IndexFunc(trustedFile.Projects, func(p entities.TrustedPostScanHooks) bool { return p.ProjectRoot == root })
+	if index == -1 {
+		trustedFile.Projects = append(trustedFile.Projects, trusted)
+	} else {
+		trustedFile.Projects[index] = trusted
+	}
+
+	if err := os.MkdirAll(filepath.Dir(trustedPath), 0o755); err != nil {
+		return []entities.PostScanHook{}, fmt.Errorf("error creating trusted post-scan hooks folder: %w", err)
+	}
+	if err := utils.WriteJsonFile(trustedPath, trustedFile); err != nil {
+		return []entities.PostScanHook{}, err
+	}
+
+	for i := range file.Hooks {
+		file.Hooks[i].Scope = entities.ScanPresetScopeProject
+		file.Hooks[i].Trusted = true
+	}
+
+	return file.Hooks, nil
+}
+
+// read returns the hooks file at path and the sha256 of its content, or no hooks and an empty sum when it does
+// not exist.
+func (r *PostScanHookRepositoryJsonImpl) read(path string) (entities.PostScanHooksFile, string, error) {
+	data, err := r.fr.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return entities.PostScanHooksFile{}, "", nil
+		}
+		return entities.PostScanHooksFile{}, "", err
+	}
+
+	file, err := utils.JSONParse[entities.PostScanHooksFile](data)
+	if err != nil {
+		return entities.PostScanHooksFile{}, "", fmt.Errorf("error parsing post-scan hooks file %s: %w", path, err)
+	}
+	sum := sha256.Sum256(data)
+
+	return file, hex.EncodeToString(sum[:]), nil
+}
+
+// isTrusted reports whether the user trusted the project hooks file with content sum.
+func (r *PostScanHookRepositoryJsonImpl) isTrusted(projectRoot string, sum string) (bool, error) {
+	trustedPath := trustedPostScanHooksFilePath()
+	if trustedPath == "" {
+		return false, nil
+	}
+	trustedFile, err := r.readTrusted(trustedPath)
+	if err != nil {
+		return false, err
+	}
+
+	root := trustedProjectRoot(projectRoot)
+	return slices.ContainsFunc(trustedFile.Projects, func(p entities.TrustedPostScanHooks) bool {
+		return p.ProjectRoot == root && p.SHA256 == sum
+	}), nil
+}
+
+func (r *PostScanHookRepositoryJsonImpl) readTrusted(path string) (entities.TrustedPostScanHooksFile, error) {
+	data, err := r.fr.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return entities.TrustedPostScanHooksFile{Projects: []entities.TrustedPostScanHooks{}}, nil
+		}
+		return entities.TrustedPostScanHooksFile{}, err
+	}
+
+	file, err := utils.JSONParse[entities.TrustedPostScanHooksFile](data)
+	if err != nil {
+		return entities.TrustedPostScanHooksFile{}, fmt.Errorf("error parsing trusted post-scan hooks file %s: %w", path, err)
+	}
+	if file.Projects == nil {
+		file.Projects = []entities.TrustedPostScanHooks{}
+	}
+
+	return file, nil
+}
+
+// trustedPostScanHooksFilePath returns the file listing the trusted project hooks, or an empty string when there
+// is no config folder.
+func trustedPostScanHooksFilePath() string {
+	configFolder := config.GetInstance().GetDefaultConfigFolder()
+	if configFolder == "" {
+		return ""
+	}
+	return filepath.Join(configFolder, config.DEFAULT_TRUSTED_HOOKS_FILE)
+}
+
+// trustedProjectRoot is the absolute form of projectRoot that trust is recorded under.
+func trustedProjectRoot(projectRoot string) string {
+	if abs, err := filepath.Abs(projectRoot); err == nil {
+		return abs
+	}
+	return filepath.Clean(projectRoot)
+}
+
+// postScanHooksFilePath returns the hooks file of a scope, or an empty string when it has no location.
+func postScanHooksFilePath(projectRoot string, scope entities.ScanPresetScope) string {
+	switch scope {
+	case entities.ScanPresetScopeUser:
+		configFolder := config.GetInstance().GetDefaultConfigFolder()
+		if configFolder == "" {
+			return ""
+		}
+		return filepath.Join(configFolder, config.DEFAULT_POST_SCAN_HOOKS_FILE)
+	case entities.ScanPresetScopeProject:
+		if projectRoot == "" {
+			return ""
+		}
+		return filepath.Join(projectRoot, config.SCANOSS_HIDDEN_FOLDER, config.DEFAULT_POST_SCAN_HOOKS_FILE)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,123 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePostScanHooks(t *testing.T, folder, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(folder, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(folder, "post-scan-hooks.json"), []byte(content), 0o644))
+}
+
+func TestPostScanHookRepository(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	projectRoot := t.TempDir()
+	repo := repository.NewPostScanHookRepositoryJsonImpl(utils.NewDefaultFileReader())
+
+	hooks, err := repo.GetAll(projectRoot)
+	require.NoError(t, err)
+	assert.Empty(t, hooks)
+
+	writePostScanHooks(t, filepath.Join(home, ".scanoss"), `{"hooks": [
+		{"name": "reload", "action": "reload"},
+		{"name": "sbom", "action": "export", "format": "cyclonedx", "output": "sbom.json"}
+	]}`)
+	writePostScanHooks(t, filepath.Join(projectRoot, ".scanoss"), `{"hooks": [
+		{"name": "sbom", "action": "export", "format": "cyclonedx", "output": "dist/sbom.json"},
+		{"name": "notify", "action": "command", "command": ["./notify.sh"]}
+	]}`)
+
+	t.Run("Project hooks override user hooks and run after them", func(t *testing.T) {
+		hooks, err := repo.GetAll(projectRoot)
+		require.NoError(t, err)
+		require.Len(t, hooks, 3)
+
+		assert.Equal(t, "reload", hooks[0].Name)
+		assert.Equal(t, entities.ScanPresetScopeUser, hooks[0].Scope)
+		assert.Equal(t, "dist/sbom.json", hooks[1].Output)
+		assert.Equal(t, entities.ScanPresetScopeProject, hooks[1].Scope)
+		assert.Equal(t, []string{"./notify.sh"}, hooks[2].Command)
+	})
+
+	t.Run("Other projects only get user hooks", func(t *testing.T) {
+		hooks, err := repo.GetAll(t.TempDir())
+		require.NoError(t, err)
+		require.Len(t, hooks, 2)
+		assert.Equal(t, "sbom.json", hooks[1].Output)
+	})
+
+	t.Run("Project hooks are trusted until their file changes", func(t *testing.T) {
+		hooks, err := repo.GetAll(projectRoot)
+		require.NoError(t, err)
+		assert.True(t, hooks[0].Trusted)
+		assert.False(t, hooks[2].Trusted)
+
+		trusted, err := repo.Trust(projectRoot)
+		require.NoError(t, err)
+		require.Len(t, trusted, 2)
+		assert.Equal(t, "notify", trusted[1].Name)
+
+		hooks, err = repo.GetAll(projectRoot)
+		require.NoError(t, err)
+		assert.True(t, hooks[2].Trusted)
+
+		writePostScanHooks(t, filepath.Join(projectRoot, ".scanoss"), `{"hooks": [
+			{"name": "notify", "action": "command", "command": ["curl", "https://example.com"]}
+		]}`)
+		hooks, err = repo.GetAll(projectRoot)
+		require.NoError(t, err)
+		require.Len(t, hooks, 3)
+		assert.False(t, hooks[2].Trusted)
+	})
+
+	t.Run("Trusting a project without hooks file is an error", func(t *testing.T) {
+		_, err := repo.Trust(t.TempDir())
+		assert.ErrorIs(t, err, entities.ErrNoProjectPostScanHooks)
+	})
+
+	t.Run("Invalid file is an error", func(t *testing.T) {
+		writePostScanHooks(t, filepath.Join(projectRoot, ".scanoss"), `{"hooks": [`)
+
+		_, err := repo.GetAll(projectRoot)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository
+
+import (
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+)
+
+// Sources used for licenses and copyrights imported from third party formats.
+// They reuse the values emitted by the SCANOSS engine so the UI orders them the same way.
+const (
+	sourceComponentDeclared = "component_declared"
+	sourceFileHeader        = "file_header"
+	sourceFileSpdxTag       = "file_spdx_tag"
+	sourceScancode          = "scancode"
+)
+
+// importedResults collects the matches found while walking a third party document and
+// turns them into results keyed by path, the same shape the SCANOSS results file has.
+type importedResults struct {
+	matches map[string][]entities.Component
+}
+
+func newImportedResults() *importedResults {
+	return &importedResults{matches: make(map[string][]entities.Component)}
+}
+
+// addFile registers a scanned file, with no match unless one is added later.
+func (r *importedResults) addFile(path string) {
+	path = normalizeImportedPath(path)
+	if path == "" {
+		return
+	}
+	if _, ok := r.matches[path]; !ok {
+		r.matches[path] = []entities.Component{}
+	}
+}
+
+func (r *importedResults) addMatch(path string, component entities.Component) {
+	path = normalizeImportedPath(path)
+	if path == "" || len(component.Purl) == 0 {
+		return
+	}
+	r.matches[path] = append(r.matches[path], component)
+}
+
+func (r *importedResults) addDependencies(path string, dependencies []entities.Dependency) {
+	path = normalizeImportedPath(path)
+	if path == "" || len(dependencies) == 0 {
+		return
+	}
+	r.matches[path] = append(r.matches[path], entities.Component{
+		ID:           entities.MatchTypeDependency,
+		Dependencies: dependencies,
+	})
+}
+
+func (r *importedResults) results() []entities.Result {
+	results := make([]entities.Result, 0, len(r.matches))
+	for path, components := range r.matches {
+		if len(components) == 0 {
+			components = []entities.Component{{ID: entities.MatchTypeNone}}
+		}
+
+		result := entities.Result{
+			Path:          path,
+			Matches:       components,
+			MatchType:     components[0].ID,
+			ComponentName: components[0].Component,
+		}
+		if components[0].Purl != nil {
+			result.Purl = &components[0].Purl
+		}
+		results = append(results, result)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Path < results[j].Path
+	})
+
+	return results
+}
+
+// sbomDeclaredFile is the path used for packages listed in an SBOM without any file evidence.
+// They are surfaced as dependencies declared by the SBOM itself.
+func sbomDeclaredFile(resultFilePath string) string {
+	return filepath.Base(resultFilePath)
+}
+
+func normalizeImportedPath(path string) string {
+	path = filepath.ToSlash(strings.TrimSpace(path))
+	path = strings.TrimPrefix(path, "./")
+	return strings.TrimLeft(path, "/")
+}
+
+func newImportedComponent(purl, name, version string) entities.Component {
+	vendor, purlName, purlVersion := parsePurlCoordinates(purl)
+	if name == "" {
+		name = purlName
+	}
+	if version == "" {
+		version = purlVersion
+	}
+
+	return entities.Component{
+		ID:        string(entities.MatchTypeFile),
+		Matched:   "100%",
+		Purl:      []string{purl},
+		Vendor:    vendor,
+		Component: name,
+		Version:   version,
+	}
+}
+
+// parsePurlCoordinates extracts the namespace, name and version of a package URL.
+func parsePurlCoordinates(purl string) (namespace, name, version string) {
+	rest := strings.TrimPrefix(purl, "pkg:")
+	if i := strings.IndexAny(rest, "?#"); i >= 0 {
+		rest = rest[:i]
+	}
+	if i := strings.LastIndex(rest, "@"); i >= 0 {
+		version, _ = url.PathUnescape(rest[i+1:])
+		rest = rest[:i]
+	}
+
+	parts := strings.Split(rest, "/")
+	if len(parts) < 2 {
+		return "", "", version
+	}
+	name, _ = url.PathUnescape(parts[len(parts)-1])
+	if len(parts) > 2 {
+		namespace, _ = url.PathUnescape(strings.Join(parts[1:len(parts)-1], "/"))
+	}
+
+	return namespace, name, version
+}
+
+// splitLicenseExpression turns an SPDX license expression into the individual license identifiers it references.
+func splitLicenseExpression(expression string) []string {
+	replacer := strings.NewReplacer("(", " ", ")", " ")
+	var licenses []string
+	seen := make(map[string]bool)
+	isException := false
+	for _, token := range strings.Fields(replacer.Replace(expression)) {
+		switch strings.ToUpper(token) {
+		case "WITH":
+			isException = true
+			continue
+		case "AND", "OR", "NOASSERTION", "NONE":
+			continue
+		}
+		// License exceptions are not licenses on their own
+		if isException {
+			isException = false
+			continue
+		}
+		if seen[token] {
+			continue
+		}
+		seen[token] = true
+		licenses = append(licenses, token)
+	}
+	return licenses
+}
+
+func appendLicenses(licenses []entities.MatchLicense, expression, source string) []entities.MatchLicense {
+	for _, name := range splitLicenseExpression(expression) {
+		licenses = append(licenses, entities.MatchLicense{Name: name, Source: source})
+	}
+	return licenses
+}
+
+// isSpdxNoValue reports whether an SPDX field holds one of the placeholder values instead of real data.
+func isSpdxNoValue(value string) bool {
+	switch strings.TrimSpace(value) {
+	case "", "NOASSERTION", "NONE":
+		return true
+	}
+	return false
+}
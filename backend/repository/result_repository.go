@@ -28,4 +28,8 @@ import "github.com/scanoss/scanoss.cc/backend/entities"
 type ResultRepository interface {
 	GetResults(filters entities.ResultFilter) ([]entities.Result, error)
 	GetResultByPath(path string) *entities.Result
+	OpenSnapshot(snapshotPath string) error
+	CloseSnapshot() error
+	Reload() error
+	IsReadOnly() bool
 }
@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+// ResultRepositoryCycloneDXImpl loads results from a CycloneDX JSON SBOM.
+// Components with occurrence evidence become file matches at each location, components
+// without file evidence are listed as dependencies declared by the SBOM itself.
+type ResultRepositoryCycloneDXImpl struct {
+	*fileResultRepository
+}
+
+func NewResultRepositoryCycloneDXImpl(fr utils.FileReader) (*ResultRepositoryCycloneDXImpl, error) {
+	base, err := newFileResultRepository(fr, parseCycloneDXResults)
+	return &ResultRepositoryCycloneDXImpl{fileResultRepository: base}, err
+}
+
+type cycloneDXDocument struct {
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXComponent struct {
+	Type               string                   `json:"type"`
+	Name               string                   `json:"name"`
+	Group              string                   `json:"group"`
+	Version            string                   `json:"version"`
+	Purl               string                   `json:"purl"`
+	Scope              string                   `json:"scope"`
+	Publisher          string                   `json:"publisher"`
+	Copyright          string                   `json:"copyright"`
+	Supplier           *cycloneDXEntity         `json:"supplier"`
+	Licenses           []cycloneDXLicenseChoice `json:"licenses"`
+	Hashes             []cycloneDXHash          `json:"hashes"`
+	ExternalReferences []cycloneDXReference     `json:"externalReferences"`
+	Evidence           *cycloneDXEvidence       `json:"evidence"`
+	Components         []cycloneDXComponent     `json:"components"`
+}
+
+type cycloneDXEntity struct {
+	Name string `json:"name"`
+}
+
+type cycloneDXLicenseChoice struct {
+	License *struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"license"`
+	Expression string `json:"expression"`
+}
+
+type cycloneDXHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cycloneDXReference struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+type cycloneDXEvidence struct {
+	Licenses  []cycloneDXLicenseChoice `json:"licenses"`
+	Copyright []struct {
+		Text string `json:"text"`
+	} `json:"copyright"`
+	Occurrences []struct {
+		Location string `json:"location"`
+	} `json:"occurrences"`
+}
+
+func parseCycloneDXResults(resultFilePath string, data []byte) ([]entities.Result, error) {
+	var document cycloneDXDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("error parsing CycloneDX document: %w", err)
+	}
+
+	imported := newImportedResults()
+	var sbomDependencies []entities.Dependency
+	for _, c := range flattenCycloneDXComponents(document.Components) {
+		if c.Type == "file" && c.Purl == "" {
+			imported.addFile(c.Name)
+			continue
+		}
+		if c.Purl == "" {
+			continue
+		}
+
+		var locations []string
+		if c.Evidence != nil {
+			for _, occurrence := range c.Evidence.Occurrences {
+				locations = append(locations, occurrence.Location)
+			}
+		}
+
+		if len(locations) == 0 {
+			sbomDependencies = append(sbomDependencies, c.toDependency())
+			continue
+		}
+
+		component := c.toComponent()
+		for _, location := range locations {
+			imported.addMatch(location, component)
+		}
+	}
+	imported.addDependencies(sbomDeclaredFile(resultFilePath), sbomDependencies)
+
+	return imported.results(), nil
+}
+
+func flattenCycloneDXComponents(components []cycloneDXComponent) []cycloneDXComponent {
+	var flattened []cycloneDXComponent
+	for _, c := range components {
+		flattened = append(flattened, c)
+		flattened = append(flattened, flattenCycloneDXComponents(c.Components)...)
+	}
+	return flattened
+}
+
+func (c cycloneDXComponent) toComponent() entities.Component {
+	component := newImportedComponent(c.Purl, c.Name, c.Version)
+	if vendor := c.vendor(); vendor != "" {
+		component.Vendor = vendor
+	}
+	component.URL = c.url()
+
+	for _, hash := range c.Hashes {
+		if strings.EqualFold(hash.Alg, "MD5") {
+			component.FileHash = hash.Content
+		}
+	}
+
+	component.Licenses = cycloneDXLicenses(component.Licenses, c.Licenses, sourceComponentDeclared)
+	if c.Copyright != "" {
+		component.Copyrights = append(component.Copyrights, entities.ComponentCopyright{Name: c.Copyright, Source: sourceComponentDeclared})
+	}
+	if c.Evidence != nil {
+		component.Licenses = cycloneDXLicenses(component.Licenses, c.Evidence.Licenses, sourceFileHeader)
+		for _, copyright := range c.Evidence.Copyright {
+			component.Copyrights = append(component.Copyrights, entities.ComponentCopyright{Name: copyright.Text, Source: sourceFileHeader})
+		}
+	}
+
+	return component
+}
+
+func (c cycloneDXComponent) toDependency() entities.Dependency {
+	component := c.toComponent()
+	dependency := entities.Dependency{
+		Component: component.Component,
+		Purl:      c.Purl,
+		Version:   component.Version,
+		Scope:     c.Scope,
+		URL:       component.URL,
+	}
+	for _, license := range component.Licenses {
+		dependency.Licenses = append(dependency.Licenses, entities.DependencyLicense{Name: license.Name, SpdxID: license.Name, URL: license.URL})
+	}
+	return dependency
+}
+
+func (c cycloneDXComponent) vendor() string {
+	switch {
+	case c.Group != "":
+		return c.Group
+	case c.Supplier != nil && c.Supplier.Name != "":
+		return c.Supplier.Name
+	default:
+		return c.Publisher
+	}
+}
+
+func (c cycloneDXComponent) url() string {
+	for _, referenceType := range []string{"vcs", "website", "distribution"} {
+		for _, reference := range c.ExternalReferences {
+			if reference.Type == referenceType && reference.URL != "" {
+				return reference.URL
+			}
+		}
+	}
+	return ""
+}
+
+func cycloneDXLicenses(licenses []entities.MatchLicense, choices []cycloneDXLicenseChoice, source string) []entities.MatchLicense {
+	for _, choice := range choices {
+		if choice.Expression != "" {
+			licenses = appendLicenses(licenses, choice.Expression, source)
+			continue
+		}
+		if choice.License == nil {
+			continue
+		}
+		name := choice.License.ID
+		if name == "" {
+			name = choice.License.Name
+		}
+		if name != "" {
+			licenses = append(licenses, entities.MatchLicense{Name: name, Source: source, URL: choice.License.URL})
+		}
+	}
+	return licenses
+}
@@ -0,0 +1,317 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+type resultParser func(resultFilePath string, data []byte) ([]entities.Result, error)
+
+var resultParsers = map[entities.InputFormat]resultParser{
+	entities.InputFormatScanoss:   parseScanossResults,
+	entities.InputFormatCycloneDX: parseCycloneDXResults,
+	entities.InputFormatSPDX:      parseSpdxResults,
+	entities.InputFormatScanCode:  parseScanCodeResults,
+}
+
+// NewResultRepository returns the result repository for the configured input format.
+// In auto mode the format is detected from the contents every time the results file is loaded,
+// so opening another scan root at runtime picks up its format as well.
+func NewResultRepository(fr utils.FileReader) (ResultRepository, error) {
+	format, err := entities.ParseInputFormat(config.GetInstance().GetInputFormat())
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case entities.InputFormatScanoss:
+		return NewResultRepositoryJsonImpl(fr)
+	case entities.InputFormatCycloneDX:
+		return NewResultRepositoryCycloneDXImpl(fr)
+	case entities.InputFormatSPDX:
+		return NewResultRepositorySpdxImpl(fr)
+	case entities.InputFormatScanCode:
+		return NewResultRepositoryScanCodeImpl(fr)
+	default:
+		return newFileResultRepository(fr, parseResultsByContent)
+	}
+}
+
+func parseResultsByContent(resultFilePath string, data []byte) ([]entities.Result, error) {
+	format := entities.DetectInputFormat(data)
+	log.Debug().Msgf("Detected %s format for results file %s", format, resultFilePath)
+	return resultParsers[format](resultFilePath, data)
+}
+
+// fileResultRepository caches the results read from the configured results file.
+// Each input format only provides the parser, reloading and lookups are shared.
+type fileResultRepository struct {
+	fr           utils.FileReader
+	parse        resultParser
+	cache        []entities.Result
+	pathIndex    map[string]entities.Result
+	lastModified time.Time
+	mutex        sync.RWMutex
+
+	// snapshotPath is set while a scan history snapshot is open in place of the configured results.
+	// snapshotFor keeps the results paths it was opened for, so switching projects closes it.
+	snapshotPath string
+	snapshotFor  []string
+}
+
+func newFileResultRepository(fr utils.FileReader, parse resultParser) (*fileResultRepository, error) {
+	repo := &fileResultRepository{
+		fr:    fr,
+		parse: parse,
+	}
+
+	// Initial cache load
+	if err := repo.refreshCache(); err != nil {
+		log.Error().Err(err).Msg("Error loading initial cache")
+		return repo, err
+	}
+
+	config.GetInstance().RegisterListener(repo.onConfigChange)
+
+	return repo, nil
+}
+
+func (r *fileResultRepository) onConfigChange(newCfg *config.Config) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.snapshotPath != "" && !slices.Equal(r.snapshotFor, newCfg.GetResultFilePaths()) {
+		log.Debug().Msgf("Results files changed, closing snapshot %s", r.snapshotPath)
+		r.snapshotPath = ""
+		r.snapshotFor = nil
+	}
+
+	if err := r.refreshCache(); err != nil {
+		log.Error().Err(err).Msg("Error refreshing results cache after config change")
+	}
+}
+
+// OpenSnapshot serves the results from the given snapshot file until CloseSnapshot is called.
+// While a snapshot is open the results are read-only.
+func (r *fileResultRepository) OpenSnapshot(snapshotPath string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	previousPath, previousFor := r.snapshotPath, r.snapshotFor
+	r.snapshotPath = snapshotPath
+	r.snapshotFor = config.GetInstance().GetResultFilePaths()
+
+	if err := r.refreshCache(); err != nil {
+		r.snapshotPath, r.snapshotFor = previousPath, previousFor
+		return err
+	}
+	return nil
+}
+
+// CloseSnapshot goes back to the configured results files.
+func (r *fileResultRepository) CloseSnapshot() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.snapshotPath == "" {
+		return nil
+	}
+	r.snapshotPath = ""
+	r.snapshotFor = nil
+
+	return r.refreshCache()
+}
+
+// Reload reads the configured results files again, e.g. after a scan rewrote them. An open snapshot is closed.
+func (r *fileResultRepository) Reload() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.snapshotPath = ""
+	r.snapshotFor = nil
+
+	return r.refreshCache()
+}
+
+func (r *fileResultRepository) IsReadOnly() bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.snapshotPath != ""
+}
+
+func (r *fileResultRepository) GetResults(filter entities.ResultFilter) ([]entities.Result, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if filter == nil {
+		return r.cache, nil
+	}
+
+	var filteredResults []entities.Result
+	for _, result := range r.cache {
+		if result.IsEmpty() || result.IsDependency() {
+			continue
+		}
+		if filter.IsValid(result) {
+			filteredResults = append(filteredResults, result)
+		}
+	}
+
+	return filteredResults, nil
+}
+
+// refreshCache loads every configured results file, or only the open snapshot. When several files are merged their paths are
+// rebased onto the scan root, and a file that cannot be loaded is skipped instead of failing the session.
+func (r *fileResultRepository) refreshCache() error {
+	cfg := config.GetInstance()
+	scanRoot := cfg.GetScanRoot()
+	resultFilePaths := cfg.GetResultFilePaths()
+	if r.snapshotPath != "" {
+		resultFilePaths = []string{r.snapshotPath}
+	}
+	merging := len(resultFilePaths) > 1
+
+	scanResults := []entities.Result{}
+	pathIndex := make(map[string]entities.Result)
+	for _, resultFilePath := range resultFilePaths {
+		results, err := r.loadResultFile(resultFilePath)
+		if err != nil {
+			if !merging {
+				return err
+			}
+			log.Error().Err(err).Msgf("Skipping results file %s", resultFilePath)
+			continue
+		}
+
+		origin := resultOrigin(scanRoot, resultFilePath)
+		for _, result := range results {
+			result.Origin = origin
+			if merging {
+				result.Path = rebaseResultPath(scanRoot, resultFilePath, result.Path)
+			}
+			if existing, ok := pathIndex[result.Path]; ok {
+				log.Warn().Msgf("Result for %s found in both %s and %s, keeping the first one", result.Path, existing.Origin, origin)
+				continue
+			}
+			pathIndex[result.Path] = result
+			scanResults = append(scanResults, result)
+		}
+	}
+
+	r.cache = scanResults
+	r.pathIndex = pathIndex
+	return nil
+}
+
+func (r *fileResultRepository) loadResultFile(resultFilePath string) ([]entities.Result, error) {
+	resultByte, err := r.fr.ReadFile(resultFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []entities.Result{}, nil
+		}
+		return nil, entities.ErrReadingResultFile
+	}
+
+	scanResults, err := r.parse(resultFilePath, resultByte)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []entities.Result{}, nil
+		}
+		return nil, entities.ErrParsingResultFile
+	}
+
+	fileInfo, err := os.Stat(resultFilePath)
+	if err == nil && fileInfo.ModTime().After(r.lastModified) {
+		r.lastModified = fileInfo.ModTime()
+	}
+
+	return scanResults, nil
+}
+
+func (r *fileResultRepository) GetResultByPath(path string) *entities.Result {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	result, ok := r.pathIndex[path]
+	if !ok {
+		return nil
+	}
+
+	return &result
+}
+
+// resultOrigin returns the results file path relative to the scan root, or as given when it lives elsewhere.
+func resultOrigin(scanRoot, resultFilePath string) string {
+	if rel, ok := relativeToScanRoot(scanRoot, resultFilePath); ok {
+		return rel
+	}
+	return filepath.ToSlash(resultFilePath)
+}
+
+// rebaseResultPath makes a result path relative to the scan root. Paths in a results file are relative
+// to the folder that was scanned, which is the parent of the .scanoss folder or the folder holding the file.
+func rebaseResultPath(scanRoot, resultFilePath, resultPath string) string {
+	scannedFolder := filepath.Dir(resultFilePath)
+	if filepath.Base(scannedFolder) == config.SCANOSS_HIDDEN_FOLDER {
+		scannedFolder = filepath.Dir(scannedFolder)
+	}
+
+	rel, ok := relativeToScanRoot(scanRoot, scannedFolder)
+	if !ok || rel == "." {
+		return resultPath
+	}
+	return path.Join(rel, resultPath)
+}
+
+func relativeToScanRoot(scanRoot, target string) (string, bool) {
+	if scanRoot == "" {
+		return "", false
+	}
+	absRoot, err := filepath.Abs(scanRoot)
+	if err != nil {
+		return "", false
+	}
+	absTarget, err := filepath.Abs(target)
+	if err != nil {
+		return "", false
+	}
+	rel, err := filepath.Rel(absRoot, absTarget)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
@@ -0,0 +1,306 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cycloneDXDocument = `{
+  "bomFormat": "CycloneDX",
+  "specVersion": "1.5",
+  "components": [
+    {
+      "type": "library",
+      "name": "scanner.c",
+      "group": "scanoss",
+      "version": "1.0.0",
+      "purl": "pkg:github/scanoss/scanner.c@1.0.0",
+      "licenses": [{"expression": "GPL-2.0-only WITH Classpath-exception-2.0 OR MIT"}],
+      "externalReferences": [{"type": "vcs", "url": "https://github.com/scanoss/scanner.c"}],
+      "evidence": {"occurrences": [{"location": "./src/scanner.c"}, {"location": "src/main.c"}]}
+    },
+    {
+      "type": "library",
+      "name": "lodash",
+      "version": "4.17.21",
+      "purl": "pkg:npm/lodash@4.17.21",
+      "scope": "required",
+      "licenses": [{"license": {"id": "MIT"}}]
+    },
+    {"type": "file", "name": "src/util.c"}
+  ]
+}`
+
+const spdxDocument = `{
+  "spdxVersion": "SPDX-2.3",
+  "SPDXID": "SPDXRef-DOCUMENT",
+  "documentDescribes": ["SPDXRef-Project"],
+  "packages": [
+    {"SPDXID": "SPDXRef-Project", "name": "project", "hasFiles": ["SPDXRef-File-1"]},
+    {
+      "SPDXID": "SPDXRef-Zlib",
+      "name": "zlib",
+      "versionInfo": "1.3",
+      "supplier": "Organization: madler",
+      "licenseDeclared": "Zlib",
+      "externalRefs": [{"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:github/madler/zlib@1.3"}]
+    },
+    {
+      "SPDXID": "SPDXRef-Express",
+      "name": "express",
+      "versionInfo": "4.18.2",
+      "licenseDeclared": "NOASSERTION",
+      "licenseConcluded": "MIT",
+      "externalRefs": [{"referenceCategory": "PACKAGE_MANAGER", "referenceType": "purl", "referenceLocator": "pkg:npm/express@4.18.2"}]
+    }
+  ],
+  "files": [
+    {"SPDXID": "SPDXRef-File-1", "fileName": "./src/app.c"},
+    {
+      "SPDXID": "SPDXRef-File-2",
+      "fileName": "./third_party/zlib/inflate.c",
+      "checksums": [{"algorithm": "MD5", "checksumValue": "d41d8cd98f00b204e9800998ecf8427e"}],
+      "licenseInfoInFiles": ["Zlib"],
+      "copyrightText": "Copyright (C) 1995-2023 Mark Adler"
+    }
+  ],
+  "relationships": [
+    {"spdxElementId": "SPDXRef-Zlib", "relationshipType": "CONTAINS", "relatedSpdxElement": "SPDXRef-File-2"}
+  ]
+}`
+
+const scanCodeDocument = `{
+  "headers": [{"tool_name": "scancode-toolkit", "tool_version": "32.0.8"}],
+  "packages": [
+    {"type": "npm", "name": "left-pad", "version": "1.3.0", "purl": "pkg:npm/left-pad@1.3.0", "package_uid": "pkg:npm/left-pad@1.3.0?uuid=1", "declared_license_expression_spdx": "WTFPL"}
+  ],
+  "dependencies": [
+    {"purl": "pkg:npm/tape", "extracted_requirement": "*", "scope": "devDependencies", "datafile_path": "project/vendor/left-pad/package.json"}
+  ],
+  "files": [
+    {"path": "project", "type": "directory"},
+    {"path": "project/main.js", "type": "file"},
+    {"path": "project/vendor/left-pad/index.js", "type": "file", "md5": "abc", "detected_license_expression_spdx": "WTFPL", "copyrights": [{"copyright": "Copyright (c) 2016 Cameron"}], "for_packages": ["pkg:npm/left-pad@1.3.0?uuid=1"]},
+    {"path": "project/vendor/left-pad/package.json", "type": "file", "for_packages": ["pkg:npm/left-pad@1.3.0?uuid=1"]}
+  ]
+}`
+
+func newImportedResultRepository(t *testing.T, document string) repository.ResultRepository {
+	t.Helper()
+
+	mu := internal_test.NewMockUtils()
+	mu.On("ReadFile", config.GetInstance().GetResultFilePath()).Return([]byte(document), nil)
+
+	repo, err := repository.NewResultRepository(mu)
+	require.NoError(t, err)
+	return repo
+}
+
+func TestNewResultRepository(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	t.Run("CycloneDX", func(t *testing.T) {
+		repo := newImportedResultRepository(t, cycloneDXDocument)
+
+		results, err := repo.GetResults(nil)
+		require.NoError(t, err)
+		assert.Len(t, results, 4)
+
+		result := repo.GetResultByPath("src/scanner.c")
+		require.NotNil(t, result)
+		assert.Equal(t, "file", result.MatchType)
+		assert.Equal(t, "scanner.c", result.ComponentName)
+		assert.Equal(t, []string{"pkg:github/scanoss/scanner.c@1.0.0"}, *result.Purl)
+		assert.Equal(t, "scanoss", result.Matches[0].Vendor)
+		assert.Equal(t, "https://github.com/scanoss/scanner.c", result.Matches[0].URL)
+		require.Len(t, result.Matches[0].Licenses, 2)
+		assert.Equal(t, "GPL-2.0-only", result.Matches[0].Licenses[0].Name)
+		assert.Equal(t, "MIT", result.Matches[0].Licenses[1].Name)
+		assert.NotNil(t, repo.GetResultByPath("src/main.c"))
+
+		assert.Equal(t, entities.MatchTypeNone, repo.GetResultByPath("src/util.c").MatchType)
+
+		sbom := repo.GetResultByPath("results.json")
+		require.NotNil(t, sbom)
+		assert.Equal(t, entities.MatchTypeDependency, sbom.MatchType)
+		require.Len(t, sbom.Matches[0].Dependencies, 1)
+		assert.Equal(t, "pkg:npm/lodash@4.17.21", sbom.Matches[0].Dependencies[0].Purl)
+		assert.Equal(t, "required", sbom.Matches[0].Dependencies[0].Scope)
+	})
+
+	t.Run("SPDX", func(t *testing.T) {
+		repo := newImportedResultRepository(t, spdxDocument)
+
+		assert.Equal(t, entities.MatchTypeNone, repo.GetResultByPath("src/app.c").MatchType)
+
+		result := repo.GetResultByPath("third_party/zlib/inflate.c")
+		require.NotNil(t, result)
+		assert.Equal(t, "file", result.MatchType)
+		match := result.Matches[0]
+		assert.Equal(t, "zlib", match.Component)
+		assert.Equal(t, "1.3", match.Version)
+		assert.Equal(t, "madler", match.Vendor)
+		assert.Equal(t, "d41d8cd98f00b204e9800998ecf8427e", match.FileHash)
+		assert.Len(t, match.Licenses, 2)
+		assert.Equal(t, []entities.ComponentCopyright{{Name: "Copyright (C) 1995-2023 Mark Adler", Source: "file_header"}}, match.Copyrights)
+
+		sbom := repo.GetResultByPath("results.json")
+		require.NotNil(t, sbom)
+		require.Len(t, sbom.Matches[0].Dependencies, 1)
+		dependency := sbom.Matches[0].Dependencies[0]
+		assert.Equal(t, "pkg:npm/express@4.18.2", dependency.Purl)
+		assert.Equal(t, []entities.DependencyLicense{{Name: "MIT", SpdxID: "MIT"}}, dependency.Licenses)
+	})
+
+	t.Run("ScanCode", func(t *testing.T) {
+		repo := newImportedResultRepository(t, scanCodeDocument)
+
+		results, err := repo.GetResults(nil)
+		require.NoError(t, err)
+		assert.Len(t, results, 3)
+
+		assert.Equal(t, entities.MatchTypeNone, repo.GetResultByPath("main.js").MatchType)
+
+		result := repo.GetResultByPath("vendor/left-pad/index.js")
+		require.NotNil(t, result)
+		assert.Equal(t, "left-pad", result.ComponentName)
+		assert.Equal(t, "abc", result.Matches[0].FileHash)
+		assert.Equal(t, "Copyright (c) 2016 Cameron", result.Matches[0].Copyrights[0].Name)
+
+		manifest := repo.GetResultByPath("vendor/left-pad/package.json")
+		require.NotNil(t, manifest)
+		require.Len(t, manifest.Matches, 2)
+		assert.Equal(t, "file", manifest.MatchType)
+		assert.Equal(t, entities.MatchTypeDependency, manifest.Matches[1].ID)
+		assert.Equal(t, "tape", manifest.Matches[1].Dependencies[0].Component)
+		assert.Equal(t, "*", manifest.Matches[1].Dependencies[0].Requirement)
+	})
+
+	t.Run("Explicit format", func(t *testing.T) {
+		config.GetInstance().SetInputFormat(string(entities.InputFormatSPDX))
+		defer config.GetInstance().SetInputFormat("")
+
+		mu := internal_test.NewMockUtils()
+		mu.On("ReadFile", config.GetInstance().GetResultFilePath()).Return([]byte(spdxDocument), nil)
+
+		repo, err := repository.NewResultRepository(mu)
+		require.NoError(t, err)
+		assert.IsType(t, &repository.ResultRepositorySpdxImpl{}, repo)
+	})
+
+	t.Run("Unsupported format", func(t *testing.T) {
+		config.GetInstance().SetInputFormat("xml")
+		defer config.GetInstance().SetInputFormat("")
+
+		_, err := repository.NewResultRepository(internal_test.NewMockUtils())
+		assert.ErrorIs(t, err, entities.ErrUnsupportedInputFormat)
+	})
+}
+
+func TestMergedResults(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	cfg := config.GetInstance()
+	scanRoot := cfg.GetScanRoot()
+	apiResults := filepath.Join(scanRoot, "services", "api", ".scanoss", "results.json")
+	webResults := filepath.Join(scanRoot, "services", "web", "results.json")
+	outsideResults := filepath.Join(t.TempDir(), "results.json")
+
+	mu := internal_test.NewMockUtils()
+	mu.On("ReadFile", apiResults).Return([]byte(`{"src/main.go": [{"id": "file", "purl": ["pkg:github/scanoss/api"]}]}`), nil)
+	mu.On("ReadFile", webResults).Return([]byte(`{"src/main.go": [{"id": "snippet", "purl": ["pkg:github/scanoss/web"]}]}`), nil)
+	mu.On("ReadFile", outsideResults).Return([]byte{}, entities.ErrReadingResultFile)
+	cfg.SetResultFilePaths([]string{apiResults, webResults, outsideResults})
+
+	repo, err := repository.NewResultRepository(mu)
+	require.NoError(t, err)
+
+	results, err := repo.GetResults(nil)
+	require.NoError(t, err)
+	assert.Len(t, results, 2)
+
+	api := repo.GetResultByPath("services/api/src/main.go")
+	require.NotNil(t, api)
+	assert.Equal(t, "services/api/.scanoss/results.json", api.Origin)
+	assert.Equal(t, "file", api.MatchType)
+
+	web := repo.GetResultByPath("services/web/src/main.go")
+	require.NotNil(t, web)
+	assert.Equal(t, "services/web/results.json", web.Origin)
+
+	assert.Nil(t, repo.GetResultByPath("src/main.go"))
+}
+
+func TestResultSnapshot(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	cfg := config.GetInstance()
+	dir := t.TempDir()
+	latest := filepath.Join(dir, "results.json")
+	snapshot := filepath.Join(dir, "history", "20260301T100000Z", "results.json")
+	other := filepath.Join(dir, "other.json")
+
+	mu := internal_test.NewMockUtils()
+	mu.On("ReadFile", latest).Return([]byte(`{"src/main.c": [{"id": "file"}], "src/util.c": [{"id": "none"}]}`), nil)
+	mu.On("ReadFile", snapshot).Return([]byte(`{"src/main.c": [{"id": "snippet"}]}`), nil)
+	mu.On("ReadFile", other).Return([]byte(`{"lib/other.c": [{"id": "file"}]}`), nil)
+	cfg.SetResultFilePath(latest)
+
+	repo, err := repository.NewResultRepositoryJsonImpl(mu)
+	require.NoError(t, err)
+	assert.False(t, repo.IsReadOnly())
+
+	require.NoError(t, repo.OpenSnapshot(snapshot))
+	assert.True(t, repo.IsReadOnly())
+	results, err := repo.GetResults(nil)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "snippet", repo.GetResultByPath("src/main.c").MatchType)
+
+	require.NoError(t, repo.CloseSnapshot())
+	assert.False(t, repo.IsReadOnly())
+	assert.Equal(t, "file", repo.GetResultByPath("src/main.c").MatchType)
+
+	t.Run("Switching results files closes the snapshot", func(t *testing.T) {
+		require.NoError(t, repo.OpenSnapshot(snapshot))
+		cfg.SetResultFilePath(other)
+
+		assert.False(t, repo.IsReadOnly())
+		assert.NotNil(t, repo.GetResultByPath("lib/other.c"))
+	})
+}
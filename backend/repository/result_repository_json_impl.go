@@ -25,110 +25,28 @@ package repository
 
 import (
 	"encoding/json"
-	"os"
-	"sync"
-	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/scanoss/scanoss.cc/backend/entities"
-	"github.com/scanoss/scanoss.cc/internal/config"
 	"github.com/scanoss/scanoss.cc/internal/utils"
 )
 
 type ResultRepositoryJsonImpl struct {
-	fr           utils.FileReader
-	cache        []entities.Result
-	pathIndex    map[string]entities.Result
-	lastModified time.Time
-	mutex        sync.RWMutex
+	*fileResultRepository
 }
 
 func NewResultRepositoryJsonImpl(fr utils.FileReader) (*ResultRepositoryJsonImpl, error) {
-	repo := &ResultRepositoryJsonImpl{
-		fr: fr,
-	}
-
-	// Initial cache load
-	if err := repo.refreshCache(); err != nil {
-		log.Error().Err(err).Msg("Error loading initial cache")
-		return repo, err
-	}
-
-	config.GetInstance().RegisterListener(repo.onConfigChange)
-
-	return repo, nil
-}
-
-func (r *ResultRepositoryJsonImpl) onConfigChange(newCfg *config.Config) {
-	r.mutex.Lock()
-	defer r.mutex.Unlock()
-
-	if err := r.refreshCache(); err != nil {
-		log.Error().Err(err).Msg("Error refreshing results cache after config change")
-	}
-}
-
-func (r *ResultRepositoryJsonImpl) GetResults(filter entities.ResultFilter) ([]entities.Result, error) {
-	r.mutex.RLock()
-	defer r.mutex.RUnlock()
-
-	if filter == nil {
-		return r.cache, nil
-	}
-
-	var filteredResults []entities.Result
-	for _, result := range r.cache {
-		if result.IsEmpty() || result.IsDependency() {
-			continue
-		}
-		if filter.IsValid(result) {
-			filteredResults = append(filteredResults, result)
-		}
-	}
-
-	return filteredResults, nil
+	base, err := newFileResultRepository(fr, parseScanossResults)
+	return &ResultRepositoryJsonImpl{fileResultRepository: base}, err
 }
 
-func (r *ResultRepositoryJsonImpl) refreshCache() error {
-	resultFilePath := config.GetInstance().GetResultFilePath()
-	resultByte, err := r.fr.ReadFile(resultFilePath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			r.cache = []entities.Result{}
-			return nil
-		}
-		return entities.ErrReadingResultFile
-	}
-
-	scanResults, err := r.parseScanResults(resultByte)
-	if err != nil {
-		if os.IsNotExist(err) {
-			r.cache = []entities.Result{}
-			return nil
-		}
-		return entities.ErrParsingResultFile
-	}
-
-	fileInfo, err := os.Stat(resultFilePath)
-	if err == nil {
-		r.lastModified = fileInfo.ModTime()
-	}
-
-	r.cache = scanResults
-	r.pathIndex = make(map[string]entities.Result)
-	for _, result := range scanResults {
-		r.pathIndex[result.Path] = result
-	}
-	return nil
-}
-
-func (r *ResultRepositoryJsonImpl) parseScanResults(resultByte []byte) ([]entities.Result, error) {
+func parseScanossResults(resultFilePath string, resultByte []byte) ([]entities.Result, error) {
 	var intermediateMap map[string][]entities.Component
 	err := json.Unmarshal(resultByte, &intermediateMap)
 	if err != nil {
 		// Gracefully handle JSON syntax errors
 		if typeError, ok := err.(*json.SyntaxError); ok {
-			log.Error().Err(typeError).Msgf("JSON file %s syntax error: offset %d", config.GetInstance().GetResultFilePath(), typeError.Offset)
+			log.Error().Err(typeError).Msgf("JSON file %s syntax error: offset %d", resultFilePath, typeError.Offset)
 			return []entities.Result{}, nil
 		}
 		log.Error().Err(err).Msg("Error parsing scan results")
@@ -155,15 +73,3 @@ func (r *ResultRepositoryJsonImpl) parseScanResults(resultByte []byte) ([]entiti
 
 	return scanResults, nil
 }
-
-func (r *ResultRepositoryJsonImpl) GetResultByPath(path string) *entities.Result {
-	r.mutex.RLock()
-	defer r.mutex.RUnlock()
-
-	result, ok := r.pathIndex[path]
-	if !ok {
-		return nil
-	}
-
-	return &result
-}
@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+// ResultRepositoryScanCodeImpl loads results from a ScanCode Toolkit JSON report.
+// Files assigned to a detected package become file matches, package manifests
+// become dependency results for the dependencies they declare.
+type ResultRepositoryScanCodeImpl struct {
+	*fileResultRepository
+}
+
+func NewResultRepositoryScanCodeImpl(fr utils.FileReader) (*ResultRepositoryScanCodeImpl, error) {
+	base, err := newFileResultRepository(fr, parseScanCodeResults)
+	return &ResultRepositoryScanCodeImpl{fileResultRepository: base}, err
+}
+
+type scanCodeDocument struct {
+	Files        []scanCodeFile       `json:"files"`
+	Packages     []scanCodePackage    `json:"packages"`
+	Dependencies []scanCodeDependency `json:"dependencies"`
+}
+
+type scanCodeFile struct {
+	Path                          string `json:"path"`
+	Type                          string `json:"type"`
+	MD5                           string `json:"md5"`
+	DetectedLicenseExpressionSpdx string `json:"detected_license_expression_spdx"`
+	// Reports produced before ScanCode 32 list licenses instead of a detected expression
+	Licenses []struct {
+		SpdxLicenseKey string `json:"spdx_license_key"`
+	} `json:"licenses"`
+	Copyrights []struct {
+		Copyright string `json:"copyright"`
+		Value     string `json:"value"`
+	} `json:"copyrights"`
+	PackageData []scanCodePackage `json:"package_data"`
+	ForPackages []string          `json:"for_packages"`
+}
+
+type scanCodePackage struct {
+	Namespace                     string               `json:"namespace"`
+	Name                          string               `json:"name"`
+	Version                       string               `json:"version"`
+	Purl                          string               `json:"purl"`
+	PackageUID                    string               `json:"package_uid"`
+	DeclaredLicenseExpressionSpdx string               `json:"declared_license_expression_spdx"`
+	HomepageURL                   string               `json:"homepage_url"`
+	VcsURL                        string               `json:"vcs_url"`
+	Dependencies                  []scanCodeDependency `json:"dependencies"`
+}
+
+type scanCodeDependency struct {
+	Purl                 string `json:"purl"`
+	ExtractedRequirement string `json:"extracted_requirement"`
+	Scope                string `json:"scope"`
+	DatafilePath         string `json:"datafile_path"`
+}
+
+func parseScanCodeResults(_ string, data []byte) ([]entities.Result, error) {
+	var document scanCodeDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("error parsing ScanCode report: %w", err)
+	}
+
+	root := scanCodeRoot(document.Files)
+	relativePath := func(path string) string {
+		if root == "" {
+			return path
+		}
+		return strings.TrimPrefix(path, root+"/")
+	}
+
+	packages := make(map[string]scanCodePackage)
+	for _, p := range document.Packages {
+		packages[p.PackageUID] = p
+	}
+
+	// Reports with package assembly list dependencies at the top level, older ones only inside package data
+	dependencies := make(map[string][]entities.Dependency)
+	for _, d := range document.Dependencies {
+		dependencies[relativePath(d.DatafilePath)] = append(dependencies[relativePath(d.DatafilePath)], d.toDependency())
+	}
+	hasTopLevelDependencies := len(document.Dependencies) > 0
+
+	imported := newImportedResults()
+	for _, f := range document.Files {
+		if f.Type != "file" {
+			continue
+		}
+		path := relativePath(f.Path)
+		imported.addFile(path)
+
+		for _, packageUID := range f.ForPackages {
+			p, ok := packages[packageUID]
+			if !ok || p.Purl == "" {
+				continue
+			}
+			component := p.toComponent()
+			component.FileHash = f.MD5
+			component.Licenses = appendLicenses(component.Licenses, f.licenseExpression(), sourceScancode)
+			for _, copyright := range f.Copyrights {
+				name := copyright.Copyright
+				if name == "" {
+					name = copyright.Value
+				}
+				component.Copyrights = append(component.Copyrights, entities.ComponentCopyright{Name: name, Source: sourceScancode})
+			}
+			imported.addMatch(path, component)
+		}
+
+		if hasTopLevelDependencies {
+			imported.addDependencies(path, dependencies[path])
+			continue
+		}
+		for _, p := range f.PackageData {
+			var declared []entities.Dependency
+			for _, d := range p.Dependencies {
+				declared = append(declared, d.toDependency())
+			}
+			imported.addDependencies(path, declared)
+		}
+	}
+
+	return imported.results(), nil
+}
+
+// scanCodeRoot returns the scanned directory when the report was produced without --strip-root,
+// in which case every path is prefixed with it.
+func scanCodeRoot(files []scanCodeFile) string {
+	root := ""
+	for _, f := range files {
+		if f.Type == "directory" && !strings.Contains(f.Path, "/") {
+			if root != "" {
+				return ""
+			}
+			root = f.Path
+		}
+	}
+	if root == "" {
+		return ""
+	}
+	for _, f := range files {
+		if f.Path != root && !strings.HasPrefix(f.Path, root+"/") {
+			return ""
+		}
+	}
+	return root
+}
+
+func (f scanCodeFile) licenseExpression() string {
+	if f.DetectedLicenseExpressionSpdx != "" {
+		return f.DetectedLicenseExpressionSpdx
+	}
+	keys := make([]string, 0, len(f.Licenses))
+	for _, license := range f.Licenses {
+		if license.SpdxLicenseKey != "" {
+			keys = append(keys, license.SpdxLicenseKey)
+		}
+	}
+	return strings.Join(keys, " AND ")
+}
+
+func (p scanCodePackage) toComponent() entities.Component {
+	component := newImportedComponent(p.Purl, p.Name, p.Version)
+	if p.Namespace != "" {
+		component.Vendor = p.Namespace
+	}
+	component.URL = p.HomepageURL
+	if component.URL == "" {
+		component.URL = p.VcsURL
+	}
+	component.Licenses = appendLicenses(component.Licenses, p.DeclaredLicenseExpressionSpdx, sourceComponentDeclared)
+	return component
+}
+
+func (d scanCodeDependency) toDependency() entities.Dependency {
+	_, name, version := parsePurlCoordinates(d.Purl)
+	return entities.Dependency{
+		Component:   name,
+		Purl:        d.Purl,
+		Requirement: d.ExtractedRequirement,
+		Version:     version,
+		Scope:       d.Scope,
+	}
+}
@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+// ResultRepositorySpdxImpl loads results from an SPDX 2.x JSON document.
+// Files contained in a package with a purl become file matches, packages without
+// files are listed as dependencies declared by the SBOM itself.
+type ResultRepositorySpdxImpl struct {
+	*fileResultRepository
+}
+
+func NewResultRepositorySpdxImpl(fr utils.FileReader) (*ResultRepositorySpdxImpl, error) {
+	base, err := newFileResultRepository(fr, parseSpdxResults)
+	return &ResultRepositorySpdxImpl{fileResultRepository: base}, err
+}
+
+type spdxDocument struct {
+	SPDXID            string             `json:"SPDXID"`
+	DocumentDescribes []string           `json:"documentDescribes"`
+	Packages          []spdxPackage      `json:"packages"`
+	Files             []spdxFile         `json:"files"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxPackage struct {
+	SPDXID           string `json:"SPDXID"`
+	Name             string `json:"name"`
+	VersionInfo      string `json:"versionInfo"`
+	Supplier         string `json:"supplier"`
+	Originator       string `json:"originator"`
+	Homepage         string `json:"homepage"`
+	DownloadLocation string `json:"downloadLocation"`
+	LicenseConcluded string `json:"licenseConcluded"`
+	LicenseDeclared  string `json:"licenseDeclared"`
+	CopyrightText    string `json:"copyrightText"`
+	ExternalRefs     []struct {
+		ReferenceCategory string `json:"referenceCategory"`
+		ReferenceType     string `json:"referenceType"`
+		ReferenceLocator  string `json:"referenceLocator"`
+	} `json:"externalRefs"`
+	HasFiles []string `json:"hasFiles"`
+}
+
+type spdxFile struct {
+	SPDXID             string   `json:"SPDXID"`
+	FileName           string   `json:"fileName"`
+	LicenseConcluded   string   `json:"licenseConcluded"`
+	LicenseInfoInFiles []string `json:"licenseInfoInFiles"`
+	CopyrightText      string   `json:"copyrightText"`
+	Checksums          []struct {
+		Algorithm     string `json:"algorithm"`
+		ChecksumValue string `json:"checksumValue"`
+	} `json:"checksums"`
+}
+
+type spdxRelationship struct {
+	SpdxElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSpdxElement string `json:"relatedSpdxElement"`
+}
+
+func parseSpdxResults(resultFilePath string, data []byte) ([]entities.Result, error) {
+	var document spdxDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("error parsing SPDX document: %w", err)
+	}
+
+	// Packages described by the document are the scanned project itself, files they contain are not matches
+	described := make(map[string]bool)
+	for _, id := range document.DocumentDescribes {
+		described[id] = true
+	}
+
+	packages := make(map[string]spdxPackage)
+	filePackages := make(map[string][]string)
+	for _, p := range document.Packages {
+		packages[p.SPDXID] = p
+		for _, fileID := range p.HasFiles {
+			filePackages[fileID] = append(filePackages[fileID], p.SPDXID)
+		}
+	}
+	for _, r := range document.Relationships {
+		switch r.RelationshipType {
+		case "DESCRIBES":
+			described[r.RelatedSpdxElement] = true
+		case "DESCRIBED_BY":
+			described[r.SpdxElementID] = true
+		case "CONTAINS":
+			filePackages[r.RelatedSpdxElement] = append(filePackages[r.RelatedSpdxElement], r.SpdxElementID)
+		case "CONTAINED_BY":
+			filePackages[r.SpdxElementID] = append(filePackages[r.SpdxElementID], r.RelatedSpdxElement)
+		}
+	}
+
+	imported := newImportedResults()
+	packagesWithFiles := make(map[string]bool)
+	for _, f := range document.Files {
+		imported.addFile(f.FileName)
+
+		// The same containment can be stated both by hasFiles and by a relationship
+		matched := make(map[string]bool)
+		for _, packageID := range filePackages[f.SPDXID] {
+			p, ok := packages[packageID]
+			if !ok || matched[packageID] || described[packageID] || p.purl() == "" {
+				continue
+			}
+			matched[packageID] = true
+			packagesWithFiles[packageID] = true
+
+			component := p.toComponent()
+			component.FileHash = f.md5()
+			for _, license := range f.LicenseInfoInFiles {
+				component.Licenses = appendLicenses(component.Licenses, license, sourceFileSpdxTag)
+			}
+			if !isSpdxNoValue(f.CopyrightText) {
+				component.Copyrights = append(component.Copyrights, entities.ComponentCopyright{Name: f.CopyrightText, Source: sourceFileHeader})
+			}
+			imported.addMatch(f.FileName, component)
+		}
+	}
+
+	var sbomDependencies []entities.Dependency
+	for _, p := range document.Packages {
+		if described[p.SPDXID] || packagesWithFiles[p.SPDXID] || p.purl() == "" {
+			continue
+		}
+		sbomDependencies = append(sbomDependencies, p.toDependency())
+	}
+	imported.addDependencies(sbomDeclaredFile(resultFilePath), sbomDependencies)
+
+	return imported.results(), nil
+}
+
+func (p spdxPackage) purl() string {
+	for _, ref := range p.ExternalRefs {
+		if strings.EqualFold(ref.ReferenceType, "purl") {
+			return ref.ReferenceLocator
+		}
+	}
+	return ""
+}
+
+func (p spdxPackage) toComponent() entities.Component {
+	component := newImportedComponent(p.purl(), p.Name, p.VersionInfo)
+	if vendor := spdxActorName(p.Supplier); vendor != "" {
+		component.Vendor = vendor
+	} else if vendor := spdxActorName(p.Originator); vendor != "" {
+		component.Vendor = vendor
+	}
+
+	switch {
+	case !isSpdxNoValue(p.Homepage):
+		component.URL = p.Homepage
+	case !isSpdxNoValue(p.DownloadLocation):
+		component.URL = p.DownloadLocation
+	}
+
+	license := p.LicenseDeclared
+	if isSpdxNoValue(license) {
+		license = p.LicenseConcluded
+	}
+	component.Licenses = appendLicenses(component.Licenses, license, sourceComponentDeclared)
+	if !isSpdxNoValue(p.CopyrightText) {
+		component.Copyrights = append(component.Copyrights, entities.ComponentCopyright{Name: p.CopyrightText, Source: sourceComponentDeclared})
+	}
+
+	return component
+}
+
+func (p spdxPackage) toDependency() entities.Dependency {
+	component := p.toComponent()
+	dependency := entities.Dependency{
+		Component: component.Component,
+		Purl:      p.purl(),
+		Version:   component.Version,
+		URL:       component.URL,
+	}
+	for _, license := range component.Licenses {
+		dependency.Licenses = append(dependency.Licenses, entities.DependencyLicense{Name: license.Name, SpdxID: license.Name})
+	}
+	return dependency
+}
+
+func (f spdxFile) md5() string {
+	for _, checksum := range f.Checksums {
+		if strings.EqualFold(checksum.Algorithm, "MD5") {
+			return checksum.ChecksumValue
+		}
+	}
+	return ""
+}
+
+// spdxActorName strips the actor type prefix, e.g. "Organization: SCANOSS" becomes "SCANOSS".
+func spdxActorName(actor string) string {
+	if isSpdxNoValue(actor) {
+		return ""
+	}
+	if _, name, found := strings.Cut(actor, ":"); found {
+		return strings.TrimSpace(name)
+	}
+	return strings.TrimSpace(actor)
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+// ResultViewRepositoryJsonImpl stores saved views in the .scanoss folder next to the results file,
+// so they follow the project when the scan root changes.
+type ResultViewRepositoryJsonImpl struct {
+	fr    utils.FileReader
+	mutex sync.Mutex
+}
+
+func NewResultViewRepositoryJsonImpl(fr utils.FileReader) ResultViewRepository {
+	return &ResultViewRepositoryJsonImpl{
+		fr: fr,
+	}
+}
+
+func (r *ResultViewRepositoryJsonImpl) GetAll() ([]entities.ResultView, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	file, err := r.read()
+	if err != nil {
+		return []entities.ResultView{}, err
+	}
+
+	return file.Views, nil
+}
+
+func (r *ResultViewRepositoryJsonImpl) Get(name string) (entities.ResultView, error) {
+	views, err := r.GetAll()
+	if err != nil {
+		return entities.ResultView{}, err
+	}
+
+	index := slices.IndexFunc(views, func(v entities.ResultView) bool { return v.Name == name })
+	if index == -1 {
+		return entities.ResultView{}, fmt.Errorf("%w: %s", entities.ErrResultViewNotFound, name)
+	}
+
+	return views[index], nil
+}
+
+// Save adds the view, or replaces the existing view with the same name.
+func (r *ResultViewRepositoryJsonImpl) Save(view entities.ResultView) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	file, err := r.read()
+	if err != nil {
+		return err
+	}
+
+	index := slices.IndexFunc(file.Views, func(v entities.ResultView) bool { return v.Name == view.Name })
+	if index == -1 {
+		file.Views = append(file.Views, view)
+	} else {
+		file.Views[index] = view
+	}
+
+	return utils.WriteJsonFile(config.GetInstance().GetResultViewsFilePath(), file)
+}
+
+func (r *ResultViewRepositoryJsonImpl) Delete(name string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	file, err := r.read()
+	if err != nil {
+		return err
+	}
+
+	index := slices.IndexFunc(file.Views, func(v entities.ResultView) bool { return v.Name == name })
+	if index == -1 {
+		return fmt.Errorf("%w: %s", entities.ErrResultViewNotFound, name)
+	}
+	file.Views = slices.Delete(file.Views, index, index+1)
+
+	return utils.WriteJsonFile(config.GetInstance().GetResultViewsFilePath(), file)
+}
+
+func (r *ResultViewRepositoryJsonImpl) read() (entities.ResultViewsFile, error) {
+	data, err := r.fr.ReadFile(config.GetInstance().GetResultViewsFilePath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return entities.ResultViewsFile{Views: []entities.ResultView{}}, nil
+		}
+		return entities.ResultViewsFile{}, err
+	}
+
+	file, err := utils.JSONParse[entities.ResultViewsFile](data)
+	if err != nil {
+		return entities.ResultViewsFile{}, fmt.Errorf("error parsing views file: %w", err)
+	}
+	if file.Views == nil {
+		file.Views = []entities.ResultView{}
+	}
+
+	return file, nil
+}
@@ -0,0 +1,89 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultViewRepository(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	viewsFile := config.GetInstance().GetResultViewsFilePath()
+	require.Equal(t, filepath.Join(config.GetInstance().GetScanRoot(), ".scanoss", "views.json"), viewsFile)
+	require.NoError(t, os.MkdirAll(filepath.Dir(viewsFile), 0o755))
+
+	repo := repository.NewResultViewRepositoryJsonImpl(utils.NewDefaultFileReader())
+
+	views, err := repo.GetAll()
+	require.NoError(t, err)
+	assert.Empty(t, views)
+
+	pendingSnippets := entities.ResultView{
+		Name: "pending snippets",
+		Filter: entities.RequestResultDTO{
+			MatchType: entities.MatchTypeSnippet,
+			Query:     "state:pending match:>90 path:src/**",
+		},
+	}
+	require.NoError(t, repo.Save(pendingSnippets))
+	require.NoError(t, repo.Save(entities.ResultView{Name: "by path", Filter: entities.RequestResultDTO{
+		Sort: entities.SortConfig{Option: entities.SortByPath, Order: entities.SortOrderAsc},
+	}}))
+
+	view, err := repo.Get("pending snippets")
+	require.NoError(t, err)
+	assert.Equal(t, pendingSnippets, view)
+
+	t.Run("saving an existing name replaces the view", func(t *testing.T) {
+		pendingSnippets.Filter.HasVulnerabilities = true
+		require.NoError(t, repo.Save(pendingSnippets))
+
+		views, err := repo.GetAll()
+		require.NoError(t, err)
+		require.Len(t, views, 2)
+		assert.True(t, views[0].Filter.HasVulnerabilities)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, repo.Delete("by path"))
+		assert.ErrorIs(t, repo.Delete("by path"), entities.ErrResultViewNotFound)
+
+		_, err := repo.Get("by path")
+		assert.ErrorIs(t, err, entities.ErrResultViewNotFound)
+	})
+}
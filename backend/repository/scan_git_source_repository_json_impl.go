@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+type ScanGitSourceRepositoryJsonImpl struct {
+	fr utils.FileReader
+}
+
+func NewScanGitSourceRepositoryJsonImpl(fr utils.FileReader) ScanGitSourceRepository {
+	return &ScanGitSourceRepositoryJsonImpl{
+		fr: fr,
+	}
+}
+
+// Get returns the git source of the given results file. A results file rewritten after its git source was saved,
+// by a later scan of the working tree, no longer comes from that commit and is reported as not found.
+func (r *ScanGitSourceRepositoryJsonImpl) Get(resultsPath string) (entities.ScanGitSource, error) {
+	sourcePath := scanGitSourcePath(resultsPath)
+	data, err := r.fr.ReadFile(sourcePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return entities.ScanGitSource{}, fmt.Errorf("%w: %s", entities.ErrScanGitSourceNotFound, sourcePath)
+		}
+		return entities.ScanGitSource{}, err
+	}
+
+	if isNewerFile(resultsPath, sourcePath) {
+		return entities.ScanGitSource{}, fmt.Errorf("%w: %s is older than its results file", entities.ErrScanGitSourceNotFound, sourcePath)
+	}
+
+	source, err := utils.JSONParse[entities.ScanGitSource](data)
+	if err != nil {
+		return entities.ScanGitSource{}, fmt.Errorf("error parsing scan git source: %w", err)
+	}
+
+	return source, nil
+}
+
+func (r *ScanGitSourceRepositoryJsonImpl) Save(resultsPath string, source entities.ScanGitSource) error {
+	return utils.WriteJsonFile(scanGitSourcePath(resultsPath), source)
+}
+
+func (r *ScanGitSourceRepositoryJsonImpl) ReadFile(resultsPath, scanRoot, filePath string) ([]byte, error) {
+	source, err := r.Get(resultsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := git.PlainOpenWithOptions(scanRoot, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return nil, fmt.Errorf("error opening git repository at %s: %w", scanRoot, err)
+	}
+	commit, err := repo.CommitObject(plumbing.NewHash(source.Commit))
+	if err != nil {
+		return nil, fmt.Errorf("error reading commit %s: %w", source.Commit, err)
+	}
+
+	rel := path.Clean(filepath.ToSlash(filePath))
+	if rel == ".." || strings.HasPrefix(rel, "../") || path.IsAbs(rel) {
+		return nil, fmt.Errorf("%s is outside the scanned folder", filePath)
+	}
+
+	file, err := commit.File(path.Join(source.Path, rel))
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s at %s: %w", filePath, source.Ref, err)
+	}
+	content, err := file.Contents()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(content), nil
+}
+
+// scanGitSourcePath returns the git source file of a results file, results.json has results.git.json.
+func scanGitSourcePath(resultsPath string) string {
+	return strings.TrimSuffix(resultsPath, filepath.Ext(resultsPath)) + config.SCAN_GIT_SOURCE_FILE_SUFFIX
+}
+
+func isNewerFile(path, than string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	thanInfo, err := os.Stat(than)
+	if err != nil {
+		return false
+	}
+	return info.ModTime().After(thanInfo.ModTime())
+}
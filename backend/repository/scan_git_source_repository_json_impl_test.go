@@ -0,0 +1,76 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanGitSourceRepository(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	resultsPath := filepath.Join(t.TempDir(), "results-0123456789ab.json")
+	require.NoError(t, utils.WriteJsonFile(resultsPath, map[string]any{}))
+	repo := repository.NewScanGitSourceRepositoryJsonImpl(utils.NewDefaultFileReader())
+
+	_, err := repo.Get(resultsPath)
+	assert.ErrorIs(t, err, entities.ErrScanGitSourceNotFound)
+
+	source := entities.ScanGitSource{
+		Ref:       "v2.3.0",
+		Commit:    "0123456789abcdef0123456789abcdef01234567",
+		Path:      "src",
+		ScannedAt: time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, repo.Save(resultsPath, source))
+	assert.FileExists(t, filepath.Join(filepath.Dir(resultsPath), "results-0123456789ab.git.json"))
+
+	saved, err := repo.Get(resultsPath)
+	require.NoError(t, err)
+	assert.Equal(t, source, saved)
+
+	t.Run("Ignores the source of results rewritten by a later scan", func(t *testing.T) {
+		later := time.Now().Add(time.Hour)
+		require.NoError(t, os.Chtimes(resultsPath, later, later))
+
+		_, err := repo.Get(resultsPath)
+		assert.ErrorIs(t, err, entities.ErrScanGitSourceNotFound)
+
+		_, err = repo.ReadFile(resultsPath, filepath.Dir(resultsPath), "main.c")
+		assert.ErrorIs(t, err, entities.ErrScanGitSourceNotFound)
+	})
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+const (
+	scanRunFile     = "run.json"
+	scanRunIDFormat = "20060102T150405Z"
+)
+
+// ScanHistoryRepositoryJsonImpl stores each run in its own folder, .scanoss/history/<id>/ holding the results
+// snapshot and a run.json with its metadata. IDs are the UTC creation time, so folders sort chronologically.
+type ScanHistoryRepositoryJsonImpl struct {
+	fr    utils.FileReader
+	mutex sync.Mutex
+}
+
+func NewScanHistoryRepositoryJsonImpl(fr utils.FileReader) ScanHistoryRepository {
+	return &ScanHistoryRepositoryJsonImpl{
+		fr: fr,
+	}
+}
+
+// List returns the runs of the given results file, newest first. Folders without a readable run.json are skipped.
+func (r *ScanHistoryRepositoryJsonImpl) List(resultsPath string) ([]entities.ScanRun, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	entries, err := os.ReadDir(scanHistoryDir(resultsPath))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []entities.ScanRun{}, nil
+		}
+		return nil, fmt.Errorf("error reading scan history: %w", err)
+	}
+
+	runs := make([]entities.ScanRun, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		run, err := r.read(resultsPath, entry.Name())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping scan history entry %s", entry.Name())
+			continue
+		}
+		runs = append(runs, run)
+	}
+
+	slices.SortFunc(runs, func(a, b entities.ScanRun) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(b.ID, a.ID)
+	})
+
+	return runs, nil
+}
+
+func (r *ScanHistoryRepositoryJsonImpl) Get(resultsPath string, id string) (entities.ScanRun, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !isValidScanRunID(id) {
+		return entities.ScanRun{}, fmt.Errorf("%w: %s", entities.ErrScanRunNotFound, id)
+	}
+	return r.read(resultsPath, id)
+}
+
+// Save stores a new run with the given results snapshot and returns it with its ID and snapshot path set.
+func (r *ScanHistoryRepositoryJsonImpl) Save(resultsPath string, run entities.ScanRun, results []byte) (entities.ScanRun, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	historyDir := scanHistoryDir(resultsPath)
+	if err := os.MkdirAll(historyDir, 0o755); err != nil {
+		return entities.ScanRun{}, fmt.Errorf("error creating scan history folder: %w", err)
+	}
+
+	// Runs started within the same second get a numeric suffix
+	baseID := run.CreatedAt.UTC().Format(scanRunIDFormat)
+	run.ID = baseID
+	for n := 2; ; n++ {
+		err := os.Mkdir(filepath.Join(historyDir, run.ID), 0o755)
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return entities.ScanRun{}, fmt.Errorf("error creating scan run folder: %w", err)
+		}
+		run.ID = fmt.Sprintf("%s-%d", baseID, n)
+	}
+
+	runDir := filepath.Join(historyDir, run.ID)
+	run.ResultsFile = filepath.Join(runDir, config.DEFAULT_RESULTS_FILE)
+	if err := utils.WriteFile(run.ResultsFile, results); err != nil {
+		_ = os.RemoveAll(runDir)
+		return entities.ScanRun{}, fmt.Errorf("error writing results snapshot: %w", err)
+	}
+
+	metadata := run
+	metadata.ResultsFile = ""
+	if err := utils.WriteJsonFile(filepath.Join(runDir, scanRunFile), metadata); err != nil {
+		_ = os.RemoveAll(runDir)
+		return entities.ScanRun{}, fmt.Errorf("error writing scan run: %w", err)
+	}
+
+	return run, nil
+}
+
+func (r *ScanHistoryRepositoryJsonImpl) Delete(resultsPath string, id string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !isValidScanRunID(id) {
+		return fmt.Errorf("%w: %s", entities.ErrScanRunNotFound, id)
+	}
+
+	runDir := filepath.Join(scanHistoryDir(resultsPath), id)
+	if _, err := os.Stat(filepath.Join(runDir, scanRunFile)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("%w: %s", entities.ErrScanRunNotFound, id)
+		}
+		return err
+	}
+
+	return os.RemoveAll(runDir)
+}
+
+func (r *ScanHistoryRepositoryJsonImpl) read(resultsPath string, id string) (entities.ScanRun, error) {
+	runDir := filepath.Join(scanHistoryDir(resultsPath), id)
+	data, err := r.fr.ReadFile(filepath.Join(runDir, scanRunFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return entities.ScanRun{}, fmt.Errorf("%w: %s", entities.ErrScanRunNotFound, id)
+		}
+		return entities.ScanRun{}, err
+	}
+
+	run, err := utils.JSONParse[entities.ScanRun](data)
+	if err != nil {
+		return entities.ScanRun{}, fmt.Errorf("error parsing scan run %s: %w", id, err)
+	}
+	run.ID = id
+	run.ResultsFile = filepath.Join(runDir, config.DEFAULT_RESULTS_FILE)
+
+	return run, nil
+}
+
+func scanHistoryDir(resultsPath string) string {
+	return filepath.Join(filepath.Dir(resultsPath), config.DEFAULT_SCAN_HISTORY_FOLDER)
+}
+
+// isValidScanRunID rejects IDs that would point outside the history folder.
+func isValidScanRunID(id string) bool {
+	return id != "" && id != "." && id != ".." && !strings.ContainsAny(id, `/\`)
+}
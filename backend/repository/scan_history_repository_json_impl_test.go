@@ -0,0 +1,97 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanHistoryRepository(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	resultsPath := filepath.Join(t.TempDir(), ".scanoss", "results.json")
+	repo := repository.NewScanHistoryRepositoryJsonImpl(utils.NewDefaultFileReader())
+
+	runs, err := repo.List(resultsPath)
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+
+	createdAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	first, err := repo.Save(resultsPath, entities.ScanRun{CreatedAt: createdAt, Scanner: "python", ResultCount: 1}, []byte(`{"a.c": []}`))
+	require.NoError(t, err)
+	assert.Equal(t, "20260301T100000Z", first.ID)
+	assert.Equal(t, filepath.Join(filepath.Dir(resultsPath), "history", first.ID, "results.json"), first.ResultsFile)
+
+	snapshot, err := os.ReadFile(first.ResultsFile)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"a.c": []}`, string(snapshot))
+
+	t.Run("Runs in the same second get distinct IDs", func(t *testing.T) {
+		second, err := repo.Save(resultsPath, entities.ScanRun{CreatedAt: createdAt.Add(time.Millisecond), Scanner: "native"}, []byte(`{}`))
+		require.NoError(t, err)
+		assert.Equal(t, "20260301T100000Z-2", second.ID)
+
+		runs, err := repo.List(resultsPath)
+		require.NoError(t, err)
+		require.Len(t, runs, 2)
+		assert.Equal(t, second.ID, runs[0].ID)
+		assert.Equal(t, first, runs[1])
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		run, err := repo.Get(resultsPath, first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, first, run)
+
+		_, err = repo.Get(resultsPath, "missing")
+		assert.ErrorIs(t, err, entities.ErrScanRunNotFound)
+
+		_, err = repo.Get(resultsPath, "../..")
+		assert.ErrorIs(t, err, entities.ErrScanRunNotFound)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, repo.Delete(resultsPath, first.ID))
+		assert.NoDirExists(t, filepath.Dir(first.ResultsFile))
+
+		err := repo.Delete(resultsPath, first.ID)
+		assert.ErrorIs(t, err, entities.ErrScanRunNotFound)
+
+		err = repo.Delete(resultsPath, "..")
+		assert.ErrorIs(t, err, entities.ErrScanRunNotFound)
+	})
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+type ScanManifestRepositoryJsonImpl struct {
+	fr utils.FileReader
+}
+
+func NewScanManifestRepositoryJsonImpl(fr utils.FileReader) ScanManifestRepository {
+	return &ScanManifestRepositoryJsonImpl{
+		fr: fr,
+	}
+}
+
+// Get returns the manifest of the given results file, or ErrScanManifestNotFound if it was never scanned incrementally.
+func (r *ScanManifestRepositoryJsonImpl) Get(resultsPath string) (entities.ScanManifest, error) {
+	path := scanManifestPath(resultsPath)
+	data, err := r.fr.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return entities.ScanManifest{}, fmt.Errorf("%w: %s", entities.ErrScanManifestNotFound, path)
+		}
+		return entities.ScanManifest{}, err
+	}
+
+	manifest, err := utils.JSONParse[entities.ScanManifest](data)
+	if err != nil {
+		return entities.ScanManifest{}, fmt.Errorf("error parsing scan manifest: %w", err)
+	}
+	if manifest.Files == nil {
+		manifest.Files = map[string]entities.ScanManifestEntry{}
+	}
+
+	return manifest, nil
+}
+
+func (r *ScanManifestRepositoryJsonImpl) Save(resultsPath string, manifest entities.ScanManifest) error {
+	return utils.WriteJsonFile(scanManifestPath(resultsPath), manifest)
+}
+
+func scanManifestPath(resultsPath string) string {
+	return filepath.Join(filepath.Dir(resultsPath), config.DEFAULT_SCAN_MANIFEST_FILE)
+}
@@ -0,0 +1,63 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanManifestRepository(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	resultsPath := filepath.Join(t.TempDir(), "results.json")
+	repo := repository.NewScanManifestRepositoryJsonImpl(utils.NewDefaultFileReader())
+
+	_, err := repo.Get(resultsPath)
+	assert.ErrorIs(t, err, entities.ErrScanManifestNotFound)
+
+	manifest := entities.ScanManifest{
+		ScannedAt: time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+		Files: map[string]entities.ScanManifestEntry{
+			"src/main.c": {MD5: "c48d764d65801d6545037921baea24b0", Size: 138, ModTime: time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)},
+		},
+	}
+	require.NoError(t, repo.Save(resultsPath, manifest))
+	assert.FileExists(t, filepath.Join(filepath.Dir(resultsPath), "scan-manifest.json"))
+
+	saved, err := repo.Get(resultsPath)
+	require.NoError(t, err)
+	assert.Equal(t, manifest, saved)
+}
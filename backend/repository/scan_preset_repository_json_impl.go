@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+// ScanPresetRepositoryJsonImpl keeps user presets in the app config folder and project presets in the .scanoss
+// folder of the project, both as scan-presets.json.
+type ScanPresetRepositoryJsonImpl struct {
+	fr    utils.FileReader
+	mutex sync.Mutex
+}
+
+func NewScanPresetRepositoryJsonImpl(fr utils.FileReader) ScanPresetRepository {
+	return &ScanPresetRepositoryJsonImpl{
+		fr: fr,
+	}
+}
+
+// GetAll returns the user presets followed by the project presets. A project preset replaces the user preset
+// with the same name.
+func (r *ScanPresetRepositoryJsonImpl) GetAll(projectRoot string) ([]entities.ScanPreset, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	presets := []entities.ScanPreset{}
+	for _, scope := range []entities.ScanPresetScope{entities.ScanPresetScopeUser, entities.ScanPresetScopeProject} {
+		path := scanPresetsFilePath(projectRoot, scope)
+		if path == "" {
+			continue
+		}
+		file, err := r.read(path)
+		if err != nil {
+			return []entities.ScanPreset{}, err
+		}
+
+		for _, preset := range file.Presets {
+			preset.Scope = scope
+			index := slices.IndexFunc(presets, func(p entities.ScanPreset) bool { return p.Name == preset.Name })
+			if index == -1 {
+				presets = append(presets, preset)
+			} else {
+				presets[index] = preset
+			}
+		}
+	}
+
+	return presets, nil
+}
+
+func (r *ScanPresetRepositoryJsonImpl) Get(projectRoot string, name string) (entities.ScanPreset, error) {
+	presets, err := r.GetAll(projectRoot)
+	if err != nil {
+		return entities.ScanPreset{}, err
+	}
+
+	index := slices.IndexFunc(presets, func(p entities.ScanPreset) bool { return p.Name == name })
+	if index == -1 {
+		return entities.ScanPreset{}, fmt.Errorf("%w: %s", entities.ErrScanPresetNotFound, name)
+	}
+
+	return presets[index], nil
+}
+
+// Save adds the preset to the file of its scope, or replaces the preset with the same name there.
+func (r *ScanPresetRepositoryJsonImpl) Save(projectRoot string, preset entities.ScanPreset) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	path := scanPresetsFilePath(projectRoot, preset.Scope)
+	if path == "" {
+		return fmt.Errorf("no location to store %s scan presets", preset.Scope)
+	}
+	file, err := r.read(path)
+	if err != nil {
+		return err
+	}
+
+	preset.Scope = ""
+	index := slices.IndexFunc(file.Presets, func(p entities.ScanPreset) bool { return p.Name == preset.Name })
+	if index == -1 {
+		file.Presets = append(file.Presets, preset)
+	} else {
+		file.Presets[index] = preset
+	}
+
+	return r.write(path, file)
+}
+
+func (r *ScanPresetRepositoryJsonImpl) Delete(projectRoot string, name string, scope entities.ScanPresetScope) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	path := scanPresetsFilePath(projectRoot, scope)
+	if path == "" {
+		return fmt.Errorf("%w: %s", entities.ErrScanPresetNotFound, name)
+	}
+	file, err := r.read(path)
+	if err != nil {
+		return err
+	}
+
+	index := slices.IndexFunc(file.Presets, func(p entities.ScanPreset) bool { return p.Name == name })
+	if index == -1 {
+		return fmt.Errorf("%w: %s", entities.ErrScanPresetNotFound, name)
+	}
+	file.Presets = slices.Delete(file.Presets, index, index+1)
+
+	return r.write(path, file)
+}
+
+func (r *ScanPresetRepositoryJsonImpl) read(path string) (entities.ScanPresetsFile, error) {
+	data, err := r.fr.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return entities.ScanPresetsFile{Presets: []entities.ScanPreset{}}, nil
+		}
+		return entities.ScanPresetsFile{}, err
+	}
+
+	file, err := utils.JSONParse[entities.ScanPresetsFile](data)
+	if err != nil {
+		return entities.ScanPresetsFile{}, fmt.Errorf("error parsing scan presets file %s: %w", path, err)
+	}
+	if file.Presets == nil {
+		file.Presets = []entities.ScanPreset{}
+	}
+
+	return file, nil
+}
+
+func (r *ScanPresetRepositoryJsonImpl) write(path string, file entities.ScanPresetsFile) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("error creating scan presets folder: %w", err)
+	}
+	return utils.WriteJsonFile(path, file)
+}
+
+// scanPresetsFilePath returns the presets file of a scope, or an empty string when it has no location.
+func scanPresetsFilePath(projectRoot string, scope entities.ScanPresetScope) string {
+	switch scope {
+	case entities.ScanPresetScopeUser:
+		configFolder := config.GetInstance().GetDefaultConfigFolder()
+		if configFolder == "" {
+			return ""
+		}
+		return filepath.Join(configFolder, config.DEFAULT_SCAN_PRESETS_FILE)
+	case entities.ScanPresetScopeProject:
+		if projectRoot == "" {
+			return ""
+		}
+		return filepath.Join(projectRoot, config.SCANOSS_HIDDEN_FOLDER, config.DEFAULT_SCAN_PRESETS_FILE)
+	default:
+		return ""
+	}
+}
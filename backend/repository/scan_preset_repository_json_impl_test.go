@@ -0,0 +1,108 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package repository_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanPresetRepository(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	projectRoot := t.TempDir()
+	repo := repository.NewScanPresetRepositoryJsonImpl(utils.NewDefaultFileReader())
+
+	presets, err := repo.GetAll(projectRoot)
+	require.NoError(t, err)
+	assert.Empty(t, presets)
+
+	require.NoError(t, repo.Save(projectRoot, entities.ScanPreset{
+		Name:  "fast",
+		Args:  map[string]any{"threads": 2},
+		Scope: entities.ScanPresetScopeUser,
+	}))
+	require.NoError(t, repo.Save(projectRoot, entities.ScanPreset{
+		Name:  "deep",
+		Args:  map[string]any{"threads": 10},
+		Scope: entities.ScanPresetScopeUser,
+	}))
+	require.NoError(t, repo.Save(projectRoot, entities.ScanPreset{
+		Name:  "deep",
+		Args:  map[string]any{"threads": 20},
+		Scope: entities.ScanPresetScopeProject,
+	}))
+	assert.FileExists(t, filepath.Join(home, ".scanoss", "scan-presets.json"))
+	assert.FileExists(t, filepath.Join(projectRoot, ".scanoss", "scan-presets.json"))
+
+	t.Run("Project presets override user presets", func(t *testing.T) {
+		presets, err := repo.GetAll(projectRoot)
+		require.NoError(t, err)
+		require.Len(t, presets, 2)
+		assert.Equal(t, "fast", presets[0].Name)
+		assert.Equal(t, entities.ScanPresetScopeUser, presets[0].Scope)
+
+		deep, err := repo.Get(projectRoot, "deep")
+		require.NoError(t, err)
+		assert.Equal(t, entities.ScanPresetScopeProject, deep.Scope)
+		assert.Equal(t, float64(20), deep.Args["threads"])
+	})
+
+	t.Run("Other projects only see user presets", func(t *testing.T) {
+		deep, err := repo.Get(t.TempDir(), "deep")
+		require.NoError(t, err)
+		assert.Equal(t, entities.ScanPresetScopeUser, deep.Scope)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, repo.Delete(projectRoot, "deep", entities.ScanPresetScopeProject))
+
+		deep, err := repo.Get(projectRoot, "deep")
+		require.NoError(t, err)
+		assert.Equal(t, entities.ScanPresetScopeUser, deep.Scope)
+
+		err = repo.Delete(projectRoot, "deep", entities.ScanPresetScopeProject)
+		assert.ErrorIs(t, err, entities.ErrScanPresetNotFound)
+	})
+
+	t.Run("Invalid presets file", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(projectRoot, ".scanoss", "scan-presets.json"), []byte(`{`), 0o644))
+
+		_, err := repo.GetAll(projectRoot)
+		assert.ErrorContains(t, err, "error parsing scan presets file")
+	})
+}
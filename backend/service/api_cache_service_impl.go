@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/internal/config"
+)
+
+// APICacheServiceImpl caches the endpoints with a TTL in the cache settings. Failing to use the cache never fails
+// a lookup, it only sends it to the network.
+type APICacheServiceImpl struct {
+	repo   repository.APICacheRepository
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+func NewAPICacheServiceImpl(repo repository.APICacheRepository) APICacheService {
+	return &APICacheServiceImpl{
+		repo: repo,
+	}
+}
+
+func (s *APICacheServiceImpl) Get(endpoint, url string) ([]byte, bool) {
+	if _, ok := s.ttl(endpoint); !ok {
+		return nil, false
+	}
+
+	entry, err := s.repo.Get(url)
+	if err != nil {
+		if !errors.Is(err, entities.ErrAPICacheMiss) {
+			log.Warn().Err(err).Msg("Error reading the API cache")
+		}
+		misses := s.misses.Add(1)
+		log.Debug().Str("endpoint", endpoint).Int64("hits", s.hits.Load()).Int64("misses", misses).Msg("API cache miss")
+		return nil, false
+	}
+
+	hits := s.hits.Add(1)
+	log.Debug().Str("endpoint", endpoint).Int64("hits", hits).Int64("misses", s.misses.Load()).Msg("API cache hit")
+	return []byte(entry.Body), true
+}
+
+func (s *APICacheServiceImpl) Put(endpoint, url string, body []byte) {
+	ttl, ok := s.ttl(endpoint)
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	err := s.repo.Save(entities.APICacheEntry{
+		Endpoint:  endpoint,
+		Key:       url,
+		StoredAt:  now,
+		ExpiresAt: now.Add(ttl),
+		Body:      string(body),
+	})
+	if err != nil {
+		log.Warn().Err(err).Msg("Error writing the API cache")
+	}
+}
+
+func (s *APICacheServiceImpl) Clear() (int, error) {
+	return s.repo.Clear()
+}
+
+func (s *APICacheServiceImpl) Stats() (entities.APICacheStats, error) {
+	return s.repo.Stats()
+}
+
+// ttl returns how long responses of an endpoint are cached. It reports false when the cache is disabled or the
+// endpoint is not cached.
+func (s *APICacheServiceImpl) ttl(endpoint string) (time.Duration, bool) {
+	settings := config.GetInstance().GetAPICacheSettings()
+	if !settings.Enabled {
+		return 0, false
+	}
+	seconds := settings.TTLSeconds[endpoint]
+	return time.Duration(seconds) * time.Second, seconds > 0
+}
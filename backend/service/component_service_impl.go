@@ -137,6 +137,10 @@ func (s *ComponentServiceImpl) GetComponentByPath(filePath string) (entities.Com
 }
 
 func (s *ComponentServiceImpl) FilterComponents(dto []entities.ComponentFilterDTO) error {
+	if s.isReadOnly() {
+		return entities.ErrReadOnlyResults
+	}
+
 	for _, filter := range dto {
 		err := utils.GetValidator().Struct(filter)
 		if err != nil {
@@ -249,6 +253,9 @@ func (s *ComponentServiceImpl) GetDeclaredComponents() ([]entities.DeclaredCompo
 }
 
 func (s *ComponentServiceImpl) Undo() error {
+	if s.isReadOnly() {
+		return entities.ErrReadOnlyResults
+	}
 	if !s.CanUndo() {
 		return nil
 	}
@@ -261,6 +268,9 @@ func (s *ComponentServiceImpl) Undo() error {
 }
 
 func (s *ComponentServiceImpl) Redo() error {
+	if s.isReadOnly() {
+		return entities.ErrReadOnlyResults
+	}
 	if !s.CanRedo() {
 		return nil
 	}
@@ -276,6 +286,11 @@ func (s *ComponentServiceImpl) Redo() error {
 	return nil
 }
 
+// isReadOnly reports whether a past scan run is open, decisions are only taken on the latest results.
+func (s *ComponentServiceImpl) isReadOnly() bool {
+	return s.resultRepo != nil && s.resultRepo.IsReadOnly()
+}
+
 func (s *ComponentServiceImpl) CanUndo() bool {
 	return len(s.undoStack) > 0
 }
@@ -32,6 +32,7 @@ import (
 
 	"github.com/scanoss/scanoss.cc/backend/entities"
 	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/backend/repository/mocks"
 	"github.com/scanoss/scanoss.cc/backend/service"
 	internal_test "github.com/scanoss/scanoss.cc/internal"
 	"github.com/scanoss/scanoss.cc/internal/config"
@@ -123,3 +124,30 @@ func bomIncludes(repo repository.ScanossSettingsRepository, path string) bool {
 	}
 	return false
 }
+
+func TestComponentServiceReadOnlyResults(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	mu := internal_test.NewMockUtils()
+	settingsPath := filepath.Join(t.TempDir(), "scanoss.json")
+	emptySettings, _ := json.Marshal(entities.SettingsFile{})
+	mu.On("ReadFile", settingsPath).Return(emptySettings, nil)
+	config.GetInstance().SetScanSettingsFilePath(settingsPath)
+
+	settingsRepo := repository.NewScanossSettingsJsonRepository(mu)
+	require.NoError(t, settingsRepo.Init())
+
+	resultRepo := mocks.NewMockResultRepository(t)
+	resultRepo.EXPECT().IsReadOnly().Return(true)
+
+	svc := service.NewComponentServiceImpl(nil, settingsRepo, resultRepo, nil, nil)
+
+	err := svc.FilterComponents([]entities.ComponentFilterDTO{
+		{Path: "src/main.c", Purl: "pkg:github/scanoss/scanner.c", Action: entities.Include},
+	})
+	assert.ErrorIs(t, err, entities.ErrReadOnlyResults)
+	assert.ErrorIs(t, svc.Undo(), entities.ErrReadOnlyResults)
+	assert.ErrorIs(t, svc.Redo(), entities.ErrReadOnlyResults)
+	assert.False(t, bomIncludes(settingsRepo, "src/main.c"))
+}
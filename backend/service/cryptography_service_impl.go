@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+type CryptographyServiceImpl struct {
+	repo repository.ResultRepository
+}
+
+func NewCryptographyServiceImpl(repo repository.ResultRepository) CryptographyService {
+	return &CryptographyServiceImpl{
+		repo: repo,
+	}
+}
+
+// GetInventory groups the algorithms detected in matched files by component, sorted by purl.
+func (s *CryptographyServiceImpl) GetInventory(dto *entities.RequestCryptographyDTO) ([]entities.CryptographyInventoryItem, error) {
+	if dto != nil {
+		if err := utils.GetValidator().Struct(dto); err != nil {
+			log.Error().Err(err).Msg("Validation error")
+			return []entities.CryptographyInventoryItem{}, err
+		}
+	}
+
+	results, err := s.repo.GetResults(nil)
+	if err != nil {
+		return []entities.CryptographyInventoryItem{}, err
+	}
+
+	items := make(map[string]*entities.CryptographyInventoryItem)
+	assets := make(map[string]map[string]*entities.CryptographyAsset)
+	for _, result := range results {
+		for _, match := range result.Matches {
+			if len(match.Purl) == 0 {
+				continue
+			}
+			purl := match.Purl[0]
+
+			for _, c := range match.Cryptography {
+				if !dto.Matches(c) {
+					continue
+				}
+
+				if _, ok := items[purl]; !ok {
+					items[purl] = &entities.CryptographyInventoryItem{Purl: purl, Component: match.Component, Version: match.Version}
+					assets[purl] = make(map[string]*entities.CryptographyAsset)
+				}
+
+				key := fmt.Sprintf("%s@%d", c.Algorithm, c.Strength)
+				asset, ok := assets[purl][key]
+				if !ok {
+					asset = &entities.CryptographyAsset{Cryptography: c}
+					assets[purl][key] = asset
+				}
+				if len(asset.Files) == 0 || asset.Files[len(asset.Files)-1] != result.Path {
+					asset.Files = append(asset.Files, result.Path)
+				}
+			}
+		}
+	}
+
+	inventory := make([]entities.CryptographyInventoryItem, 0, len(items))
+	for purl, item := range items {
+		for _, asset := range assets[purl] {
+			sort.Strings(asset.Files)
+			item.Assets = append(item.Assets, *asset)
+		}
+		sort.Slice(item.Assets, func(i, j int) bool {
+			if item.Assets[i].Algorithm != item.Assets[j].Algorithm {
+				return item.Assets[i].Algorithm < item.Assets[j].Algorithm
+			}
+			return item.Assets[i].Strength < item.Assets[j].Strength
+		})
+		inventory = append(inventory, *item)
+	}
+	sort.Slice(inventory, func(i, j int) bool {
+		return inventory[i].Purl < inventory[j].Purl
+	})
+
+	return inventory, nil
+}
+
+// ExportCBOM writes the whole crypto inventory as a CycloneDX CBOM to the given file.
+func (s *CryptographyServiceImpl) ExportCBOM(filePath string) error {
+	inventory, err := s.GetInventory(nil)
+	if err != nil {
+		return err
+	}
+
+	cbom := entities.NewCBOM(inventory, uuid.NewString(), time.Now())
+	data, err := json.MarshalIndent(cbom, "", "  ")
+	if err != nil {
+		log.Error().Err(err).Msg("Error marshalling CBOM")
+		return fmt.Errorf("error marshalling CBOM: %w", err)
+	}
+
+	if err := os.WriteFile(filePath, data, 0o644); err != nil {
+		log.Error().Err(err).Msgf("Error writing CBOM to %s", filePath)
+		return fmt.Errorf("error writing CBOM: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	repositoryMocks "github.com/scanoss/scanoss.cc/backend/repository/mocks"
+	"github.com/scanoss/scanoss.cc/backend/service"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCryptographyService(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	results := []entities.Result{
+		{
+			Path: "src/cipher.c",
+			Matches: []entities.Component{{
+				ID:        "file",
+				Purl:      []string{"pkg:github/openssl/openssl"},
+				Component: "openssl",
+				Version:   "3.0.0",
+				Cryptography: []entities.Cryptography{
+					{Algorithm: "aes", Strength: 256},
+					{Algorithm: "md5", Strength: 128},
+				},
+			}},
+		},
+		{
+			Path: "src/digest.c",
+			Matches: []entities.Component{{
+				ID:           "snippet",
+				Purl:         []string{"pkg:github/openssl/openssl"},
+				Component:    "openssl",
+				Cryptography: []entities.Cryptography{{Algorithm: "aes", Strength: 256}},
+			}},
+		},
+		{
+			Path:    "src/main.c",
+			Matches: []entities.Component{{ID: "file", Purl: []string{"pkg:github/scanoss/engine"}}},
+		},
+	}
+
+	t.Run("GetInventory groups algorithms by component", func(t *testing.T) {
+		repo := repositoryMocks.NewMockResultRepository(t)
+		repo.EXPECT().GetResults(entities.ResultFilter(nil)).Return(results, nil)
+
+		inventory, err := service.NewCryptographyServiceImpl(repo).GetInventory(nil)
+		require.NoError(t, err)
+		require.Len(t, inventory, 1)
+
+		item := inventory[0]
+		assert.Equal(t, "pkg:github/openssl/openssl", item.Purl)
+		assert.Equal(t, "3.0.0", item.Version)
+		require.Len(t, item.Assets, 2)
+		assert.Equal(t, "aes", item.Assets[0].Algorithm)
+		assert.Equal(t, []string{"src/cipher.c", "src/digest.c"}, item.Assets[0].Files)
+		assert.Equal(t, "md5", item.Assets[1].Algorithm)
+	})
+
+	t.Run("GetInventory filters by algorithm and strength", func(t *testing.T) {
+		repo := repositoryMocks.NewMockResultRepository(t)
+		repo.EXPECT().GetResults(entities.ResultFilter(nil)).Return(results, nil)
+
+		inventory, err := service.NewCryptographyServiceImpl(repo).GetInventory(&entities.RequestCryptographyDTO{MinStrength: 200})
+		require.NoError(t, err)
+		require.Len(t, inventory, 1)
+		require.Len(t, inventory[0].Assets, 1)
+		assert.Equal(t, "aes", inventory[0].Assets[0].Algorithm)
+	})
+
+	t.Run("ExportCBOM writes a CycloneDX document", func(t *testing.T) {
+		repo := repositoryMocks.NewMockResultRepository(t)
+		repo.EXPECT().GetResults(entities.ResultFilter(nil)).Return(results, nil)
+
+		filePath := filepath.Join(t.TempDir(), "cbom.json")
+		require.NoError(t, service.NewCryptographyServiceImpl(repo).ExportCBOM(filePath))
+
+		data, err := os.ReadFile(filePath)
+		require.NoError(t, err)
+
+		var cbom entities.CBOM
+		require.NoError(t, json.Unmarshal(data, &cbom))
+		assert.Equal(t, "CycloneDX", cbom.BomFormat)
+		require.Len(t, cbom.Components, 3)
+		assert.Equal(t, "library", cbom.Components[0].Type)
+
+		aes := cbom.Components[1]
+		assert.Equal(t, "cryptographic-asset", aes.Type)
+		assert.Equal(t, "crypto/algorithm/aes@256", aes.BomRef)
+		assert.Equal(t, "block-cipher", aes.CryptoProperties.AlgorithmProperties.Primitive)
+		assert.Equal(t, "256", aes.CryptoProperties.AlgorithmProperties.ParameterSetIdentifier)
+		assert.Len(t, aes.Evidence.Occurrences, 2)
+
+		require.Len(t, cbom.Dependencies, 1)
+		assert.Equal(t, []string{"crypto/algorithm/aes@256", "crypto/algorithm/md5@128"}, cbom.Dependencies[0].DependsOn)
+	})
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/mappers"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+type DependencyServiceImpl struct {
+	repo             repository.DependencyRepository
+	componentService ComponentService
+	mapper           mappers.DependencyMapper
+}
+
+func NewDependencyServiceImpl(repo repository.DependencyRepository, componentService ComponentService, mapper mappers.DependencyMapper) DependencyService {
+	return &DependencyServiceImpl{
+		repo:             repo,
+		componentService: componentService,
+		mapper:           mapper,
+	}
+}
+
+func (s *DependencyServiceImpl) GetAll(dto *entities.RequestDependencyDTO) ([]entities.DependencyDTO, error) {
+	if dto != nil {
+		if err := utils.GetValidator().Struct(dto); err != nil {
+			log.Error().Err(err).Msg("Validation error")
+			return []entities.DependencyDTO{}, err
+		}
+	}
+
+	dependencies, err := s.repo.GetDependencies()
+	if err != nil {
+		return []entities.DependencyDTO{}, err
+	}
+
+	output := make([]entities.DependencyDTO, 0, len(dependencies))
+	for _, dependency := range s.mapper.MapToDependencyDTOList(dependencies) {
+		if dto.Matches(dependency) {
+			output = append(output, dependency)
+		}
+	}
+
+	return output, nil
+}
+
+// GetTree groups dependencies under the manifest file that declares them.
+// Manifest nodes roll up the workflow state of their dependencies.
+func (s *DependencyServiceImpl) GetTree() ([]entities.TreeNode, error) {
+	dependencies, err := s.GetAll(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]entities.TreeNode, 0)
+	nodeIndex := make(map[string]int)
+
+	for _, dependency := range dependencies {
+		i, ok := nodeIndex[dependency.DeclaredFile]
+		if !ok {
+			nodes = append(nodes, entities.NewTreeNode(dependency.DeclaredFile, entities.ResultDTO{}, true))
+			i = len(nodes) - 1
+			nodeIndex[dependency.DeclaredFile] = i
+		}
+
+		leaf := entities.NewTreeNode(dependency.DeclaredFile, entities.ResultDTO{WorkflowState: dependency.WorkflowState}, false)
+		leaf.ID = fmt.Sprintf("%s#%s", dependency.DeclaredFile, dependency.Purl)
+		leaf.Name = dependency.Component
+		if dependency.Version != "" {
+			leaf.Name = fmt.Sprintf("%s@%s", dependency.Component, dependency.Version)
+		}
+		nodes[i].Children = append(nodes[i].Children, leaf)
+	}
+
+	for i := range nodes {
+		nodes[i].WorkflowState = calculateFolderWorkflowState(nodes[i].Children)
+	}
+	sortTreeNodes(nodes)
+
+	return nodes, nil
+}
+
+// FilterDependencies records include/remove/replace decisions for declared
+// dependencies. They go through the component service so they end up in the
+// same BOM lists and share its undo/redo history.
+func (s *DependencyServiceImpl) FilterDependencies(dto []entities.ComponentFilterDTO) error {
+	for _, filter := range dto {
+		if filter.Purl == "" {
+			return fmt.Errorf("a purl is required to filter a dependency")
+		}
+		if filter.Path != "" && s.repo.GetDependency(filter.Path, filter.Purl) == nil {
+			return fmt.Errorf("dependency %s is not declared in %s", filter.Purl, filter.Path)
+		}
+	}
+
+	return s.componentService.FilterComponents(dto)
+}
@@ -0,0 +1,139 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service_test
+
+import (
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/mappers"
+	repositoryMocks "github.com/scanoss/scanoss.cc/backend/repository/mocks"
+	"github.com/scanoss/scanoss.cc/backend/service"
+	"github.com/scanoss/scanoss.cc/backend/service/mocks"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDeclaredDependency(declaredFile, component, purl, scope string) entities.DeclaredDependency {
+	return entities.DeclaredDependency{
+		DeclaredFile: declaredFile,
+		Dependency: entities.Dependency{
+			Component: component,
+			Purl:      purl,
+			Scope:     scope,
+		},
+	}
+}
+
+func TestDependencyService(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	settings := &entities.ScanossSettings{
+		SettingsFile: &entities.SettingsFile{
+			Bom: entities.Bom{
+				Remove: []entities.ComponentFilter{
+					{Path: "package.json", Purl: "pkg:npm/jest", Comment: "dev only"},
+				},
+			},
+		},
+	}
+
+	dependencies := []entities.DeclaredDependency{
+		newDeclaredDependency("package.json", "jest", "pkg:npm/jest", "devDependencies"),
+		newDeclaredDependency("package.json", "react", "pkg:npm/react", "dependencies"),
+		newDeclaredDependency("requirements.txt", "requests", "pkg:pypi/requests", ""),
+	}
+
+	t.Run("GetAll maps decisions and applies filters", func(t *testing.T) {
+		repo := repositoryMocks.NewMockDependencyRepository(t)
+		repo.EXPECT().GetDependencies().Return(dependencies, nil)
+
+		svc := service.NewDependencyServiceImpl(repo, nil, mappers.NewDependencyMapper(settings))
+
+		all, err := svc.GetAll(&entities.RequestDependencyDTO{})
+		require.NoError(t, err)
+		require.Len(t, all, 3)
+		assert.Equal(t, entities.Completed, all[0].WorkflowState)
+		assert.Equal(t, entities.Remove, all[0].FilterConfig.Action)
+		assert.Equal(t, "dev only", all[0].Comment)
+		assert.Equal(t, entities.Pending, all[1].WorkflowState)
+
+		pending, err := svc.GetAll(&entities.RequestDependencyDTO{WorkflowState: entities.Pending, Query: "req"})
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		assert.Equal(t, "pkg:pypi/requests", pending[0].Purl)
+	})
+
+	t.Run("GetTree groups dependencies by declared file", func(t *testing.T) {
+		repo := repositoryMocks.NewMockDependencyRepository(t)
+		repo.EXPECT().GetDependencies().Return(dependencies, nil)
+
+		svc := service.NewDependencyServiceImpl(repo, nil, mappers.NewDependencyMapper(settings))
+
+		tree, err := svc.GetTree()
+		require.NoError(t, err)
+		require.Len(t, tree, 2)
+
+		assert.Equal(t, "package.json", tree[0].Path)
+		assert.Equal(t, entities.Mixed, tree[0].WorkflowState)
+		require.Len(t, tree[0].Children, 2)
+		assert.Equal(t, "package.json#pkg:npm/jest", tree[0].Children[0].ID)
+
+		assert.Equal(t, "requirements.txt", tree[1].Path)
+		assert.Equal(t, entities.Pending, tree[1].WorkflowState)
+	})
+
+	t.Run("FilterDependencies delegates to the component service", func(t *testing.T) {
+		repo := repositoryMocks.NewMockDependencyRepository(t)
+		componentService := mocks.NewMockComponentService(t)
+
+		filters := []entities.ComponentFilterDTO{
+			{Path: "package.json", Purl: "pkg:npm/react", Action: entities.Include},
+		}
+		repo.EXPECT().GetDependency("package.json", "pkg:npm/react").Return(&dependencies[1])
+		componentService.EXPECT().FilterComponents(filters).Return(nil)
+
+		svc := service.NewDependencyServiceImpl(repo, componentService, mappers.NewDependencyMapper(settings))
+
+		assert.NoError(t, svc.FilterDependencies(filters))
+	})
+
+	t.Run("FilterDependencies rejects undeclared dependencies", func(t *testing.T) {
+		repo := repositoryMocks.NewMockDependencyRepository(t)
+		componentService := mocks.NewMockComponentService(t)
+
+		repo.EXPECT().GetDependency("package.json", "pkg:npm/vue").Return(nil)
+
+		svc := service.NewDependencyServiceImpl(repo, componentService, mappers.NewDependencyMapper(settings))
+
+		err := svc.FilterDependencies([]entities.ComponentFilterDTO{
+			{Path: "package.json", Purl: "pkg:npm/vue", Action: entities.Include},
+		})
+		assert.Error(t, err)
+	})
+}
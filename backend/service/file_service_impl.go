@@ -24,6 +24,7 @@
 package service
 
 import (
+	"github.com/rs/zerolog/log"
 	"github.com/scanoss/scanoss.cc/backend/entities"
 	"github.com/scanoss/scanoss.cc/backend/repository"
 )
@@ -68,3 +69,29 @@ func (c *FileServiceImpl) GetLocalFile(path string) (entities.FileDTO, error) {
 		Language: file.GetLanguage(),
 	}, err
 }
+
+// GetMatchRanges returns the local/remote line range pairs of the match for a file, validated against
+// the length of both files. When the remote file cannot be fetched its side is left unvalidated.
+func (c *FileServiceImpl) GetMatchRanges(path string) (entities.MatchRangesDTO, error) {
+	component, err := c.componentRepo.FindByFilePath(path)
+	if err != nil {
+		return entities.MatchRangesDTO{}, err
+	}
+
+	localFile, err := c.repo.ReadLocalFile(path)
+	if err != nil {
+		return entities.MatchRangesDTO{}, err
+	}
+
+	remoteTotalLines := 0
+	if component.FileHash != "" {
+		remoteFile, err := c.repo.ReadRemoteFileByMD5(path, component.FileHash)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Unable to fetch remote file for %s, remote ranges will not be validated", path)
+		} else {
+			remoteTotalLines = entities.CountLines(remoteFile.GetContent())
+		}
+	}
+
+	return entities.NewMatchRanges(component.Lines, component.OssLines, entities.CountLines(localFile.GetContent()), remoteTotalLines), nil
+}
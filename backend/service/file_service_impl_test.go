@@ -27,6 +27,7 @@ package service_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/scanoss/scanoss.cc/backend/entities"
@@ -115,3 +116,28 @@ func TestGetRemoteFileContent_Error(t *testing.T) {
 	mockFileRepo.AssertExpectations(t)
 	mockComponentRepo.AssertExpectations(t)
 }
+
+func TestGetMatchRanges(t *testing.T) {
+	mockFileRepo := mocks.NewMockFileRepository(t)
+	mockComponentRepo := mocks.NewMockComponentRepository(t)
+	service := service.NewFileService(mockFileRepo, mockComponentRepo)
+
+	localFile := entities.NewFile("", "local.c", []byte(strings.Repeat("line\n", 50)))
+	remoteFile := entities.NewFile("", "local.c", []byte(strings.Repeat("line\n", 100)))
+
+	mockComponentRepo.EXPECT().FindByFilePath("local.c").Return(entities.Component{FileHash: "test-md5", Lines: "1-20,31-60", OssLines: "11-30,71-100"}, nil)
+	mockFileRepo.EXPECT().ReadLocalFile("local.c").Return(*localFile, nil)
+	mockFileRepo.EXPECT().ReadRemoteFileByMD5("local.c", "test-md5").Return(*remoteFile, nil)
+
+	ranges, err := service.GetMatchRanges("local.c")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entities.LineRangePair{
+		{Local: entities.LineRange{Start: 1, End: 20}, Remote: entities.LineRange{Start: 11, End: 30}},
+		{Local: entities.LineRange{Start: 31, End: 50}, Remote: entities.LineRange{Start: 71, End: 100}},
+	}, ranges.Pairs)
+	assert.Equal(t, 40, ranges.Coverage.MatchedLines)
+	assert.Equal(t, 80.0, ranges.Coverage.LocalPercentage)
+	assert.Equal(t, 50.0, ranges.Coverage.RemotePercentage)
+	assert.Len(t, ranges.Warnings, 1)
+}
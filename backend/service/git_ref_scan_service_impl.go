@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+const gitRefShortCommitLength = 12
+
+// GitRefScanServiceImpl scans a folder as it was at a git ref. The tree of the ref is written to a temporary
+// folder, which is scanned and removed, and the results file is tagged with the commit it was scanned from.
+type GitRefScanServiceImpl struct {
+	scanService             ScanService
+	scanGitSourceRepository repository.ScanGitSourceRepository
+}
+
+func NewGitRefScanServiceImpl(scanService ScanService, scanGitSourceRepository repository.ScanGitSourceRepository) GitRefScanService {
+	return &GitRefScanServiceImpl{
+		scanService:             scanService,
+		scanGitSourceRepository: scanGitSourceRepository,
+	}
+}
+
+func (s *GitRefScanServiceImpl) Scan(request entities.GitRefScanRequest) (entities.GitRefScan, error) {
+	if err := utils.GetValidator().Struct(request); err != nil {
+		return entities.GitRefScan{}, fmt.Errorf("invalid git ref scan request: %w", err)
+	}
+	root, err := filepath.Abs(request.Root)
+	if err != nil {
+		return entities.GitRefScan{}, err
+	}
+
+	repo, err := git.PlainOpenWithOptions(root, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return entities.GitRefScan{}, fmt.Errorf("error opening git repository at %s: %w", root, err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return entities.GitRefScan{}, err
+	}
+	// The scanned folder may be a subfolder of the repository, its tree is looked up relative to the root
+	relRoot, err := filepath.Rel(worktree.Filesystem.Root(), root)
+	if err != nil || relRoot == ".." || strings.HasPrefix(relRoot, ".."+string(filepath.Separator)) {
+		return entities.GitRefScan{}, fmt.Errorf("%s is outside its git repository", root)
+	}
+	relRoot = filepath.ToSlash(relRoot)
+	if relRoot == "." {
+		relRoot = ""
+	}
+
+	hash, err := repo.ResolveRevision(plumbing.Revision(request.Ref))
+	if err != nil {
+		return entities.GitRefScan{}, fmt.Errorf("error resolving %s: %w", request.Ref, err)
+	}
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return entities.GitRefScan{}, fmt.Errorf("error reading commit %s: %w", hash, err)
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return entities.GitRefScan{}, err
+	}
+	if relRoot != "" {
+		if tree, err = tree.Tree(relRoot); err != nil {
+			return entities.GitRefScan{}, fmt.Errorf("%s does not exist at %s: %w", relRoot, request.Ref, err)
+		}
+	}
+
+	output := request.Output
+	if output == "" {
+		output = filepath.Join(root, config.SCANOSS_HIDDEN_FOLDER, fmt.Sprintf("results-%s.json", hash.String()[:gitRefShortCommitLength]))
+	}
+	if output, err = filepath.Abs(output); err != nil {
+		return entities.GitRefScan{}, err
+	}
+	if err := os.MkdirAll(filepath.Dir(output), 0o755); err != nil {
+		return entities.GitRefScan{}, fmt.Errorf("error creating output folder: %w", err)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "scanoss-cc-git-ref-*")
+	if err != nil {
+		return entities.GitRefScan{}, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	log.Info().Msgf("Checking out %s (%s) into %s", request.Ref, hash, tmpDir)
+	if err := materializeGitTree(tree, tmpDir); err != nil {
+		return entities.GitRefScan{}, fmt.Errorf("error checking out %s: %w", request.Ref, err)
+	}
+
+	args := append([]string{tmpDir, "--output", output}, request.Args...)
+	if err := s.scanService.Scan(args); err != nil {
+		return entities.GitRefScan{}, err
+	}
+
+	source := entities.ScanGitSource{
+		Ref:       request.Ref,
+		Commit:    hash.String(),
+		Path:      relRoot,
+		ScannedAt: time.Now(),
+	}
+	if err := s.scanGitSourceRepository.Save(output, source); err != nil {
+		return entities.GitRefScan{}, fmt.Errorf("error saving scan git source: %w", err)
+	}
+
+	return entities.GitRefScan{Output: output, Source: source}, nil
+}
+
+// materializeGitTree writes the regular files of a git tree into dest. Symlinks and submodules are skipped, as
+// they are not scanned from the working tree either.
+func materializeGitTree(tree *object.Tree, dest string) error {
+	return tree.Files().ForEach(func(file *object.File) error {
+		if file.Mode != filemode.Regular && file.Mode != filemode.Executable && file.Mode != filemode.Deprecated {
+			return nil
+		}
+
+		target := filepath.Join(dest, filepath.FromSlash(file.Name))
+		if rel, err := filepath.Rel(dest, target); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("refusing to write %s outside %s", file.Name, dest)
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return err
+		}
+
+		perm := os.FileMode(0o644)
+		if file.Mode == filemode.Executable {
+			perm = 0o755
+		}
+		return writeGitBlob(file, target, perm)
+	})
+}
+
+func writeGitBlob(file *object.File, target string, perm os.FileMode) error {
+	reader, err := file.Reader()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, reader); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,126 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/backend/service"
+	"github.com/scanoss/scanoss.cc/backend/service/mocks"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitRefScanService(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	repoRoot := t.TempDir()
+	root := filepath.Join(repoRoot, "src")
+	writeScanFixture(t, repoRoot, map[string]string{
+		"README.md":     "readme\n",
+		"src/main.c":    "int main(void) { return 0; }\n",
+		"src/lib/lib.c": "int lib(void) { return 1; }\n",
+	})
+	require.NoError(t, os.Chmod(filepath.Join(root, "main.c"), 0o755))
+
+	repo, err := git.PlainInit(repoRoot, false)
+	require.NoError(t, err)
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+	require.NoError(t, worktree.AddWithOptions(&git.AddOptions{All: true}))
+	hash, err := worktree.Commit("initial", &git.CommitOptions{Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()}})
+	require.NoError(t, err)
+	_, err = repo.CreateTag("v1.0.0", hash, nil)
+	require.NoError(t, err)
+
+	// The working tree moved on since the tag
+	writeScanFixture(t, repoRoot, map[string]string{
+		"src/main.c":  "int main(void) { return 42; }\n",
+		"src/added.c": "int added(void) { return 2; }\n",
+	})
+
+	var scannedDir string
+	scanned := map[string]string{}
+	scanService := mocks.NewMockScanService(t)
+	scanService.EXPECT().Scan(mock.Anything).RunAndReturn(func(args []string) error {
+		scannedDir = args[0]
+		err := filepath.WalkDir(scannedDir, func(path string, entry os.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return err
+			}
+			content, err := os.ReadFile(path)
+			rel, _ := filepath.Rel(scannedDir, path)
+			scanned[filepath.ToSlash(rel)] = string(content)
+			return err
+		})
+		require.NoError(t, err)
+		info, err := os.Stat(filepath.Join(scannedDir, "main.c"))
+		require.NoError(t, err)
+		assert.NotZero(t, info.Mode()&0o100, "executable bit is kept")
+
+		assert.Equal(t, "--output", args[1])
+		return utils.WriteJsonFile(args[2], map[string]any{"main.c": []map[string]string{{"id": "none"}}})
+	})
+
+	sourceRepo := repository.NewScanGitSourceRepositoryJsonImpl(utils.NewDefaultFileReader())
+	svc := service.NewGitRefScanServiceImpl(scanService, sourceRepo)
+
+	scan, err := svc.Scan(entities.GitRefScanRequest{Root: root, Ref: "v1.0.0", Args: []string{"--quiet"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"main.c":    "int main(void) { return 0; }\n",
+		"lib/lib.c": "int lib(void) { return 1; }\n",
+	}, scanned)
+	assert.NoDirExists(t, scannedDir)
+
+	assert.Equal(t, filepath.Join(root, ".scanoss", "results-"+hash.String()[:12]+".json"), scan.Output)
+	assert.FileExists(t, scan.Output)
+	assert.Equal(t, "v1.0.0", scan.Source.Ref)
+	assert.Equal(t, hash.String(), scan.Source.Commit)
+	assert.Equal(t, "src", scan.Source.Path)
+
+	content, err := sourceRepo.ReadFile(scan.Output, root, "main.c")
+	require.NoError(t, err)
+	assert.Equal(t, "int main(void) { return 0; }\n", string(content))
+
+	_, err = sourceRepo.ReadFile(scan.Output, root, "../README.md")
+	assert.Error(t, err)
+
+	_, err = svc.Scan(entities.GitRefScanRequest{Root: root, Ref: "no-such-ref"})
+	assert.ErrorContains(t, err, "no-such-ref")
+}
@@ -0,0 +1,457 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+// IncrementalScanServiceImpl rescans only the files that changed since the last scan and merges their results
+// into the existing results file. Results are merged as raw JSON, so fields this app does not model are kept.
+type IncrementalScanServiceImpl struct {
+	scanService               ScanService
+	scanManifestRepository    repository.ScanManifestRepository
+	scanossSettingsRepository repository.ScanossSettingsRepository
+}
+
+func NewIncrementalScanServiceImpl(
+	scanService ScanService,
+	scanManifestRepository repository.ScanManifestRepository,
+	scanossSettingsRepository repository.ScanossSettingsRepository,
+) IncrementalScanService {
+	return &IncrementalScanServiceImpl{
+		scanService:               scanService,
+		scanManifestRepository:    scanManifestRepository,
+		scanossSettingsRepository: scanossSettingsRepository,
+	}
+}
+
+// DetectChanges lists the files changed since the last scan, from a git diff against request.Since or else from
+// the scan manifest. It fails with ErrScanManifestNotFound when neither is available.
+func (s *IncrementalScanServiceImpl) DetectChanges(request entities.IncrementalScanRequest) (entities.ScanChanges, error) {
+	request, err := s.normalize(request)
+	if err != nil {
+		return entities.ScanChanges{}, err
+	}
+
+	if request.Since != "" {
+		return s.detectGitChanges(request.Root, request.Since)
+	}
+
+	manifest, err := s.scanManifestRepository.Get(request.Output)
+	if err != nil {
+		return entities.ScanChanges{}, err
+	}
+	changes, _, err := s.detectManifestChanges(request.Root, manifest)
+	return changes, err
+}
+
+// Scan rescans the changed files and updates the results file. Without a previous results file, or without a
+// git ref nor a scan manifest to compare with, the whole folder is scanned.
+func (s *IncrementalScanServiceImpl) Scan(request entities.IncrementalScanRequest) (entities.ScanChanges, error) {
+	request, err := s.normalize(request)
+	if err != nil {
+		return entities.ScanChanges{}, err
+	}
+
+	manifest, err := s.scanManifestRepository.Get(request.Output)
+	hasManifest := err == nil
+	if err != nil && !errors.Is(err, entities.ErrScanManifestNotFound) {
+		log.Warn().Err(err).Msg("Ignoring unreadable scan manifest")
+	}
+
+	_, statErr := os.Stat(request.Output)
+	hasResults := statErr == nil
+	if !hasResults || (request.Since == "" && !hasManifest) {
+		log.Info().Msgf("No previous scan to compare with, scanning all of %s", request.Root)
+		return s.fullScan(request)
+	}
+
+	var changes entities.ScanChanges
+	if request.Since != "" {
+		changes, err = s.detectGitChanges(request.Root, request.Since)
+		if err == nil && hasManifest {
+			manifest, err = s.refreshManifest(request.Root, manifest, changes)
+		}
+	} else {
+		changes, manifest, err = s.detectManifestChanges(request.Root, manifest)
+	}
+	if err != nil {
+		return entities.ScanChanges{}, err
+	}
+
+	log.Info().Msgf("%d changed and %d deleted files since the last scan", len(changes.Changed), len(changes.Deleted))
+
+	scanned := map[string]json.RawMessage{}
+	if len(changes.Changed) > 0 {
+		if scanned, err = s.scanFiles(request, changes.Changed); err != nil {
+			return entities.ScanChanges{}, err
+		}
+	}
+
+	changes.Deleted, err = mergeScanResults(request.Root, request.Output, scanned, changes)
+	if err != nil {
+		return entities.ScanChanges{}, err
+	}
+
+	if hasManifest {
+		manifest.ScannedAt = time.Now()
+		if err := s.scanManifestRepository.Save(request.Output, manifest); err != nil {
+			return entities.ScanChanges{}, fmt.Errorf("error saving scan manifest: %w", err)
+		}
+	}
+
+	return changes, nil
+}
+
+func (s *IncrementalScanServiceImpl) normalize(request entities.IncrementalScanRequest) (entities.IncrementalScanRequest, error) {
+	if err := utils.GetValidator().Struct(request); err != nil {
+		return request, fmt.Errorf("invalid incremental scan request: %w", err)
+	}
+
+	root, err := filepath.Abs(request.Root)
+	if err != nil {
+		return request, err
+	}
+	output, err := filepath.Abs(request.Output)
+	if err != nil {
+		return request, err
+	}
+	request.Root, request.Output = root, output
+
+	return request, nil
+}
+
+// fullScan scans the whole folder and records every file in a new scan manifest.
+func (s *IncrementalScanServiceImpl) fullScan(request entities.IncrementalScanRequest) (entities.ScanChanges, error) {
+	if err := os.MkdirAll(filepath.Dir(request.Output), 0o755); err != nil {
+		return entities.ScanChanges{}, fmt.Errorf("error creating output folder: %w", err)
+	}
+
+	args := append([]string{request.Root, "--output", request.Output}, request.Args...)
+	if err := s.scanService.Scan(args); err != nil {
+		return entities.ScanChanges{}, err
+	}
+
+	changes, manifest, err := s.detectManifestChanges(request.Root, entities.ScanManifest{})
+	if err != nil {
+		return entities.ScanChanges{}, err
+	}
+	manifest.ScannedAt = time.Now()
+	if err := s.scanManifestRepository.Save(request.Output, manifest); err != nil {
+		return entities.ScanChanges{}, fmt.Errorf("error saving scan manifest: %w", err)
+	}
+
+	return changes, nil
+}
+
+// scanFiles scans the given files into a temporary results file and returns its results keyed by paths
+// relative to the scan root.
+func (s *IncrementalScanServiceImpl) scanFiles(request entities.IncrementalScanRequest, files []string) (map[string]json.RawMessage, error) {
+	tmpDir, err := os.MkdirTemp("", "scanoss-cc-incremental-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	output := filepath.Join(tmpDir, config.DEFAULT_RESULTS_FILE)
+	args := append([]string{"--output", output}, request.Args...)
+	args = append(args, "--files")
+	for _, file := range files {
+		args = append(args, filepath.Join(request.Root, filepath.FromSlash(file)))
+	}
+
+	if err := s.scanService.Scan(args); err != nil {
+		return nil, err
+	}
+
+	results, err := readRawScanResults(output)
+	if err != nil {
+		return nil, err
+	}
+
+	rebased := make(map[string]json.RawMessage, len(results))
+	for path, matches := range results {
+		rebased[rebaseScanPath(request.Root, path)] = matches
+	}
+	return rebased, nil
+}
+
+// detectManifestChanges compares the folder with the manifest and returns the changes along with the manifest
+// describing the folder as it is now.
+func (s *IncrementalScanServiceImpl) detectManifestChanges(root string, manifest entities.ScanManifest) (entities.ScanChanges, entities.ScanManifest, error) {
+	files, err := listScanFolder(root, s.scanossSettingsRepository)
+	if err != nil {
+		return entities.ScanChanges{}, manifest, err
+	}
+
+	changes := entities.ScanChanges{Changed: []string{}, Deleted: []string{}}
+	current := entities.ScanManifest{ScannedAt: manifest.ScannedAt, Files: make(map[string]entities.ScanManifestEntry, len(files))}
+
+	for _, rel := range files {
+		previous, known := manifest.Files[rel]
+		entry, err := scanManifestEntry(filepath.Join(root, filepath.FromSlash(rel)), previous, known)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping unreadable file %s", rel)
+			continue
+		}
+		current.Files[rel] = entry
+		if !known || entry.MD5 != previous.MD5 {
+			changes.Changed = append(changes.Changed, rel)
+		}
+	}
+
+	for rel := range manifest.Files {
+		if _, ok := current.Files[rel]; !ok {
+			changes.Deleted = append(changes.Deleted, rel)
+		}
+	}
+	slices.Sort(changes.Deleted)
+
+	return changes, current, nil
+}
+
+// refreshManifest applies git detected changes to the manifest so a later manifest based scan starts from them.
+func (s *IncrementalScanServiceImpl) refreshManifest(root string, manifest entities.ScanManifest, changes entities.ScanChanges) (entities.ScanManifest, error) {
+	for _, rel := range changes.Deleted {
+		delete(manifest.Files, rel)
+	}
+	for _, rel := range changes.Changed {
+		entry, err := scanManifestEntry(filepath.Join(root, filepath.FromSlash(rel)), entities.ScanManifestEntry{}, false)
+		if err != nil {
+			return manifest, err
+		}
+		manifest.Files[rel] = entry
+	}
+	return manifest, nil
+}
+
+// detectGitChanges lists the files that differ between the given ref and the working tree, committed or not.
+func (s *IncrementalScanServiceImpl) detectGitChanges(root, ref string) (entities.ScanChanges, error) {
+	repo, err := git.PlainOpenWithOptions(root, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return entities.ScanChanges{}, fmt.Errorf("error opening git repository at %s: %w", root, err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return entities.ScanChanges{}, err
+	}
+	repoRoot := worktree.Filesystem.Root()
+
+	refTree, err := commitTree(repo, plumbing.Revision(ref))
+	if err != nil {
+		return entities.ScanChanges{}, fmt.Errorf("error resolving %s: %w", ref, err)
+	}
+	headTree, err := commitTree(repo, plumbing.Revision(plumbing.HEAD))
+	if err != nil {
+		return entities.ScanChanges{}, fmt.Errorf("error resolving HEAD: %w", err)
+	}
+
+	// Paths are collected relative to the repository root, then rebased onto the scan root
+	changed := map[string]bool{}
+	deleted := map[string]bool{}
+
+	diff, err := object.DiffTree(refTree, headTree)
+	if err != nil {
+		return entities.ScanChanges{}, fmt.Errorf("error comparing %s with HEAD: %w", ref, err)
+	}
+	for _, change := range diff {
+		if change.From.Name != "" && change.From.Name != change.To.Name {
+			deleted[change.From.Name] = true
+		}
+		if change.To.Name != "" {
+			changed[change.To.Name] = true
+		}
+	}
+
+	status, err := worktree.Status()
+	if err != nil {
+		return entities.ScanChanges{}, fmt.Errorf("error reading the working tree status: %w", err)
+	}
+	for path, fileStatus := range status {
+		if fileStatus.Worktree == git.Deleted || (fileStatus.Staging == git.Deleted && fileStatus.Worktree != git.Untracked) {
+			deleted[path] = true
+			delete(changed, path)
+			continue
+		}
+		changed[path] = true
+	}
+
+	skip := scanFolderMatcher(s.scanossSettingsRepository)
+	changes := entities.ScanChanges{Changed: []string{}, Deleted: []string{}}
+	for path := range changed {
+		rel, ok := relativeToScanRoot(root, repoRoot, path)
+		if !ok || skip(rel, false) {
+			continue
+		}
+		// A file changed since the ref may have been removed from the working tree since
+		info, err := os.Stat(filepath.Join(root, filepath.FromSlash(rel)))
+		if err != nil || !info.Mode().IsRegular() {
+			deleted[path] = true
+			continue
+		}
+		changes.Changed = append(changes.Changed, rel)
+	}
+	for path := range deleted {
+		if rel, ok := relativeToScanRoot(root, repoRoot, path); ok && !changed[path] {
+			changes.Deleted = append(changes.Deleted, rel)
+		}
+	}
+	slices.Sort(changes.Changed)
+	slices.Sort(changes.Deleted)
+
+	return changes, nil
+}
+
+func commitTree(repo *git.Repository, revision plumbing.Revision) (*object.Tree, error) {
+	hash, err := repo.ResolveRevision(revision)
+	if err != nil {
+		return nil, err
+	}
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return nil, err
+	}
+	return commit.Tree()
+}
+
+// relativeToScanRoot rebases a path relative to the repository root onto the scan root. It reports false for
+// paths outside the scan root.
+func relativeToScanRoot(root, repoRoot, path string) (string, bool) {
+	rel, err := filepath.Rel(root, filepath.Join(repoRoot, filepath.FromSlash(path)))
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+// scanManifestEntry describes a file, reusing the previous MD5 when neither its size nor modification time changed.
+func scanManifestEntry(path string, previous entities.ScanManifestEntry, known bool) (entities.ScanManifestEntry, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return entities.ScanManifestEntry{}, err
+	}
+
+	entry := entities.ScanManifestEntry{Size: info.Size(), ModTime: info.ModTime().UTC()}
+	if known && previous.Size == entry.Size && previous.ModTime.Equal(entry.ModTime) {
+		entry.MD5 = previous.MD5
+		return entry, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return entities.ScanManifestEntry{}, err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return entities.ScanManifestEntry{}, err
+	}
+	entry.MD5 = hex.EncodeToString(hash.Sum(nil))
+
+	return entry, nil
+}
+
+// mergeScanResults replaces the results of the rescanned files and removes deleted files from the results file.
+// Results of files missing from the scan root are dropped as deleted too. It returns every deleted path.
+func mergeScanResults(root, output string, scanned map[string]json.RawMessage, changes entities.ScanChanges) ([]string, error) {
+	results, err := readRawScanResults(output)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := slices.Clone(changes.Deleted)
+	for _, path := range changes.Deleted {
+		delete(results, path)
+	}
+	for _, path := range changes.Changed {
+		delete(results, path)
+	}
+	for path := range results {
+		if _, err := os.Stat(filepath.Join(root, filepath.FromSlash(path))); errors.Is(err, os.ErrNotExist) {
+			delete(results, path)
+			deleted = append(deleted, path)
+		}
+	}
+	for path, matches := range scanned {
+		results[path] = matches
+	}
+
+	if err := utils.WriteJsonFile(output, results); err != nil {
+		return nil, fmt.Errorf("error writing results: %w", err)
+	}
+
+	slices.Sort(deleted)
+	return slices.Compact(deleted), nil
+}
+
+func readRawScanResults(path string) (map[string]json.RawMessage, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading scan results: %w", err)
+	}
+
+	results := map[string]json.RawMessage{}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return results, nil
+	}
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("error parsing scan results %s: %w", path, err)
+	}
+	return results, nil
+}
+
+// rebaseScanPath turns a result key written by the scanner for an absolute --files path back into a path
+// relative to the scan root.
+func rebaseScanPath(root, path string) string {
+	native := filepath.FromSlash(path)
+	if !filepath.IsAbs(native) {
+		return filepath.ToSlash(strings.TrimPrefix(path, "./"))
+	}
+	rel, err := filepath.Rel(root, native)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return path
+	}
+	return filepath.ToSlash(rel)
+}
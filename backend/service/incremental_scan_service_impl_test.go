@@ -0,0 +1,250 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/backend/service"
+	"github.com/scanoss/scanoss.cc/backend/service/mocks"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeScan answers Scan calls like a scanner would: a "none" match for every scanned file, keyed by the path
+// it was given. It records the files of every call.
+func fakeScan(t *testing.T, root string, calls *[][]string) func(args []string) error {
+	return func(args []string) error {
+		var output string
+		var files []string
+		for i := 0; i < len(args); i++ {
+			switch {
+			case args[i] == "--output":
+				i++
+				output = args[i]
+			case args[i] == "--files":
+				files = append(files, args[i+1:]...)
+				i = len(args)
+			case args[i] == root:
+				paths, err := filepath.Glob(filepath.Join(root, "*.c"))
+				require.NoError(t, err)
+				for _, path := range paths {
+					rel, _ := filepath.Rel(root, path)
+					files = append(files, rel)
+				}
+			}
+		}
+		*calls = append(*calls, files)
+
+		results := map[string][]map[string]string{}
+		for _, file := range files {
+			results[filepath.ToSlash(file)] = []map[string]string{{"id": "none", "scan": "new"}}
+		}
+		return utils.WriteJsonFile(output, results)
+	}
+}
+
+func readResults(t *testing.T, path string) map[string]json.RawMessage {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var results map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(data, &results))
+	return results
+}
+
+func TestIncrementalScanService_Manifest(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	root := t.TempDir()
+	output := filepath.Join(root, ".scanoss", "results.json")
+	writeScanFixture(t, root, map[string]string{
+		"main.c":    "int main(void) { return 0; }\n",
+		"util.c":    "int util(void) { return 1; }\n",
+		"old.c":     "int old(void) { return 2; }\n",
+		"touched.c": "int touched(void) { return 3; }\n",
+	})
+
+	var calls [][]string
+	scanService := mocks.NewMockScanService(t)
+	scanService.EXPECT().Scan(mock.Anything).RunAndReturn(fakeScan(t, root, &calls))
+
+	manifestRepo := repository.NewScanManifestRepositoryJsonImpl(utils.NewDefaultFileReader())
+	svc := service.NewIncrementalScanServiceImpl(scanService, manifestRepo, nil)
+	request := entities.IncrementalScanRequest{Root: root, Output: output, Args: []string{"--quiet"}}
+
+	t.Run("Scans everything the first time", func(t *testing.T) {
+		changes, err := svc.Scan(request)
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, []string{"main.c", "util.c", "old.c", "touched.c"}, changes.Changed)
+		require.Len(t, calls, 1)
+
+		manifest, err := manifestRepo.Get(output)
+		require.NoError(t, err)
+		assert.Len(t, manifest.Files, 4)
+	})
+
+	// A result written by an earlier scan, with fields this app does not know about
+	results := readResults(t, output)
+	results["util.c"] = json.RawMessage(`[{"id":"file","purl":["pkg:github/scanoss/util"],"custom_field":{"kept":true}}]`)
+	require.NoError(t, utils.WriteJsonFile(output, results))
+
+	t.Run("Rescans only changed files", func(t *testing.T) {
+		calls = nil
+		writeScanFixture(t, root, map[string]string{
+			"main.c": "int main(void) { return 42; }\n",
+			"new.c":  "int added(void) { return 4; }\n",
+		})
+		require.NoError(t, os.Remove(filepath.Join(root, "old.c")))
+		// Same contents with a new modification time is not a change
+		later := time.Now().Add(time.Hour)
+		require.NoError(t, os.Chtimes(filepath.Join(root, "touched.c"), later, later))
+
+		changes, err := svc.DetectChanges(request)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"main.c", "new.c"}, changes.Changed)
+		assert.Equal(t, []string{"old.c"}, changes.Deleted)
+
+		changes, err = svc.Scan(request)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"main.c", "new.c"}, changes.Changed)
+		assert.Equal(t, []string{"old.c"}, changes.Deleted)
+
+		require.Len(t, calls, 1)
+		assert.Equal(t, []string{filepath.Join(root, "main.c"), filepath.Join(root, "new.c")}, calls[0])
+
+		results := readResults(t, output)
+		assert.ElementsMatch(t, []string{"main.c", "new.c", "util.c", "touched.c"}, keys(results))
+		assert.JSONEq(t, `[{"id":"file","purl":["pkg:github/scanoss/util"],"custom_field":{"kept":true}}]`, string(results["util.c"]))
+		assert.JSONEq(t, `[{"id":"none","scan":"new"}]`, string(results["main.c"]))
+	})
+
+	t.Run("Does not scan when nothing changed", func(t *testing.T) {
+		calls = nil
+		changes, err := svc.Scan(request)
+		require.NoError(t, err)
+
+		assert.True(t, changes.IsEmpty())
+		assert.Empty(t, calls)
+	})
+
+	t.Run("Rejects an invalid request", func(t *testing.T) {
+		_, err := svc.Scan(entities.IncrementalScanRequest{Root: root})
+		assert.Error(t, err)
+	})
+}
+
+func TestIncrementalScanService_Git(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	repoRoot := t.TempDir()
+	root := filepath.Join(repoRoot, "src")
+	writeScanFixture(t, repoRoot, map[string]string{
+		"README.md":       "readme\n",
+		"src/main.c":      "int main(void) { return 0; }\n",
+		"src/util.c":      "int util(void) { return 1; }\n",
+		"src/removed.c":   "int removed(void) { return 2; }\n",
+		"src/committed.c": "int committed(void) { return 3; }\n",
+	})
+
+	repo, err := git.PlainInit(repoRoot, false)
+	require.NoError(t, err)
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+	commit := func(message string) {
+		require.NoError(t, worktree.AddWithOptions(&git.AddOptions{All: true}))
+		_, err := worktree.Commit(message, &git.CommitOptions{Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()}})
+		require.NoError(t, err)
+	}
+	commit("initial")
+
+	// One committed change after the ref, and uncommitted changes in the working tree
+	writeScanFixture(t, repoRoot, map[string]string{"src/committed.c": "int committed(void) { return 30; }\n"})
+	commit("second")
+	writeScanFixture(t, repoRoot, map[string]string{
+		"src/main.c":      "int main(void) { return 10; }\n",
+		"src/untracked.c": "int untracked(void) { return 5; }\n",
+		"README.md":       "changed outside the scan root\n",
+	})
+	require.NoError(t, os.Remove(filepath.Join(root, "removed.c")))
+
+	output := filepath.Join(root, ".scanoss", "results.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(output), 0o755))
+	require.NoError(t, utils.WriteJsonFile(output, map[string]any{
+		"main.c":      []map[string]string{{"id": "none"}},
+		"util.c":      []map[string]string{{"id": "file", "component": "util"}},
+		"removed.c":   []map[string]string{{"id": "none"}},
+		"committed.c": []map[string]string{{"id": "none"}},
+	}))
+
+	var calls [][]string
+	scanService := mocks.NewMockScanService(t)
+	scanService.EXPECT().Scan(mock.Anything).RunAndReturn(fakeScan(t, root, &calls)).Maybe()
+
+	svc := service.NewIncrementalScanServiceImpl(scanService, repository.NewScanManifestRepositoryJsonImpl(utils.NewDefaultFileReader()), nil)
+	request := entities.IncrementalScanRequest{Root: root, Output: output, Since: "HEAD~1"}
+
+	changes, err := svc.DetectChanges(request)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"committed.c", "main.c", "untracked.c"}, changes.Changed)
+	assert.Equal(t, []string{"removed.c"}, changes.Deleted)
+
+	_, err = svc.Scan(request)
+	require.NoError(t, err)
+	require.Len(t, calls, 1)
+	assert.Len(t, calls[0], 3)
+
+	results := readResults(t, output)
+	assert.ElementsMatch(t, []string{"committed.c", "main.c", "untracked.c", "util.c"}, keys(results))
+	assert.JSONEq(t, `[{"id":"file","component":"util"}]`, string(results["util.c"]))
+
+	_, err = svc.DetectChanges(entities.IncrementalScanRequest{Root: root, Output: output, Since: "no-such-ref"})
+	assert.ErrorContains(t, err, "no-such-ref")
+}
+
+func keys(m map[string]json.RawMessage) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	slices.Sort(result)
+	return result
+}
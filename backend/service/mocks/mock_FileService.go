@@ -76,6 +76,62 @@ func (_c *MockFileService_GetLocalFile_Call) RunAndReturn(run func(string) (enti
 	return _c
 }
 
+// GetMatchRanges provides a mock function with given fields: path
+func (_m *MockFileService) GetMatchRanges(path string) (entities.MatchRangesDTO, error) {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMatchRanges")
+	}
+
+	var r0 entities.MatchRangesDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (entities.MatchRangesDTO, error)); ok {
+		return rf(path)
+	}
+	if rf, ok := ret.Get(0).(func(string) entities.MatchRangesDTO); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Get(0).(entities.MatchRangesDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockFileService_GetMatchRanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMatchRanges'
+type MockFileService_GetMatchRanges_Call struct {
+	*mock.Call
+}
+
+// GetMatchRanges is a helper method to define mock.On call
+//   - path string
+func (_e *MockFileService_Expecter) GetMatchRanges(path interface{}) *MockFileService_GetMatchRanges_Call {
+	return &MockFileService_GetMatchRanges_Call{Call: _e.mock.On("GetMatchRanges", path)}
+}
+
+func (_c *MockFileService_GetMatchRanges_Call) Run(run func(path string)) *MockFileService_GetMatchRanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockFileService_GetMatchRanges_Call) Return(_a0 entities.MatchRangesDTO, _a1 error) *MockFileService_GetMatchRanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockFileService_GetMatchRanges_Call) RunAndReturn(run func(string) (entities.MatchRangesDTO, error)) *MockFileService_GetMatchRanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRemoteFile provides a mock function with given fields: path
 func (_m *MockFileService) GetRemoteFile(path string) (entities.FileDTO, error) {
 	ret := _m.Called(path)
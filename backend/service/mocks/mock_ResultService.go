@@ -126,6 +126,118 @@ func (_c *MockResultService_GetByPath_Call) RunAndReturn(run func(string) entiti
 	return _c
 }
 
+// GetPage provides a mock function with given fields: dto
+func (_m *MockResultService) GetPage(dto *entities.RequestResultPageDTO) (entities.ResultPageDTO, error) {
+	ret := _m.Called(dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPage")
+	}
+
+	var r0 entities.ResultPageDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.RequestResultPageDTO) (entities.ResultPageDTO, error)); ok {
+		return rf(dto)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.RequestResultPageDTO) entities.ResultPageDTO); ok {
+		r0 = rf(dto)
+	} else {
+		r0 = ret.Get(0).(entities.ResultPageDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.RequestResultPageDTO) error); ok {
+		r1 = rf(dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockResultService_GetPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPage'
+type MockResultService_GetPage_Call struct {
+	*mock.Call
+}
+
+// GetPage is a helper method to define mock.On call
+//   - dto *entities.RequestResultPageDTO
+func (_e *MockResultService_Expecter) GetPage(dto interface{}) *MockResultService_GetPage_Call {
+	return &MockResultService_GetPage_Call{Call: _e.mock.On("GetPage", dto)}
+}
+
+func (_c *MockResultService_GetPage_Call) Run(run func(dto *entities.RequestResultPageDTO)) *MockResultService_GetPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.RequestResultPageDTO))
+	})
+	return _c
+}
+
+func (_c *MockResultService_GetPage_Call) Return(_a0 entities.ResultPageDTO, _a1 error) *MockResultService_GetPage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockResultService_GetPage_Call) RunAndReturn(run func(*entities.RequestResultPageDTO) (entities.ResultPageDTO, error)) *MockResultService_GetPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSummary provides a mock function with given fields: dto
+func (_m *MockResultService) GetSummary(dto *entities.RequestResultDTO) (entities.ResultSummaryDTO, error) {
+	ret := _m.Called(dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSummary")
+	}
+
+	var r0 entities.ResultSummaryDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*entities.RequestResultDTO) (entities.ResultSummaryDTO, error)); ok {
+		return rf(dto)
+	}
+	if rf, ok := ret.Get(0).(func(*entities.RequestResultDTO) entities.ResultSummaryDTO); ok {
+		r0 = rf(dto)
+	} else {
+		r0 = ret.Get(0).(entities.ResultSummaryDTO)
+	}
+
+	if rf, ok := ret.Get(1).(func(*entities.RequestResultDTO) error); ok {
+		r1 = rf(dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockResultService_GetSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSummary'
+type MockResultService_GetSummary_Call struct {
+	*mock.Call
+}
+
+// GetSummary is a helper method to define mock.On call
+//   - dto *entities.RequestResultDTO
+func (_e *MockResultService_Expecter) GetSummary(dto interface{}) *MockResultService_GetSummary_Call {
+	return &MockResultService_GetSummary_Call{Call: _e.mock.On("GetSummary", dto)}
+}
+
+func (_c *MockResultService_GetSummary_Call) Run(run func(dto *entities.RequestResultDTO)) *MockResultService_GetSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*entities.RequestResultDTO))
+	})
+	return _c
+}
+
+func (_c *MockResultService_GetSummary_Call) Return(_a0 entities.ResultSummaryDTO, _a1 error) *MockResultService_GetSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockResultService_GetSummary_Call) RunAndReturn(run func(*entities.RequestResultDTO) (entities.ResultSummaryDTO, error)) *MockResultService_GetSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetContext provides a mock function with given fields: ctx
 func (_m *MockResultService) SetContext(ctx context.Context) {
 	_m.Called(ctx)
@@ -155,7 +267,7 @@ func (_c *MockResultService_SetContext_Call) Return() *MockResultService_SetCont
 }
 
 func (_c *MockResultService_SetContext_Call) RunAndReturn(run func(context.Context)) *MockResultService_SetContext_Call {
-	_c.Call.Return(run)
+	_c.Run(run)
 	return _c
 }
 
@@ -2,7 +2,10 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	entities "github.com/scanoss/scanoss.cc/backend/entities"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockScanService is an autogenerated mock type for the ScanService type
 type MockScanService struct {
@@ -107,6 +110,51 @@ func (_c *MockScanService_CheckDependencies_Call) RunAndReturn(run func() error)
 	return _c
 }
 
+// Diagnose provides a mock function with given fields:
+func (_m *MockScanService) Diagnose() entities.ScannerDiagnostic {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Diagnose")
+	}
+
+	var r0 entities.ScannerDiagnostic
+	if rf, ok := ret.Get(0).(func() entities.ScannerDiagnostic); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(entities.ScannerDiagnostic)
+	}
+
+	return r0
+}
+
+// MockScanService_Diagnose_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Diagnose'
+type MockScanService_Diagnose_Call struct {
+	*mock.Call
+}
+
+// Diagnose is a helper method to define mock.On call
+func (_e *MockScanService_Expecter) Diagnose() *MockScanService_Diagnose_Call {
+	return &MockScanService_Diagnose_Call{Call: _e.mock.On("Diagnose")}
+}
+
+func (_c *MockScanService_Diagnose_Call) Run(run func()) *MockScanService_Diagnose_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockScanService_Diagnose_Call) Return(_a0 entities.ScannerDiagnostic) *MockScanService_Diagnose_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockScanService_Diagnose_Call) RunAndReturn(run func() entities.ScannerDiagnostic) *MockScanService_Diagnose_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDefaultScanArgs provides a mock function with given fields:
 func (_m *MockScanService) GetDefaultScanArgs() []string {
 	ret := _m.Called()
@@ -246,6 +294,52 @@ func (_c *MockScanService_ScanStream_Call) RunAndReturn(run func([]string) error
 	return _c
 }
 
+// ScanWithOptions provides a mock function with given fields: request
+func (_m *MockScanService) ScanWithOptions(request entities.ScanRequest) error {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScanWithOptions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(entities.ScanRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockScanService_ScanWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScanWithOptions'
+type MockScanService_ScanWithOptions_Call struct {
+	*mock.Call
+}
+
+// ScanWithOptions is a helper method to define mock.On call
+//   - request entities.ScanRequest
+func (_e *MockScanService_Expecter) ScanWithOptions(request interface{}) *MockScanService_ScanWithOptions_Call {
+	return &MockScanService_ScanWithOptions_Call{Call: _e.mock.On("ScanWithOptions", request)}
+}
+
+func (_c *MockScanService_ScanWithOptions_Call) Run(run func(request entities.ScanRequest)) *MockScanService_ScanWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(entities.ScanRequest))
+	})
+	return _c
+}
+
+func (_c *MockScanService_ScanWithOptions_Call) Return(_a0 error) *MockScanService_ScanWithOptions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockScanService_ScanWithOptions_Call) RunAndReturn(run func(entities.ScanRequest) error) *MockScanService_ScanWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockScanService creates a new instance of MockScanService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockScanService(t interface {
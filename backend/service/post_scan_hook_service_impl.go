@@ -0,0 +1,420 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// Environment variables set for command hooks.
+const (
+	PostScanHookEnvScanRoot     = "SCANOSS_SCAN_ROOT"
+	PostScanHookEnvResultsFile  = "SCANOSS_RESULTS_FILE"
+	PostScanHookEnvSettingsFile = "SCANOSS_SETTINGS_FILE"
+	PostScanHookEnvScanner      = "SCANOSS_SCANNER"
+	PostScanHookEnvHookName     = "SCANOSS_HOOK_NAME"
+)
+
+// postScanHookMaxOutput is how much of the end of a command's output is kept in its result.
+const postScanHookMaxOutput = 4096
+
+type PostScanHookServiceImpl struct {
+	ctx                    context.Context
+	repo                   repository.PostScanHookRepository
+	resultRepo             repository.ResultRepository
+	componentService       ComponentService
+	scanossSettingsService ScanossSettingsService
+	resultService          ResultService
+	cryptographyService    CryptographyService
+}
+
+func NewPostScanHookServiceImpl(
+	repo repository.PostScanHookRepository,
+	resultRepo repository.ResultRepository,
+	componentService ComponentService,
+	scanossSettingsService ScanossSettingsService,
+	resultService ResultService,
+	cryptographyService CryptographyService,
+) PostScanHookService {
+	return &PostScanHookServiceImpl{
+		repo:                   repo,
+		resultRepo:             resultRepo,
+		componentService:       componentService,
+		scanossSettingsService: scanossSettingsService,
+		resultService:          resultService,
+		cryptographyService:    cryptographyService,
+	}
+}
+
+func (s *PostScanHookServiceImpl) SetContext(ctx context.Context) {
+	s.ctx = ctx
+}
+
+// GetAll returns the hooks of the current scan root, invalid hooks are left out and logged.
+func (s *PostScanHookServiceImpl) GetAll() ([]entities.PostScanHook, error) {
+	return s.validHooks(config.GetInstance().GetScanRoot())
+}
+
+// postScanHookRun is the scanned folder the hooks run for. The built in actions work on the review session, so
+// they need it to be open on that folder; the app opens the folder it scans before scanning.
+type postScanHookRun struct {
+	scanRoot     string
+	resultsPath  string
+	settingsPath string
+	inSession    bool
+}
+
+// Run runs the hooks of scanRoot in order, emitting the result of each one. A failing hook does not stop the
+// ones after it. The review session and the rest of the app config are left as they are.
+func (s *PostScanHookServiceImpl) Run(scanRoot string, resultsPath string) []entities.PostScanHookResult {
+	if absRoot, err := filepath.Abs(scanRoot); err == nil {
+		scanRoot = absRoot
+	}
+
+	results := []entities.PostScanHookResult{}
+	hooks, err := s.validHooks(scanRoot)
+	if err != nil {
+		log.Error().Err(err).Msg("Error reading post-scan hooks")
+		return results
+	}
+	if len(hooks) == 0 {
+		return results
+	}
+
+	cfg := config.GetInstance()
+	run := postScanHookRun{
+		scanRoot:     scanRoot,
+		resultsPath:  cfg.GetDefaultResultFilePath(scanRoot),
+		settingsPath: cfg.GetDefaultScanSettingsFilePath(scanRoot),
+	}
+	if sessionRoot, err := filepath.Abs(cfg.GetScanRoot()); err == nil && sessionRoot == scanRoot {
+		run.resultsPath = cfg.GetResultFilePath()
+		run.settingsPath = cfg.GetScanSettingsFilePath()
+		run.inSession = true
+	}
+	if resultsPath != "" {
+		run.resultsPath = resultsPath
+	}
+
+	for _, hook := range hooks {
+		if !hook.Trusted {
+			result := entities.PostScanHookResult{
+				Name:   hook.Name,
+				Action: hook.Action,
+				Status: entities.PostScanHookSkipped,
+				Error:  fmt.Sprintf("hooks of %s are not trusted, review its .scanoss/%s and run: scanoss-cc hooks trust", scanRoot, config.DEFAULT_POST_SCAN_HOOKS_FILE),
+			}
+			log.Warn().Msgf("Skipping post-scan hook %s: %s", hook.Name, result.Error)
+			results = append(results, result)
+			s.emitEvent(entities.PostScanHookEvent, result)
+			continue
+		}
+
+		start := time.Now()
+		output, err := s.runHook(hook, run)
+
+		result := entities.PostScanHookResult{
+			Name:       hook.Name,
+			Action:     hook.Action,
+			Status:     entities.PostScanHookSucceeded,
+			Output:     utils.RedactSecret(output, cfg.GetApiToken()),
+			DurationMs: time.Since(start).Milliseconds(),
+		}
+		if err != nil {
+			log.Error().Err(err).Msgf("Post-scan hook %s failed", hook.Name)
+			result.Status = entities.PostScanHookFailed
+			result.Error = utils.RedactSecret(err.Error(), cfg.GetApiToken())
+		}
+
+		results = append(results, result)
+		s.emitEvent(entities.PostScanHookEvent, result)
+	}
+
+	s.emitEvent(entities.PostScanHooksCompleteEvent, results)
+	return results
+}
+
+func (s *PostScanHookServiceImpl) validHooks(scanRoot string) ([]entities.PostScanHook, error) {
+	hooks, err := s.repo.GetAll(scanRoot)
+	if err != nil {
+		return []entities.PostScanHook{}, err
+	}
+
+	valid := make([]entities.PostScanHook, 0, len(hooks))
+	for _, hook := range hooks {
+		if err := hook.Validate(); err != nil {
+			log.Warn().Err(err).Msgf("Skipping post-scan hook from the %s hooks file", hook.Scope)
+			continue
+		}
+		valid = append(valid, hook)
+	}
+
+	return valid, nil
+}
+
+func (s *PostScanHookServiceImpl) runHook(hook entities.PostScanHook, run postScanHookRun) (string, error) {
+	if hook.Action != entities.PostScanHookCommand && !run.inSession {
+		return "", fmt.Errorf("%w: open %s to run %s", entities.ErrPostScanHookNotInSession, run.scanRoot, hook.Action)
+	}
+
+	switch hook.Action {
+	case entities.PostScanHookReload:
+		if err := s.resultRepo.Reload(); err != nil {
+			return "", err
+		}
+		s.emitEvent(entities.ResultsReloadedEvent, nil)
+		return "", nil
+	case entities.PostScanHookApplyRules:
+		file, err := resolveHookPath(hook, run.scanRoot, hook.File)
+		if err != nil {
+			return "", err
+		}
+		return s.applyRules(file)
+	case entities.PostScanHookExport:
+		output, err := resolveHookPath(hook, run.scanRoot, hook.Output)
+		if err != nil {
+			return "", err
+		}
+		if err := s.export(hook.Format, output); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Exported %s to %s", hook.Format, output), nil
+	case entities.PostScanHookCommand:
+		return s.runCommand(hook, run)
+	default:
+		return "", fmt.Errorf("%w: unknown action %q", entities.ErrInvalidPostScanHook, hook.Action)
+	}
+}
+
+// applyRules applies the component decisions of a rules file and saves them to the settings file, so they
+// survive the session being reloaded after the scan.
+func (s *PostScanHookServiceImpl) applyRules(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading rules file: %w", err)
+	}
+	rules, err := utils.JSONParse[[]entities.ComponentFilterDTO](data)
+	if err != nil {
+		return "", fmt.Errorf("error parsing rules file %s: %w", path, err)
+	}
+	if len(rules) == 0 {
+		return "No rules to apply", nil
+	}
+
+	if err := s.componentService.FilterComponents(rules); err != nil {
+		return "", err
+	}
+	if err := s.scanossSettingsService.Save(); err != nil {
+		return "", fmt.Errorf("error saving applied rules: %w", err)
+	}
+
+	return fmt.Sprintf("Applied %d rules from %s", len(rules), path), nil
+}
+
+func (s *PostScanHookServiceImpl) export(format entities.ExportFormat, output string) error {
+	if err := os.MkdirAll(filepath.Dir(output), 0o755); err != nil {
+		return fmt.Errorf("error creating export folder: %w", err)
+	}
+
+	switch format {
+	case entities.ExportFormatCBOM:
+		return s.cryptographyService.ExportCBOM(output)
+	case entities.ExportFormatSummary:
+		summary, err := s.resultService.GetSummary(&entities.RequestResultDTO{})
+		if err != nil {
+			return err
+		}
+		return utils.WriteJsonFile(output, summary)
+	case entities.ExportFormatCycloneDX:
+		entries, err := s.sbomEntries()
+		if err != nil {
+			return err
+		}
+		return utils.WriteJsonFile(output, entities.NewSBOM(entries, uuid.NewString(), time.Now()))
+	default:
+		return fmt.Errorf("%w: unknown export format %q", entities.ErrInvalidPostScanHook, format)
+	}
+}
+
+// sbomEntries groups the results kept in the BOM by component. Replaced components take the purl they were
+// replaced with, their licenses are only known for the detected component.
+func (s *PostScanHookServiceImpl) sbomEntries() ([]entities.SBOMEntry, error) {
+	results, err := s.resultService.GetAll(&entities.RequestResultDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []entities.SBOMEntry
+	index := make(map[string]int)
+	for _, result := range results {
+		if result.FilterConfig.Action == entities.Remove {
+			continue
+		}
+		purl, name, replaced := result.DetectedPurl, result.DetectedName, false
+		if result.ConcludedPurl != "" && result.ConcludedPurl != result.DetectedPurl {
+			purl, name, replaced = result.ConcludedPurl, result.ConcludedName, true
+		}
+		if purl == "" {
+			continue
+		}
+
+		i, ok := index[purl]
+		if !ok {
+			entry := entities.SBOMEntry{Purl: purl, Name: name}
+			if !replaced {
+				if component, err := s.componentService.GetComponentByPath(result.Path); err == nil {
+					entry.Name = component.Component
+					entry.Version = component.Version
+					for _, license := range component.Licenses {
+						if l, ok := license.(*entities.LicenseDTO); ok && !slices.Contains(entry.Licenses, l.Name) {
+							entry.Licenses = append(entry.Licenses, l.Name)
+						}
+					}
+				}
+			}
+			i = len(entries)
+			index[purl] = i
+			entries = append(entries, entry)
+		}
+		entries[i].Files = append(entries[i].Files, result.Path)
+	}
+
+	return entries, nil
+}
+
+// runCommand runs a command hook in the scan root with the documented SCANOSS_* variables, returning the end of
+// its combined output.
+func (s *PostScanHookServiceImpl) runCommand(hook entities.PostScanHook, run postScanHookRun) (string, error) {
+	timeout := hook.TimeoutSeconds
+	if timeout == 0 {
+		timeout = entities.DefaultPostScanHookTimeoutSeconds
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, hook.Command[0], hook.Command[1:]...)
+	cmd.Dir = run.scanRoot
+	cmd.Env = append(os.Environ(),
+		PostScanHookEnvScanRoot+"="+run.scanRoot,
+		PostScanHookEnvResultsFile+"="+run.resultsPath,
+		PostScanHookEnvSettingsFile+"="+run.settingsPath,
+		PostScanHookEnvScanner+"="+config.GetInstance().GetScanner(),
+		PostScanHookEnvHookName+"="+hook.Name,
+	)
+
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	err := cmd.Run()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("command timed out after %d seconds", timeout)
+	}
+
+	text := output.String()
+	if len(text) > postScanHookMaxOutput {
+		text = text[len(text)-postScanHookMaxOutput:]
+	}
+	return text, err
+}
+
+func (s *PostScanHookServiceImpl) emitEvent(eventName string, data ...any) {
+	if s.ctx != nil {
+		runtime.EventsEmit(s.ctx, eventName, data...)
+	}
+}
+
+// resolveHookPath resolves a path of hook against the scan root. User hooks may point anywhere, project hooks come
+// with the scanned code and may only use relative paths that stay inside the scan root, symlinks included.
+func resolveHookPath(hook entities.PostScanHook, scanRoot, path string) (string, error) {
+	if hook.Scope == entities.ScanPresetScopeUser {
+		path = expandHomePath(path)
+		if filepath.IsAbs(path) {
+			return path, nil
+		}
+		return filepath.Join(scanRoot, path), nil
+	}
+
+	clean := filepath.Clean(filepath.FromSlash(path))
+	if filepath.IsAbs(clean) || filepath.VolumeName(clean) != "" || strings.HasPrefix(path, "~") || isOutsideFolder(clean) {
+		return "", fmt.Errorf("%w: %s", entities.ErrUnsafePostScanHookPath, path)
+	}
+
+	target := filepath.Join(scanRoot, clean)
+	realRoot, err := filepath.EvalSymlinks(scanRoot)
+	if err != nil {
+		return "", err
+	}
+	realTarget, err := evalExistingSymlinks(target)
+	if err != nil {
+		return "", err
+	}
+	if rel, err := filepath.Rel(realRoot, realTarget); err != nil || isOutsideFolder(rel) {
+		return "", fmt.Errorf("%w: %s", entities.ErrUnsafePostScanHookPath, path)
+	}
+
+	return target, nil
+}
+
+// evalExistingSymlinks resolves the symlinks of the longest existing part of path, keeping the rest as is.
+func evalExistingSymlinks(path string) (string, error) {
+	real, err := filepath.EvalSymlinks(path)
+	if err == nil {
+		return real, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+
+	parent := filepath.Dir(path)
+	if parent == path {
+		return path, nil
+	}
+	realParent, err := evalExistingSymlinks(parent)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(realParent, filepath.Base(path)), nil
+}
+
+func isOutsideFolder(rel string) bool {
+	return rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,278 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	repositoryMocks "github.com/scanoss/scanoss.cc/backend/repository/mocks"
+	"github.com/scanoss/scanoss.cc/backend/service"
+	serviceMocks "github.com/scanoss/scanoss.cc/backend/service/mocks"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type postScanHookMocks struct {
+	repo             *repositoryMocks.MockPostScanHookRepository
+	resultRepo       *repositoryMocks.MockResultRepository
+	componentService *serviceMocks.MockComponentService
+	settingsService  *serviceMocks.MockScanossSettingsService
+	resultService    *serviceMocks.MockResultService
+	cryptoService    *serviceMocks.MockCryptographyService
+}
+
+func newPostScanHookService(t *testing.T) (service.PostScanHookService, postScanHookMocks, string) {
+	t.Helper()
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	t.Cleanup(cleanup)
+
+	m := postScanHookMocks{
+		repo:             repositoryMocks.NewMockPostScanHookRepository(t),
+		resultRepo:       repositoryMocks.NewMockResultRepository(t),
+		componentService: serviceMocks.NewMockComponentService(t),
+		settingsService:  serviceMocks.NewMockScanossSettingsService(t),
+		resultService:    serviceMocks.NewMockResultService(t),
+		cryptoService:    serviceMocks.NewMockCryptographyService(t),
+	}
+	svc := service.NewPostScanHookServiceImpl(m.repo, m.resultRepo, m.componentService, m.settingsService, m.resultService, m.cryptoService)
+	return svc, m, config.GetInstance().GetScanRoot()
+}
+
+// trustedProjectHooks returns hooks as read from a project hooks file the user trusted.
+func trustedProjectHooks(hooks []entities.PostScanHook) []entities.PostScanHook {
+	for i := range hooks {
+		hooks[i].Scope = entities.ScanPresetScopeProject
+		hooks[i].Trusted = true
+	}
+	return hooks
+}
+
+func TestPostScanHookService_Run(t *testing.T) {
+	t.Run("built in actions run in order", func(t *testing.T) {
+		svc, m, scanRoot := newPostScanHookService(t)
+		rules := []entities.ComponentFilterDTO{{Purl: "pkg:npm/react", Action: entities.Include}}
+		data, err := json.Marshal(rules)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(scanRoot, "rules.json"), data, 0o644))
+
+		m.repo.EXPECT().GetAll(scanRoot).Return(trustedProjectHooks([]entities.PostScanHook{
+			{Name: "reload", Action: entities.PostScanHookReload},
+			{Name: "rules", Action: entities.PostScanHookApplyRules, File: "rules.json"},
+			{Name: "summary", Action: entities.PostScanHookExport, Format: entities.ExportFormatSummary, Output: "reports/summary.json"},
+			{Name: "broken", Action: entities.PostScanHookExport, Format: "xlsx"},
+		}), nil)
+		m.resultRepo.EXPECT().Reload().Return(nil).Once()
+		m.componentService.EXPECT().FilterComponents(rules).Return(nil).Once()
+		m.settingsService.EXPECT().Save().Return(nil).Once()
+		m.resultService.EXPECT().GetSummary(&entities.RequestResultDTO{}).Return(entities.ResultSummaryDTO{Total: 3, Pending: 1, Completed: 2}, nil)
+
+		results := svc.Run(scanRoot, "")
+
+		require.Len(t, results, 3, "the invalid hook is skipped")
+		for _, result := range results {
+			assert.Equal(t, entities.PostScanHookSucceeded, result.Status, result.Error)
+		}
+		assert.Contains(t, results[1].Output, "Applied 1 rules")
+
+		summary, err := os.ReadFile(filepath.Join(scanRoot, "reports", "summary.json"))
+		require.NoError(t, err)
+		assert.Contains(t, string(summary), `"completed": 2`)
+	})
+
+	t.Run("a failing hook does not stop the next ones", func(t *testing.T) {
+		svc, m, scanRoot := newPostScanHookService(t)
+		m.repo.EXPECT().GetAll(scanRoot).Return(trustedProjectHooks([]entities.PostScanHook{
+			{Name: "rules", Action: entities.PostScanHookApplyRules, File: "missing.json"},
+			{Name: "reload", Action: entities.PostScanHookReload},
+		}), nil)
+		m.resultRepo.EXPECT().Reload().Return(nil).Once()
+
+		results := svc.Run(scanRoot, "")
+
+		require.Len(t, results, 2)
+		assert.Equal(t, entities.PostScanHookFailed, results[0].Status)
+		assert.Contains(t, results[0].Error, "rules file")
+		assert.Equal(t, entities.PostScanHookSucceeded, results[1].Status)
+	})
+
+	t.Run("SBOM export groups results by component", func(t *testing.T) {
+		svc, m, scanRoot := newPostScanHookService(t)
+		m.repo.EXPECT().GetAll(scanRoot).Return(trustedProjectHooks([]entities.PostScanHook{
+			{Name: "sbom", Action: entities.PostScanHookExport, Format: entities.ExportFormatCycloneDX, Output: "sbom.json"},
+		}), nil)
+		m.resultService.EXPECT().GetAll(&entities.RequestResultDTO{}).Return([]entities.ResultDTO{
+			{Path: "a.js", DetectedPurl: "pkg:npm/react", DetectedName: "react"},
+			{Path: "b.js", DetectedPurl: "pkg:npm/react", DetectedName: "react"},
+			{Path: "c.js", DetectedPurl: "pkg:npm/left-pad", FilterConfig: entities.FilterConfig{Action: entities.Remove}},
+			{Path: "d.js", DetectedPurl: "pkg:npm/lodash", ConcludedPurl: "pkg:npm/underscore", ConcludedName: "underscore"},
+		}, nil)
+		m.componentService.EXPECT().GetComponentByPath("a.js").Return(entities.ComponentDTO{
+			Component: "react",
+			Version:   "18.2.0",
+			Licenses:  []entities.ComponentLicense{&entities.LicenseDTO{Name: "MIT"}, &entities.LicenseDTO{Name: "MIT"}},
+		}, nil)
+
+		results := svc.Run(scanRoot, "")
+		require.Len(t, results, 1)
+		require.Equal(t, entities.PostScanHookSucceeded, results[0].Status, results[0].Error)
+
+		data, err := os.ReadFile(filepath.Join(scanRoot, "sbom.json"))
+		require.NoError(t, err)
+		var sbom entities.SBOM
+		require.NoError(t, json.Unmarshal(data, &sbom))
+		require.Len(t, sbom.Components, 2)
+		assert.Equal(t, "pkg:npm/react", sbom.Components[0].Purl)
+		assert.Equal(t, "18.2.0", sbom.Components[0].Version)
+		assert.Len(t, sbom.Components[0].Licenses, 1)
+		assert.Len(t, sbom.Components[0].Evidence.Occurrences, 2)
+		assert.Equal(t, "pkg:npm/underscore", sbom.Components[1].Purl)
+	})
+
+	t.Run("command hooks get the scan environment", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("uses a shell script as hook")
+		}
+		svc, m, scanRoot := newPostScanHookService(t)
+		script := filepath.Join(scanRoot, "hook.sh")
+		require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\necho \"$SCANOSS_HOOK_NAME $SCANOSS_RESULTS_FILE $PWD\"\nexit 3\n"), 0o755))
+		m.repo.EXPECT().GetAll(scanRoot).Return(trustedProjectHooks([]entities.PostScanHook{
+			{Name: "notify", Action: entities.PostScanHookCommand, Command: []string{"./hook.sh"}},
+		}), nil)
+
+		results := svc.Run(scanRoot, "/tmp/results.json")
+
+		require.Len(t, results, 1)
+		assert.Equal(t, entities.PostScanHookFailed, results[0].Status)
+		assert.Contains(t, results[0].Error, "exit status 3")
+		assert.Contains(t, results[0].Output, "notify /tmp/results.json")
+		assert.Contains(t, results[0].Output, filepath.Base(scanRoot))
+	})
+
+	t.Run("untrusted project hooks are skipped whatever their action", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("uses a shell script as hook")
+		}
+		svc, m, scanRoot := newPostScanHookService(t)
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		script := filepath.Join(scanRoot, "hook.sh")
+		require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\ntouch ran\n"), 0o755))
+		m.repo.EXPECT().GetAll(scanRoot).Return([]entities.PostScanHook{
+			{Name: "notify", Action: entities.PostScanHookCommand, Command: []string{"./hook.sh"}, Scope: entities.ScanPresetScopeProject},
+			{Name: "reload", Action: entities.PostScanHookReload, Scope: entities.ScanPresetScopeProject},
+			{Name: "rules", Action: entities.PostScanHookApplyRules, File: "/etc/passwd", Scope: entities.ScanPresetScopeProject},
+			{Name: "bashrc", Action: entities.PostScanHookExport, Format: entities.ExportFormatSummary, Output: "~/.bashrc", Scope: entities.ScanPresetScopeProject},
+		}, nil)
+
+		results := svc.Run(scanRoot, "")
+
+		require.Len(t, results, 4)
+		for _, result := range results {
+			assert.Equal(t, entities.PostScanHookSkipped, result.Status, result.Name)
+			assert.Contains(t, result.Error, "scanoss-cc hooks trust")
+		}
+		assert.NoFileExists(t, filepath.Join(scanRoot, "ran"))
+		assert.NoFileExists(t, filepath.Join(home, ".bashrc"))
+	})
+
+	t.Run("trusted project hooks can't use paths outside the scan root", func(t *testing.T) {
+		svc, m, scanRoot := newPostScanHookService(t)
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		outside := t.TempDir()
+		require.NoError(t, os.Symlink(outside, filepath.Join(scanRoot, "linked")))
+		m.repo.EXPECT().GetAll(scanRoot).Return(trustedProjectHooks([]entities.PostScanHook{
+			{Name: "home", Action: entities.PostScanHookExport, Format: entities.ExportFormatSummary, Output: "~/.bashrc"},
+			{Name: "absolute", Action: entities.PostScanHookExport, Format: entities.ExportFormatSummary, Output: filepath.Join(outside, "summary.json")},
+			{Name: "parent", Action: entities.PostScanHookExport, Format: entities.ExportFormatSummary, Output: "reports/../../summary.json"},
+			{Name: "symlink", Action: entities.PostScanHookExport, Format: entities.ExportFormatSummary, Output: "linked/summary.json"},
+			{Name: "rules", Action: entities.PostScanHookApplyRules, File: "../rules.json"},
+		}), nil)
+
+		results := svc.Run(scanRoot, "")
+
+		require.Len(t, results, 5)
+		for _, result := range results {
+			assert.Equal(t, entities.PostScanHookFailed, result.Status, result.Name)
+			assert.Contains(t, result.Error, entities.ErrUnsafePostScanHookPath.Error(), result.Name)
+		}
+		assert.NoFileExists(t, filepath.Join(home, ".bashrc"))
+		assert.NoFileExists(t, filepath.Join(outside, "summary.json"))
+		assert.NoFileExists(t, filepath.Join(filepath.Dir(scanRoot), "summary.json"))
+	})
+
+	t.Run("user hooks may export outside the scan root", func(t *testing.T) {
+		svc, m, scanRoot := newPostScanHookService(t)
+		output := filepath.Join(t.TempDir(), "summary.json")
+		m.repo.EXPECT().GetAll(scanRoot).Return([]entities.PostScanHook{
+			{Name: "summary", Action: entities.PostScanHookExport, Format: entities.ExportFormatSummary, Output: output, Scope: entities.ScanPresetScopeUser, Trusted: true},
+		}, nil)
+		m.resultService.EXPECT().GetSummary(&entities.RequestResultDTO{}).Return(entities.ResultSummaryDTO{Total: 1}, nil)
+
+		results := svc.Run(scanRoot, "")
+
+		require.Len(t, results, 1)
+		assert.Equal(t, entities.PostScanHookSucceeded, results[0].Status, results[0].Error)
+		assert.FileExists(t, output)
+	})
+
+	t.Run("hooks of another folder leave the review session alone", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("uses a shell script as hook")
+		}
+		svc, m, sessionRoot := newPostScanHookService(t)
+		scanRoot := t.TempDir()
+		script := filepath.Join(scanRoot, "hook.sh")
+		require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\necho \"$SCANOSS_RESULTS_FILE\"\n"), 0o755))
+		m.repo.EXPECT().GetAll(scanRoot).Return(trustedProjectHooks([]entities.PostScanHook{
+			{Name: "reload", Action: entities.PostScanHookReload},
+			{Name: "notify", Action: entities.PostScanHookCommand, Command: []string{"./hook.sh"}},
+		}), nil)
+
+		results := svc.Run(scanRoot, "")
+
+		require.Len(t, results, 2)
+		assert.Equal(t, entities.PostScanHookFailed, results[0].Status)
+		assert.Contains(t, results[0].Error, entities.ErrPostScanHookNotInSession.Error())
+		assert.Equal(t, entities.PostScanHookSucceeded, results[1].Status, results[1].Error)
+		assert.Contains(t, results[1].Output, filepath.Join(scanRoot, ".scanoss", "results.json"))
+		assert.Equal(t, sessionRoot, config.GetInstance().GetScanRoot())
+	})
+
+	t.Run("no hooks", func(t *testing.T) {
+		svc, m, scanRoot := newPostScanHookService(t)
+		m.repo.EXPECT().GetAll(scanRoot).Return([]entities.PostScanHook{}, nil)
+
+		assert.Empty(t, svc.Run(scanRoot, ""))
+	})
+}
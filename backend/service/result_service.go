@@ -31,6 +31,8 @@ import (
 
 type ResultService interface {
 	GetAll(dto *entities.RequestResultDTO) ([]entities.ResultDTO, error)
+	GetPage(dto *entities.RequestResultPageDTO) (entities.ResultPageDTO, error)
+	GetSummary(dto *entities.RequestResultDTO) (entities.ResultSummaryDTO, error)
 	GetByPath(path string) entities.ResultDTO
 	SetContext(ctx context.Context)
 }
@@ -25,7 +25,7 @@ package service
 
 import (
 	"context"
-	"sort"
+	"fmt"
 
 	"github.com/rs/zerolog/log"
 	"github.com/scanoss/scanoss.cc/backend/entities"
@@ -48,21 +48,87 @@ func NewResultServiceImpl(repo repository.ResultRepository, mapper mappers.Resul
 }
 
 func (s *ResultServiceImpl) GetAll(dto *entities.RequestResultDTO) ([]entities.ResultDTO, error) {
-	err := utils.GetValidator().Struct(dto)
+	results, err := s.getFilteredResults(dto)
 	if err != nil {
-		log.Error().Err(err).Msg("Validation error: %v")
 		return []entities.ResultDTO{}, err
 	}
 
-	filter := entities.NewResultFilterFactory().Create(dto)
-	results, err := s.repo.GetResults(filter)
+	s.sortResults(results, dto)
+
+	return s.mapper.MapToResultDTOList(results), nil
+}
+
+// GetPage sorts every matching result but only maps the requested page, so large result sets can be loaded
+// incrementally.
+func (s *ResultServiceImpl) GetPage(dto *entities.RequestResultPageDTO) (entities.ResultPageDTO, error) {
+	if dto == nil {
+		return entities.ResultPageDTO{}, fmt.Errorf("page request is required")
+	}
+
+	if err := utils.GetValidator().Struct(dto); err != nil {
+		log.Error().Err(err).Msg("Validation error")
+		return entities.ResultPageDTO{}, err
+	}
+
+	results, err := s.getFilteredResults(&dto.Filter)
 	if err != nil {
-		return []entities.ResultDTO{}, err
+		return entities.ResultPageDTO{}, err
 	}
 
-	s.sortResults(results, dto)
+	s.sortResults(results, &dto.Filter)
 
-	return s.mapper.MapToResultDTOList(results), nil
+	start := min(dto.Offset, len(results))
+	end := min(start+dto.Limit, len(results))
+
+	return entities.ResultPageDTO{
+		Results: s.mapper.MapToResultDTOList(results[start:end]),
+		Offset:  start,
+		Limit:   dto.Limit,
+		Total:   len(results),
+		HasMore: end < len(results),
+	}, nil
+}
+
+// GetSummary counts the results matching the filter without sorting or mapping them.
+func (s *ResultServiceImpl) GetSummary(dto *entities.RequestResultDTO) (entities.ResultSummaryDTO, error) {
+	results, err := s.getFilteredResults(dto)
+	if err != nil {
+		return entities.ResultSummaryDTO{}, err
+	}
+
+	completed := entities.NewResultFilterWorkflowState(entities.Completed)
+	summary := entities.ResultSummaryDTO{Total: len(results)}
+	for _, result := range results {
+		if completed.IsValid(result) {
+			summary.Completed++
+		} else {
+			summary.Pending++
+		}
+
+		switch entities.MatchType(result.MatchType) {
+		case entities.MatchTypeFile:
+			summary.File++
+		case entities.MatchTypeSnippet:
+			summary.Snippet++
+		}
+	}
+
+	return summary, nil
+}
+
+func (s *ResultServiceImpl) getFilteredResults(dto *entities.RequestResultDTO) ([]entities.Result, error) {
+	err := utils.GetValidator().Struct(dto)
+	if err != nil {
+		log.Error().Err(err).Msg("Validation error: %v")
+		return nil, err
+	}
+
+	filter, err := entities.NewResultFilterFactory().Create(dto)
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid result query")
+		return nil, err
+	}
+	return s.repo.GetResults(filter)
 }
 
 func (s *ResultServiceImpl) SetContext(ctx context.Context) {
@@ -70,42 +136,7 @@ func (s *ResultServiceImpl) SetContext(ctx context.Context) {
 }
 
 func (s *ResultServiceImpl) sortResults(results []entities.Result, dto *entities.RequestResultDTO) {
-	sort.Slice(results, func(i, j int) bool {
-		firstResult := results[i]
-		secondResult := results[j]
-
-		// Default values if Sort is not specified
-		sortOption := entities.SortByMatchPercentage
-		sortOrder := entities.SortOrderDesc
-		if dto.Sort.Option != "" {
-			sortOption = dto.Sort.Option
-		}
-		if dto.Sort.Order != "" {
-			sortOrder = dto.Sort.Order
-		}
-
-		switch sortOption {
-		case entities.SortByMatchPercentage:
-			iPercentage := firstResult.GetMatchPercentage()
-			jPercentage := secondResult.GetMatchPercentage()
-			if iPercentage != jPercentage {
-				return sortOrder == entities.SortOrderDesc && iPercentage > jPercentage ||
-					sortOrder == entities.SortOrderAsc && iPercentage < jPercentage
-			}
-			return firstResult.Path < secondResult.Path // Secondary sort by path
-		case entities.SortByPath:
-			return (sortOrder == entities.SortOrderAsc) == (firstResult.Path < secondResult.Path)
-		default:
-			// Default to match percentage sorting
-			iPercentage := firstResult.GetMatchPercentage()
-			jPercentage := secondResult.GetMatchPercentage()
-			if iPercentage != jPercentage {
-				return sortOrder == entities.SortOrderDesc && iPercentage > jPercentage ||
-					sortOrder == entities.SortOrderAsc && iPercentage < jPercentage
-			}
-			return firstResult.Path < secondResult.Path
-		}
-	})
+	entities.SortResults(results, dto.Sort)
 }
 
 func (s *ResultServiceImpl) GetByPath(path string) entities.ResultDTO {
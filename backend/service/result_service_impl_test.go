@@ -90,3 +90,62 @@ func TestGetResults(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 	resultMapper.AssertExpectations(t)
 }
+
+func TestGetResultsPage(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	purl := []string{"pkg:example/package"}
+	results := []entities.Result{
+		{Path: "c.go", MatchType: "file", Purl: &purl},
+		{Path: "a.go", MatchType: "snippet", Purl: &purl},
+		{Path: "d.go", MatchType: "file", Purl: &purl},
+		{Path: "b.go", MatchType: "snippet", Purl: &purl},
+		{Path: "e.go", MatchType: "file", Purl: &purl},
+	}
+
+	mockRepo := repoMocks.NewMockResultRepository(t)
+	resultMapper := mapperMocks.NewMockResultMapper(t)
+	mockRepo.EXPECT().GetResults(mock.Anything).RunAndReturn(func(entities.ResultFilter) ([]entities.Result, error) {
+		return append([]entities.Result(nil), results...), nil
+	})
+
+	// Only the requested page is mapped
+	resultMapper.EXPECT().MapToResultDTOList(mock.Anything).RunAndReturn(func(page []entities.Result) []entities.ResultDTO {
+		dtos := make([]entities.ResultDTO, len(page))
+		for i, r := range page {
+			dtos[i] = entities.ResultDTO{Path: r.Path}
+		}
+		return dtos
+	})
+
+	svc := service.NewResultServiceImpl(mockRepo, resultMapper)
+	sortByPath := entities.RequestResultDTO{Sort: entities.SortConfig{Option: entities.SortByPath, Order: entities.SortOrderAsc}}
+
+	page, err := svc.GetPage(&entities.RequestResultPageDTO{Filter: sortByPath, Offset: 1, Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, entities.ResultPageDTO{
+		Results: []entities.ResultDTO{{Path: "b.go"}, {Path: "c.go"}},
+		Offset:  1,
+		Limit:   2,
+		Total:   5,
+		HasMore: true,
+	}, page)
+
+	page, err = svc.GetPage(&entities.RequestResultPageDTO{Filter: sortByPath, Offset: 4, Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []entities.ResultDTO{{Path: "e.go"}}, page.Results)
+	assert.False(t, page.HasMore)
+
+	page, err = svc.GetPage(&entities.RequestResultPageDTO{Filter: sortByPath, Offset: 10, Limit: 2})
+	assert.NoError(t, err)
+	assert.Empty(t, page.Results)
+	assert.Equal(t, 5, page.Total)
+
+	_, err = svc.GetPage(&entities.RequestResultPageDTO{Filter: sortByPath, Limit: 0})
+	assert.Error(t, err)
+
+	summary, err := svc.GetSummary(&entities.RequestResultDTO{})
+	assert.NoError(t, err)
+	assert.Equal(t, entities.ResultSummaryDTO{Total: 5, Pending: 5, File: 3, Snippet: 2}, summary)
+}
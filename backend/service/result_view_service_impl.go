@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+type ResultViewServiceImpl struct {
+	repo repository.ResultViewRepository
+}
+
+func NewResultViewServiceImpl(repo repository.ResultViewRepository) ResultViewService {
+	return &ResultViewServiceImpl{
+		repo: repo,
+	}
+}
+
+func (s *ResultViewServiceImpl) GetAll() ([]entities.ResultView, error) {
+	return s.repo.GetAll()
+}
+
+func (s *ResultViewServiceImpl) Get(name string) (entities.ResultView, error) {
+	return s.repo.Get(name)
+}
+
+// Save creates the view, or overwrites the saved view with the same name.
+func (s *ResultViewServiceImpl) Save(view entities.ResultView) error {
+	view.Name = strings.TrimSpace(view.Name)
+	if err := utils.GetValidator().Struct(view); err != nil {
+		log.Error().Err(err).Msg("Invalid view: validation failed")
+		return fmt.Errorf("invalid view: %w", err)
+	}
+
+	if entities.IsFieldQuery(view.Filter.Query) {
+		if _, err := entities.ParseResultQuery(view.Filter.Query); err != nil {
+			return fmt.Errorf("invalid view: %w", err)
+		}
+	}
+
+	return s.repo.Save(view)
+}
+
+func (s *ResultViewServiceImpl) Delete(name string) error {
+	return s.repo.Delete(name)
+}
+
+// GetStartupView returns the view selected with --view, or nil when the app was opened without one.
+func (s *ResultViewServiceImpl) GetStartupView() (*entities.ResultView, error) {
+	name := config.GetInstance().GetStartupView()
+	if name == "" {
+		return nil, nil
+	}
+
+	view, err := s.repo.Get(name)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error loading startup view %q", name)
+		return nil, err
+	}
+
+	return &view, nil
+}
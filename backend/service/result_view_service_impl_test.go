@@ -0,0 +1,71 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service_test
+
+import (
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	repoMocks "github.com/scanoss/scanoss.cc/backend/repository/mocks"
+	"github.com/scanoss/scanoss.cc/backend/service"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultViewService_Save(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	repo := repoMocks.NewMockResultViewRepository(t)
+	svc := service.NewResultViewServiceImpl(repo)
+
+	repo.EXPECT().Save(entities.ResultView{Name: "vendored", Filter: entities.RequestResultDTO{Query: `comment:"vendored"`}}).Return(nil)
+	assert.NoError(t, svc.Save(entities.ResultView{Name: " vendored ", Filter: entities.RequestResultDTO{Query: `comment:"vendored"`}}))
+
+	assert.Error(t, svc.Save(entities.ResultView{Name: "  "}))
+	assert.Error(t, svc.Save(entities.ResultView{Name: "broken", Filter: entities.RequestResultDTO{Query: "state:done"}}))
+}
+
+func TestResultViewService_GetStartupView(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	repo := repoMocks.NewMockResultViewRepository(t)
+	svc := service.NewResultViewServiceImpl(repo)
+
+	view, err := svc.GetStartupView()
+	require.NoError(t, err)
+	assert.Nil(t, view)
+
+	config.GetInstance().SetStartupView("triage")
+	repo.EXPECT().Get("triage").Return(entities.ResultView{Name: "triage"}, nil)
+
+	view, err = svc.GetStartupView()
+	require.NoError(t, err)
+	assert.Equal(t, "triage", view.Name)
+}
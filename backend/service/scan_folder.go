@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+// scanFolderMatcher reports whether a path relative to the scan root is left out of folder scans: hidden
+// entries and the effective scanning skip patterns. settings may be nil.
+func scanFolderMatcher(settings repository.ScanossSettingsRepository) func(rel string, isDir bool) bool {
+	var patterns []gitignore.Pattern
+	if settings != nil {
+		for _, pattern := range settings.GetEffectiveScanningSkipPatterns() {
+			patterns = append(patterns, gitignore.ParsePattern(pattern, nil))
+		}
+	}
+	matcher := gitignore.NewMatcher(patterns)
+
+	return func(rel string, isDir bool) bool {
+		parts := utils.FullySplitPath(rel)
+		for _, part := range parts {
+			if strings.HasPrefix(part, ".") {
+				return true
+			}
+		}
+		return matcher.Match(parts, isDir)
+	}
+}
+
+// listScanFolder returns the regular files of root that a folder scan fingerprints, relative to root with
+// forward slashes.
+func listScanFolder(root string, settings repository.ScanossSettingsRepository) ([]string, error) {
+	skip := scanFolderMatcher(settings)
+
+	var files []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping unreadable path %s", path)
+			if d != nil && d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if path == root {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if skip(rel, true) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() || skip(rel, false) {
+			return nil
+		}
+
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking %s: %w", root, err)
+	}
+
+	return files, nil
+}
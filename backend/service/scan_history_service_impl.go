@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+var errResultsNotLoaded = errors.New("no results are loaded to switch scan runs")
+
+// ScanHistoryServiceImpl records a snapshot of the results after every scan and lets the UI switch between them.
+// Opening a past run loads it read-only in place of the latest results, until it is closed again.
+type ScanHistoryServiceImpl struct {
+	historyRepo repository.ScanHistoryRepository
+	resultRepo  repository.ResultRepository
+	openRun     *entities.ScanRun
+	mutex       sync.Mutex
+	now         func() time.Time
+}
+
+// NewScanHistoryServiceImpl returns the scan history service. resultRepo may be nil when only recording runs,
+// as the CLI does.
+func NewScanHistoryServiceImpl(historyRepo repository.ScanHistoryRepository, resultRepo repository.ResultRepository) ScanHistoryService {
+	return &ScanHistoryServiceImpl{
+		historyRepo: historyRepo,
+		resultRepo:  resultRepo,
+		now:         time.Now,
+	}
+}
+
+// Record snapshots the results file written by a scan run with the given arguments and applies the retention policy.
+func (s *ScanHistoryServiceImpl) Record(resultsPath string, args []string) (entities.ScanRun, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cfg := config.GetInstance()
+	results, err := os.ReadFile(resultsPath)
+	if err != nil {
+		return entities.ScanRun{}, fmt.Errorf("error reading results file: %w", err)
+	}
+
+	scanner := cfg.GetScanner()
+	if scanner == "" {
+		scanner = string(entities.ScannerPython)
+	}
+
+	settingsHash, err := fileSHA256(cfg.GetScanSettingsFilePath())
+	if err != nil {
+		log.Warn().Err(err).Msg("Error hashing scan settings for scan history")
+	}
+
+	run, err := s.historyRepo.Save(resultsPath, entities.ScanRun{
+		CreatedAt:    s.now().UTC(),
+		Scanner:      scanner,
+		Args:         utils.RedactArgs(args),
+		SettingsHash: settingsHash,
+		ResultCount:  countResults(results),
+	}, results)
+	if err != nil {
+		return entities.ScanRun{}, err
+	}
+
+	// A new scan always shows its own results
+	if err := s.closeLocked(); err != nil {
+		log.Error().Err(err).Msg("Error closing scan run after a new scan")
+	}
+
+	s.applyRetention(resultsPath)
+
+	log.Debug().Msgf("Recorded scan run %s with %d results", run.ID, run.ResultCount)
+	return run, nil
+}
+
+// List returns the runs of the current results file, newest first.
+func (s *ScanHistoryServiceImpl) List() ([]entities.ScanRun, error) {
+	return s.historyRepo.List(config.GetInstance().GetResultFilePath())
+}
+
+func (s *ScanHistoryServiceImpl) Open(id string) (entities.ScanRun, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.resultRepo == nil {
+		return entities.ScanRun{}, errResultsNotLoaded
+	}
+
+	run, err := s.historyRepo.Get(config.GetInstance().GetResultFilePath(), id)
+	if err != nil {
+		return entities.ScanRun{}, err
+	}
+	if err := s.resultRepo.OpenSnapshot(run.ResultsFile); err != nil {
+		return entities.ScanRun{}, fmt.Errorf("error opening scan run %s: %w", id, err)
+	}
+
+	s.openRun = &run
+	return run, nil
+}
+
+// Close goes back to the latest results.
+func (s *ScanHistoryServiceImpl) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.closeLocked()
+}
+
+func (s *ScanHistoryServiceImpl) Delete(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.openRun != nil && s.openRun.ID == id {
+		if err := s.closeLocked(); err != nil {
+			return err
+		}
+	}
+
+	return s.historyRepo.Delete(config.GetInstance().GetResultFilePath(), id)
+}
+
+// GetOpenRun returns the run being shown, or nil when showing the latest results.
+func (s *ScanHistoryServiceImpl) GetOpenRun() *entities.ScanRun {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// The result repository drops the snapshot by itself when another project is opened
+	if s.openRun == nil || s.resultRepo == nil || !s.resultRepo.IsReadOnly() {
+		s.openRun = nil
+		return nil
+	}
+
+	run := *s.openRun
+	return &run
+}
+
+func (s *ScanHistoryServiceImpl) closeLocked() error {
+	if s.openRun == nil || s.resultRepo == nil {
+		s.openRun = nil
+		return nil
+	}
+
+	s.openRun = nil
+	return s.resultRepo.CloseSnapshot()
+}
+
+func (s *ScanHistoryServiceImpl) applyRetention(resultsPath string) {
+	cfg := config.GetInstance()
+	retention := entities.ScanHistoryRetention{
+		MaxRuns:    cfg.GetScanHistoryMaxRuns(),
+		MaxAgeDays: cfg.GetScanHistoryMaxAgeDays(),
+	}
+
+	runs, err := s.historyRepo.List(resultsPath)
+	if err != nil {
+		log.Error().Err(err).Msg("Error listing scan history for retention")
+		return
+	}
+
+	for _, run := range retention.Expired(runs, s.now()) {
+		if err := s.historyRepo.Delete(resultsPath, run.ID); err != nil {
+			log.Error().Err(err).Msgf("Error removing expired scan run %s", run.ID)
+		}
+	}
+}
+
+// countResults returns the number of files in a scanoss results file, 0 for other formats.
+func countResults(results []byte) int {
+	var files map[string]json.RawMessage
+	if err := json.Unmarshal(results, &files); err != nil {
+		return 0
+	}
+	return len(files)
+}
+
+// fileSHA256 returns the hex SHA-256 of a file, or an empty string when it does not exist.
+func fileSHA256(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
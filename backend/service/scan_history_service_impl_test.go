@@ -0,0 +1,117 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/backend/service"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanHistoryService(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	cfg := config.GetInstance()
+	resultsPath := filepath.Join(t.TempDir(), ".scanoss", "results.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(resultsPath), 0o755))
+	require.NoError(t, os.WriteFile(resultsPath, []byte(`{"src/main.c": [{"id": "file"}]}`), 0o644))
+	cfg.SetResultFilePath(resultsPath)
+	cfg.SetScanner(string(entities.ScannerNative))
+	cfg.SetScanHistoryRetention(2, 0)
+
+	fr := utils.NewDefaultFileReader()
+	resultRepo, err := repository.NewResultRepositoryJsonImpl(fr)
+	require.NoError(t, err)
+	svc := service.NewScanHistoryServiceImpl(repository.NewScanHistoryRepositoryJsonImpl(fr), resultRepo)
+
+	first, err := svc.Record(resultsPath, []string{".", "--key", "secret", "--output", resultsPath})
+	require.NoError(t, err)
+	assert.Equal(t, []string{".", "--key", "****", "--output", resultsPath}, first.Args)
+	assert.Equal(t, "native", first.Scanner)
+	assert.Equal(t, 1, first.ResultCount)
+
+	require.NoError(t, os.WriteFile(resultsPath, []byte(`{"src/main.c": [{"id": "none"}], "src/util.c": [{"id": "none"}]}`), 0o644))
+	second, err := svc.Record(resultsPath, []string{"."})
+	require.NoError(t, err)
+	assert.Equal(t, 2, second.ResultCount)
+
+	t.Run("Open a past run read-only", func(t *testing.T) {
+		run, err := svc.Open(first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, first.ID, run.ID)
+		assert.True(t, resultRepo.IsReadOnly())
+		assert.Equal(t, first.ID, svc.GetOpenRun().ID)
+		assert.Equal(t, "file", resultRepo.GetResultByPath("src/main.c").MatchType)
+		assert.Nil(t, resultRepo.GetResultByPath("src/util.c"))
+
+		require.NoError(t, svc.Close())
+		assert.False(t, resultRepo.IsReadOnly())
+		assert.Nil(t, svc.GetOpenRun())
+		assert.NotNil(t, resultRepo.GetResultByPath("src/util.c"))
+	})
+
+	t.Run("Recording a scan closes the open run", func(t *testing.T) {
+		_, err := svc.Open(first.ID)
+		require.NoError(t, err)
+
+		_, err = svc.Record(resultsPath, []string{"."})
+		require.NoError(t, err)
+		assert.False(t, resultRepo.IsReadOnly())
+		assert.Nil(t, svc.GetOpenRun())
+	})
+
+	t.Run("Retention keeps the newest runs", func(t *testing.T) {
+		runs, err := svc.List()
+		require.NoError(t, err)
+		require.Len(t, runs, 2)
+		assert.Equal(t, second.ID, runs[1].ID)
+
+		_, err = svc.Open(first.ID)
+		assert.ErrorIs(t, err, entities.ErrScanRunNotFound)
+	})
+
+	t.Run("Deleting the open run goes back to the latest results", func(t *testing.T) {
+		_, err := svc.Open(second.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, svc.Delete(second.ID))
+		assert.False(t, resultRepo.IsReadOnly())
+
+		runs, err := svc.List()
+		require.NoError(t, err)
+		assert.Len(t, runs, 1)
+	})
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+)
+
+// ScanPresetServiceImpl manages the scan presets of the user and of the current scan root.
+type ScanPresetServiceImpl struct {
+	repo repository.ScanPresetRepository
+}
+
+func NewScanPresetServiceImpl(repo repository.ScanPresetRepository) ScanPresetService {
+	return &ScanPresetServiceImpl{
+		repo: repo,
+	}
+}
+
+// GetAll returns the valid presets. Invalid presets edited by hand are skipped with a warning.
+func (s *ScanPresetServiceImpl) GetAll() ([]entities.ScanPreset, error) {
+	presets, err := s.repo.GetAll(config.GetInstance().GetScanRoot())
+	if err != nil {
+		return []entities.ScanPreset{}, err
+	}
+
+	valid := make([]entities.ScanPreset, 0, len(presets))
+	for _, preset := range presets {
+		if err := preset.Validate(); err != nil {
+			log.Warn().Err(err).Msgf("Skipping %s scan preset %q", preset.Scope, preset.Name)
+			continue
+		}
+		valid = append(valid, preset)
+	}
+
+	return valid, nil
+}
+
+func (s *ScanPresetServiceImpl) Get(name string) (entities.ScanPreset, error) {
+	preset, err := s.repo.Get(config.GetInstance().GetScanRoot(), name)
+	if err != nil {
+		return entities.ScanPreset{}, err
+	}
+	if err := preset.Validate(); err != nil {
+		return entities.ScanPreset{}, err
+	}
+
+	return preset, nil
+}
+
+// Save creates the preset in its scope, user when not set, or overwrites the preset with the same name there.
+func (s *ScanPresetServiceImpl) Save(preset entities.ScanPreset) error {
+	preset.Name = strings.TrimSpace(preset.Name)
+	if preset.Scope == "" {
+		preset.Scope = entities.ScanPresetScopeUser
+	}
+
+	if err := utils.GetValidator().Struct(preset); err != nil {
+		log.Error().Err(err).Msg("Invalid scan preset: validation failed")
+		return fmt.Errorf("%w: %w", entities.ErrInvalidScanPreset, err)
+	}
+	if err := preset.Validate(); err != nil {
+		return err
+	}
+
+	return s.repo.Save(config.GetInstance().GetScanRoot(), preset)
+}
+
+func (s *ScanPresetServiceImpl) Delete(name string, scope entities.ScanPresetScope) error {
+	return s.repo.Delete(config.GetInstance().GetScanRoot(), name, scope)
+}
@@ -0,0 +1,80 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service_test
+
+import (
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository/mocks"
+	"github.com/scanoss/scanoss.cc/backend/service"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanPresetService(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	scanRoot := config.GetInstance().GetScanRoot()
+
+	t.Run("GetAll skips invalid presets", func(t *testing.T) {
+		repo := mocks.NewMockScanPresetRepository(t)
+		repo.EXPECT().GetAll(scanRoot).Return([]entities.ScanPreset{
+			{Name: "deep", Args: map[string]any{"threads": float64(10)}, Scope: entities.ScanPresetScopeUser},
+			{Name: "broken", Args: map[string]any{"threads": "many"}, Scope: entities.ScanPresetScopeProject},
+		}, nil)
+
+		presets, err := service.NewScanPresetServiceImpl(repo).GetAll()
+		require.NoError(t, err)
+		require.Len(t, presets, 1)
+		assert.Equal(t, "deep", presets[0].Name)
+	})
+
+	t.Run("Save defaults to the user scope", func(t *testing.T) {
+		repo := mocks.NewMockScanPresetRepository(t)
+		repo.EXPECT().Save(scanRoot, mock.MatchedBy(func(preset entities.ScanPreset) bool {
+			return preset.Name == "deep" && preset.Scope == entities.ScanPresetScopeUser
+		})).Return(nil)
+
+		err := service.NewScanPresetServiceImpl(repo).Save(entities.ScanPreset{Name: " deep ", Args: map[string]any{"retry": 3}})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Save rejects invalid presets", func(t *testing.T) {
+		repo := mocks.NewMockScanPresetRepository(t)
+		svc := service.NewScanPresetServiceImpl(repo)
+
+		err := svc.Save(entities.ScanPreset{Name: "", Args: map[string]any{}})
+		assert.ErrorIs(t, err, entities.ErrInvalidScanPreset)
+
+		err = svc.Save(entities.ScanPreset{Name: "deep", Args: map[string]any{"dependencies": 1}})
+		assert.ErrorIs(t, err, entities.ErrInvalidScanPreset)
+	})
+}
@@ -23,10 +23,91 @@
 
 package service
 
+import (
+	"slices"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/internal/archive"
+	"github.com/scanoss/scanoss.cc/internal/config"
+)
+
 type ScanService interface {
 	CheckDependencies() error
+	Diagnose() entities.ScannerDiagnostic
 	GetDefaultScanArgs() []string
 	Scan(args []string) error
 	ScanStream(args []string) error
+	ScanWithOptions(request entities.ScanRequest) error
 	AbortScan() error
 }
+
+// NewScanService returns the scan service for the scanner selected in the config.
+// scanHistoryService and postScanHookService may be nil when scan runs should not be recorded or followed by hooks.
+func NewScanService(scanossSettingsRepository repository.ScanossSettingsRepository, scanHistoryService ScanHistoryService, postScanHookService PostScanHookService) ScanService {
+	if config.GetInstance().GetScanner() == string(entities.ScannerNative) {
+		return NewScanServiceNativeImpl(scanossSettingsRepository, scanHistoryService, postScanHookService)
+	}
+	return NewScanServicePythonImpl(scanHistoryService, postScanHookService)
+}
+
+// recordScanRun adds a finished scan to the scan history. Failing to record never fails the scan itself.
+func recordScanRun(scanHistoryService ScanHistoryService, resultsPath string, args []string) {
+	if scanHistoryService == nil || resultsPath == "" {
+		return
+	}
+	if _, err := scanHistoryService.Record(resultsPath, args); err != nil {
+		log.Error().Err(err).Msg("Error recording scan run in the scan history")
+	}
+}
+
+// runPostScanHooks runs the post-scan hooks of the scanned folder, once scanComplete has been emitted.
+func runPostScanHooks(postScanHookService PostScanHookService, scanRoot, resultsPath string) {
+	if postScanHookService == nil {
+		return
+	}
+	postScanHookService.Run(scanRoot, resultsPath)
+}
+
+// scanRootFromArgs returns the folder scanned by args, or the configured scan root when only files were scanned.
+func scanRootFromArgs(args []string) string {
+	if path, _, err := entities.ParseScanArgs(args); err == nil && path != "" {
+		return path
+	}
+	return config.GetInstance().GetScanRoot()
+}
+
+// archiveScanArgs replaces an archive scanned by args with the folder it is extracted to, so the scanner reports
+// paths relative to the archive.
+func archiveScanArgs(args []string) ([]string, error) {
+	path, _, err := entities.ParseScanArgs(args)
+	if err != nil || path == "" || !archive.IsArchive(path) {
+		return args, nil
+	}
+
+	contentRoot, err := archive.ContentRoot(path, config.GetInstance().GetArchiveLimits())
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := slices.Clone(args)
+	for i, arg := range resolved {
+		if strings.TrimSpace(arg) == path {
+			resolved[i] = contentRoot
+			break
+		}
+	}
+	return resolved, nil
+}
+
+// scanRequestArgs validates a scan request, reporting invalid arguments as a failed scan.
+func scanRequestArgs(request entities.ScanRequest, emitEvent func(eventName string, data ...any)) ([]string, error) {
+	args, err := request.Args()
+	if err != nil {
+		emitEvent("scanFailed", err.Error())
+		return nil, err
+	}
+	return args, nil
+}
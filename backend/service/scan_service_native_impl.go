@@ -0,0 +1,674 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/internal/archive"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/fetch"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/scanoss/scanoss.cc/internal/wfp"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+const scanDirectEndpoint = "/scan/direct"
+
+// nativeScanArgs are the scanoss-py arguments the native scanner understands. Other arguments are only accepted
+// when left at their default value.
+var nativeScanArgs = map[string]bool{
+	"files":              true,
+	"output":             true,
+	"format":             true,
+	"threads":            true,
+	"flags":              true,
+	"post-size":          true,
+	"timeout":            true,
+	"retry":              true,
+	"settings":           true,
+	"skip-settings-file": true,
+	"debug":              true,
+	"trace":              true,
+	"quiet":              true,
+}
+
+type nativeScanOptions struct {
+	path       string
+	files      []string
+	output     string
+	threads    int
+	flags      int
+	postSizeKB int
+	timeout    time.Duration
+	retry      int
+	quiet      bool
+}
+
+// ScanServiceNativeImpl scans without scanoss-py: it walks the scan root, fingerprints files as WFP, posts them
+// to the SCANOSS API in size limited batches and writes a results.json with the same shape as scanoss-py.
+type ScanServiceNativeImpl struct {
+	ctx                       context.Context
+	client                    HTTPClient
+	retryPolicy               fetch.Policy
+	scanossSettingsRepository repository.ScanossSettingsRepository
+	cancelLock                sync.Mutex
+	cancelFunc                context.CancelFunc
+	history                   ScanHistoryService
+	hooks                     PostScanHookService
+}
+
+func NewScanServiceNativeImpl(scanossSettingsRepository repository.ScanossSettingsRepository, scanHistoryService ScanHistoryService, postScanHookService PostScanHookService) *ScanServiceNativeImpl {
+	// Batches are retried by postWithRetry as many times as --retry asks, the client only adds circuit breaking
+	policy := fetch.PolicyFromConfig()
+	clientPolicy := policy
+	clientPolicy.MaxRetries = 0
+
+	return &ScanServiceNativeImpl{
+		client:                    fetch.NewClient(&http.Client{}, clientPolicy),
+		retryPolicy:               policy,
+		scanossSettingsRepository: scanossSettingsRepository,
+		history:                   scanHistoryService,
+		hooks:                     postScanHookService,
+	}
+}
+
+func (s *ScanServiceNativeImpl) SetContext(ctx context.Context) {
+	s.ctx = ctx
+}
+
+// CheckDependencies only needs an API URL, the native scanner has no external tools.
+func (s *ScanServiceNativeImpl) CheckDependencies() error {
+	if config.GetInstance().GetApiUrl() == "" {
+		return fmt.Errorf("no SCANOSS API URL configured")
+	}
+	return nil
+}
+
+// Diagnose reports this executable, since the native scanner is built into the app.
+func (s *ScanServiceNativeImpl) Diagnose() entities.ScannerDiagnostic {
+	diagnostic := entities.ScannerDiagnostic{
+		Scanner: entities.ScannerNative,
+		Source:  entities.ScannerSourceBuiltIn,
+		Version: entities.AppVersion,
+	}
+	binary, err := os.Executable()
+	if err != nil {
+		diagnostic.Error = err.Error()
+	}
+	diagnostic.Binary = binary
+	if err := s.CheckDependencies(); err != nil {
+		diagnostic.Error = err.Error()
+	}
+	return diagnostic
+}
+
+func (s *ScanServiceNativeImpl) GetDefaultScanArgs() []string {
+	args := []string{}
+	cfg := config.GetInstance()
+
+	if cfg.GetResultFilePath() != "" {
+		args = append(args, "--output", cfg.GetResultFilePath())
+	}
+
+	if cfg.GetScanSettingsFilePath() != "" {
+		args = append(args, "--settings", cfg.GetScanSettingsFilePath())
+	}
+
+	return args
+}
+
+func (s *ScanServiceNativeImpl) GetScanArgs() []entities.ScanArgDef {
+	return entities.ScanArguments
+}
+
+// Scan runs a scan from the command line, printing progress unless --quiet is set.
+func (s *ScanServiceNativeImpl) Scan(args []string) error {
+	opts, err := s.parseScanArgs(args)
+	if err != nil {
+		return err
+	}
+
+	return s.scan(context.Background(), opts, func(line string) {
+		if !opts.quiet {
+			fmt.Fprintln(os.Stderr, line)
+		}
+	}, entities.NewScanProgressTracker(nil))
+}
+
+// ScanStream runs a scan from the UI, reporting progress with the same events as the scanoss-py scanner plus
+// structured scanProgress events.
+func (s *ScanServiceNativeImpl) ScanStream(args []string) error {
+	opts, err := s.parseScanArgs(args)
+	if err != nil {
+		s.emitEvent("scanFailed", err.Error())
+		return err
+	}
+
+	scanCtx, cancel := context.WithCancel(context.Background())
+	s.cancelLock.Lock()
+	s.cancelFunc = cancel
+	s.cancelLock.Unlock()
+
+	defer func() {
+		cancel()
+		s.cancelLock.Lock()
+		s.cancelFunc = nil
+		s.cancelLock.Unlock()
+	}()
+
+	tracker := entities.NewScanProgressTracker(func(progress entities.ScanProgress) {
+		s.emitEvent(entities.ScanProgressEvent, progress)
+	})
+	err = s.scan(scanCtx, opts, func(line string) {
+		s.emitEvent("commandOutput", line)
+	}, tracker)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			s.emitEvent("scanAborted", "Scan was aborted")
+			return nil
+		}
+		s.emitEvent("commandError", err.Error())
+		s.emitEvent("scanFailed", err.Error())
+		return err
+	}
+
+	if len(args) == 0 {
+		args = append([]string{"."}, s.GetDefaultScanArgs()...)
+	}
+	recordScanRun(s.history, opts.output, args)
+
+	s.emitEvent("scanComplete", nil)
+	s.emitEvent("commandOutput", "Scan completed successfully!")
+
+	scanRoot := opts.path
+	if scanRoot == "" {
+		scanRoot = config.GetInstance().GetScanRoot()
+	}
+	runPostScanHooks(s.hooks, scanRoot, opts.output)
+	return nil
+}
+
+// ScanWithOptions validates a scan described with typed options, then runs it like ScanStream.
+func (s *ScanServiceNativeImpl) ScanWithOptions(request entities.ScanRequest) error {
+	args, err := scanRequestArgs(request, s.emitEvent)
+	if err != nil {
+		return err
+	}
+	return s.ScanStream(args)
+}
+
+func (s *ScanServiceNativeImpl) AbortScan() error {
+	s.cancelLock.Lock()
+	defer s.cancelLock.Unlock()
+
+	if s.cancelFunc != nil {
+		s.cancelFunc()
+		s.cancelFunc = nil
+		s.emitEvent("commandOutput", "Scan aborted by user")
+	}
+
+	return nil
+}
+
+func (s *ScanServiceNativeImpl) parseScanArgs(args []string) (nativeScanOptions, error) {
+	opts := nativeScanOptions{
+		threads:    5,
+		postSizeKB: 32,
+		timeout:    180 * time.Second,
+		retry:      5,
+	}
+
+	if len(args) == 0 {
+		args = append([]string{"."}, s.GetDefaultScanArgs()...)
+	}
+
+	argDefs := make(map[string]entities.ScanArgDef, len(entities.ScanArguments))
+	for _, def := range entities.ScanArguments {
+		argDefs[def.Name] = def
+	}
+
+	// Like scanoss-py, --files takes every value up to the next argument starting with a dash
+	inFiles := false
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			if inFiles {
+				if err := opts.set("files", arg); err != nil {
+					return opts, err
+				}
+				continue
+			}
+			if opts.path != "" {
+				return opts, fmt.Errorf("only one folder can be scanned, got %q and %q", opts.path, arg)
+			}
+			opts.path = arg
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		inFiles = name == "files" && !hasValue
+		def, ok := argDefs[name]
+		if !ok {
+			return opts, fmt.Errorf("unknown scan argument --%s", name)
+		}
+
+		isFlag := def.Type == "bool" || def.Type == ""
+		if !isFlag && !hasValue {
+			if i+1 >= len(args) {
+				return opts, fmt.Errorf("missing value for --%s", name)
+			}
+			i++
+			value = args[i]
+		}
+
+		if !nativeScanArgs[name] {
+			// The scan dialog always sends numeric options, so defaults are ignored rather than rejected
+			if !isFlag && value == fmt.Sprint(def.Default) {
+				continue
+			}
+			return opts, fmt.Errorf("--%s is not supported by the native scanner", name)
+		}
+
+		if err := opts.set(name, value); err != nil {
+			return opts, err
+		}
+	}
+
+	if opts.path == "" && len(opts.files) == 0 {
+		opts.path = "."
+	}
+
+	return opts, nil
+}
+
+func (o *nativeScanOptions) set(name, value string) error {
+	intValue := func() (int, error) {
+		v, err := strconv.Atoi(value)
+		if err != nil || v < 0 {
+			return 0, fmt.Errorf("invalid value %q for --%s", value, name)
+		}
+		return v, nil
+	}
+
+	var err error
+	switch name {
+	case "files":
+		for _, file := range strings.Split(value, ",") {
+			if file = strings.TrimSpace(file); file != "" {
+				o.files = append(o.files, file)
+			}
+		}
+	case "output":
+		o.output = value
+	case "format":
+		if value != "plain" && value != "json" {
+			return fmt.Errorf("the native scanner only writes plain results, got --format %s", value)
+		}
+	case "threads":
+		o.threads, err = intValue()
+		o.threads = max(o.threads, 1)
+	case "flags":
+		o.flags, err = intValue()
+	case "post-size":
+		o.postSizeKB, err = intValue()
+		o.postSizeKB = max(o.postSizeKB, 1)
+	case "timeout":
+		var seconds int
+		seconds, err = intValue()
+		o.timeout = time.Duration(seconds) * time.Second
+	case "retry":
+		o.retry, err = intValue()
+	case "quiet":
+		o.quiet = true
+	}
+
+	return err
+}
+
+func (s *ScanServiceNativeImpl) scan(ctx context.Context, opts nativeScanOptions, progress func(string), tracker *entities.ScanProgressTracker) error {
+	cfg := config.GetInstance()
+	apiURL := strings.TrimSuffix(cfg.GetApiUrl(), "/")
+	if apiURL == "" {
+		return fmt.Errorf("no SCANOSS API URL configured")
+	}
+
+	files, err := s.collectFiles(opts)
+	if err != nil {
+		return err
+	}
+	tracker.SetFilesFound(len(files))
+	tracker.SetPhase(entities.ScanPhaseFingerprinting)
+	progress(fmt.Sprintf("Fingerprinting %d files...", len(files)))
+
+	batches, err := fingerprintBatches(ctx, files, opts.postSizeKB*1024, tracker)
+	if err != nil {
+		return err
+	}
+	tracker.SetBatchesTotal(len(batches))
+	tracker.SetPhase(entities.ScanPhaseScanning)
+	progress(fmt.Sprintf("Posting %d requests to %s using %d threads", len(batches), apiURL, opts.threads))
+
+	results, err := s.postBatches(ctx, apiURL+scanDirectEndpoint, cfg.GetApiToken(), batches, opts, progress, tracker)
+	if err != nil {
+		return err
+	}
+
+	tracker.SetPhase(entities.ScanPhaseWriting)
+	if opts.output == "" {
+		out, err := utils.JSONSerialize(results)
+		if err != nil {
+			return err
+		}
+		if _, err := os.Stdout.Write(out); err != nil {
+			return err
+		}
+		tracker.SetPhase(entities.ScanPhaseDone)
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(opts.output), 0o755); err != nil {
+		return fmt.Errorf("error creating output folder: %w", err)
+	}
+	if err := utils.WriteJsonFile(opts.output, results); err != nil {
+		return fmt.Errorf("error writing results: %w", err)
+	}
+	tracker.SetPhase(entities.ScanPhaseDone)
+	progress(fmt.Sprintf("Results written to %s", opts.output))
+
+	return nil
+}
+
+// scanFile is a file to fingerprint, with the path reported to the API.
+type scanFile struct {
+	path       string
+	reportPath string
+}
+
+// collectFiles lists the files to scan. Folders are walked skipping hidden entries and the effective scanning
+// skip patterns, explicit --files are always scanned.
+func (s *ScanServiceNativeImpl) collectFiles(opts nativeScanOptions) ([]scanFile, error) {
+	var files []scanFile
+	for _, file := range opts.files {
+		files = append(files, scanFile{path: file, reportPath: filepath.ToSlash(file)})
+	}
+	if opts.path == "" {
+		return files, nil
+	}
+
+	// An archive is scanned from its extracted contents, reporting paths relative to the archive
+	root, err := archive.ContentRoot(opts.path, config.GetInstance().GetArchiveLimits())
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := listScanFolder(root, s.scanossSettingsRepository)
+	if err != nil {
+		return nil, err
+	}
+	for _, rel := range paths {
+		files = append(files, scanFile{path: filepath.Join(root, filepath.FromSlash(rel)), reportPath: rel})
+	}
+
+	return files, nil
+}
+
+// fingerprintBatches groups WFP blocks so each request stays under maxPostSize bytes. A file whose
+// fingerprint is larger than the limit is posted on its own.
+func fingerprintBatches(ctx context.Context, files []scanFile, maxPostSize int, tracker *entities.ScanProgressTracker) ([]string, error) {
+	var batches []string
+	var current strings.Builder
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		contents, err := os.ReadFile(file.path)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping unreadable file %s", file.path)
+			continue
+		}
+		if len(contents) == 0 {
+			continue
+		}
+
+		block := wfp.Fingerprint(file.reportPath, contents)
+		tracker.FileFingerprinted()
+		if current.Len() > 0 && current.Len()+len(block) > maxPostSize {
+			batches = append(batches, current.String())
+			current.Reset()
+		}
+		current.WriteString(block)
+	}
+
+	if current.Len() > 0 {
+		batches = append(batches, current.String())
+	}
+
+	return batches, nil
+}
+
+func (s *ScanServiceNativeImpl) postBatches(ctx context.Context, url, apiKey string, batches []string, opts nativeScanOptions, progress func(string), tracker *entities.ScanProgressTracker) (map[string]json.RawMessage, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		done     int
+		results  = make(map[string]json.RawMessage)
+		queue    = make(chan int)
+	)
+
+	for range min(opts.threads, max(len(batches), 1)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range queue {
+				tracker.BatchSent()
+				response, err := s.postWithRetry(ctx, url, apiKey, batches[index], opts, func(attempt int, err error) {
+					tracker.BatchFailed(index+1, attempt, err.Error())
+				})
+
+				mu.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+				} else {
+					for path, matches := range response {
+						results[path] = matches
+					}
+					done++
+					tracker.BatchCompleted()
+					progress(fmt.Sprintf("Scanned request %d of %d", done, len(batches)))
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for index := range batches {
+		select {
+		case queue <- index:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// scanRequestError is a failed scan request, with how long the API asked to wait before sending it again.
+type scanRequestError struct {
+	message    string
+	retryAfter time.Duration
+}
+
+func (e *scanRequestError) Error() string {
+	return e.message
+}
+
+// postWithRetry posts one batch, retrying failures worth retrying with the backoff of the HTTP settings.
+// onFailure is called with the 1-based attempt number of every failed attempt.
+func (s *ScanServiceNativeImpl) postWithRetry(ctx context.Context, url, apiKey, batch string, opts nativeScanOptions, onFailure func(attempt int, err error)) (map[string]json.RawMessage, error) {
+	var lastErr error
+	for attempt := 0; attempt <= opts.retry; attempt++ {
+		if attempt > 0 {
+			var retryAfter time.Duration
+			var requestErr *scanRequestError
+			if errors.As(lastErr, &requestErr) {
+				retryAfter = requestErr.retryAfter
+			}
+			delay, ok := s.retryPolicy.RetryDelay(attempt-1, retryAfter)
+			if !ok {
+				return nil, lastErr
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+
+		response, retryable, err := s.post(ctx, url, apiKey, batch, opts)
+		if err == nil {
+			return response, nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		onFailure(attempt+1, err)
+		if !retryable {
+			return nil, err
+		}
+
+		lastErr = err
+		log.Debug().Err(err).Msgf("Scan request failed, attempt %d of %d", attempt+1, opts.retry+1)
+	}
+
+	return nil, fmt.Errorf("scan request failed after %d attempts: %w", opts.retry+1, lastErr)
+}
+
+// post sends one WFP batch. The returned bool reports whether a failure is worth retrying.
+func (s *ScanServiceNativeImpl) post(ctx context.Context, url, apiKey, batch string, opts nativeScanOptions) (map[string]json.RawMessage, bool, error) {
+	requestID := uuid.NewString()
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	_ = form.WriteField("type", "identify")
+	_ = form.WriteField("format", "plain")
+	if opts.flags > 0 {
+		_ = form.WriteField("flags", strconv.Itoa(opts.flags))
+	}
+	part, err := form.CreateFormFile("file", requestID+".wfp")
+	if err != nil {
+		return nil, false, err
+	}
+	if _, err := io.WriteString(part, batch); err != nil {
+		return nil, false, err
+	}
+	if err := form.Close(); err != nil {
+		return nil, false, err
+	}
+
+	requestCtx, cancel := context.WithTimeout(ctx, opts.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, url, &body)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.Header.Set("User-Agent", "scanoss-cc/"+entities.AppVersion)
+	req.Header.Set("x-request-id", requestID)
+	if apiKey != "" {
+		req.Header.Set("x-api-key", apiKey)
+		req.Header.Set("X-Session", apiKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, !errors.Is(err, fetch.ErrCircuitOpen), fmt.Errorf("scan request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, fmt.Errorf("error reading scan response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+		return nil, retryable, &scanRequestError{
+			message:    fmt.Sprintf("scan request %s failed with status %d: %s", requestID, resp.StatusCode, utils.RedactSecret(strings.TrimSpace(string(data)), apiKey)),
+			retryAfter: fetch.RetryAfter(resp),
+		}
+	}
+
+	var results map[string]json.RawMessage
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, false, fmt.Errorf("error parsing scan response: %w", err)
+	}
+
+	return results, false, nil
+}
+
+func (s *ScanServiceNativeImpl) emitEvent(eventName string, data ...any) {
+	if s.ctx != nil {
+		runtime.EventsEmit(s.ctx, eventName, data...)
+	}
+}
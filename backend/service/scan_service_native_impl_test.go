@@ -0,0 +1,316 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service_test
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	repoMocks "github.com/scanoss/scanoss.cc/backend/repository/mocks"
+	"github.com/scanoss/scanoss.cc/backend/service"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/archive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var wfpFileLine = regexp.MustCompile(`(?m)^file=[0-9a-f]{32},\d+,(.+)$`)
+
+// scanAPIStandIn answers /scan/direct like the SCANOSS API, with one "none" match per fingerprinted file.
+type scanAPIStandIn struct {
+	mu        sync.Mutex
+	requests  int
+	files     []string
+	apiKeys   []string
+	failFirst int
+}
+
+func (s *scanAPIStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.URL.Path != "/scan/direct" || r.Method != http.MethodPost {
+		http.NotFound(w, r)
+		return
+	}
+	if s.failFirst > 0 {
+		s.failFirst--
+		http.Error(w, "busy", http.StatusServiceUnavailable)
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	wfp, _ := io.ReadAll(file)
+
+	s.requests++
+	s.apiKeys = append(s.apiKeys, r.Header.Get("x-api-key"))
+
+	response := map[string][]map[string]string{}
+	for _, match := range wfpFileLine.FindAllStringSubmatch(string(wfp), -1) {
+		s.files = append(s.files, match[1])
+		response[match[1]] = []map[string]string{{"id": "none"}}
+	}
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func writeScanFixture(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for path, contents := range files {
+		full := filepath.Join(root, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0o755))
+		require.NoError(t, os.WriteFile(full, []byte(contents), 0o644))
+	}
+}
+
+func TestScanServiceNative_Scan(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	api := &scanAPIStandIn{failFirst: 1}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	internal_test.UseAPI(t, server.URL, "test-key")
+
+	root := t.TempDir()
+	var source strings.Builder
+	for i := range 200 {
+		fmt.Fprintf(&source, "static int compute_%d(int value) { return value * %d + %d; }\n", i, i*31, i*7)
+	}
+	writeScanFixture(t, root, map[string]string{
+		"src/main.c":                source.String(),
+		"src/util.c":                source.String() + "// util\n",
+		"src/lib/helper.c":          source.String() + "// helper\n",
+		"node_modules/dep/index.js": source.String(),
+		"build.log":                 "log",
+		".git/config":               "[core]",
+		"empty.txt":                 "",
+	})
+
+	settingsRepo := repoMocks.NewMockScanossSettingsRepository(t)
+	settingsRepo.EXPECT().GetEffectiveScanningSkipPatterns().Return([]string{"node_modules/", "*.log"})
+
+	output := filepath.Join(root, ".scanoss", "results.json")
+	svc := service.NewScanServiceNativeImpl(settingsRepo, nil, nil)
+	err := svc.Scan([]string{"--quiet", root, "--output", output, "--post-size", "1", "--threads", "2", "--retry", "2", "--sc-timeout", "600"})
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"src/main.c", "src/util.c", "src/lib/helper.c"}, api.files)
+	assert.Equal(t, 3, api.requests, "every fingerprint is larger than the post size, so each file is its own request")
+	for _, key := range api.apiKeys {
+		assert.Equal(t, "test-key", key)
+	}
+
+	data, err := os.ReadFile(output)
+	require.NoError(t, err)
+
+	var results map[string][]map[string]string
+	require.NoError(t, json.Unmarshal(data, &results))
+	assert.Len(t, results, 3)
+	assert.Equal(t, "none", results["src/lib/helper.c"][0]["id"])
+}
+
+func TestScanServiceNative_Archive(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+	defer archive.Cleanup()
+
+	api := &scanAPIStandIn{}
+	server := httptest.NewServer(api)
+	defer server.Close()
+	internal_test.UseAPI(t, server.URL, "")
+
+	var source strings.Builder
+	for i := range 50 {
+		fmt.Fprintf(&source, "static int compute_%d(int value) { return value * %d; }\n", i, i*31)
+	}
+	archivePath := filepath.Join(t.TempDir(), "drop-1.0.zip")
+	out, err := os.Create(archivePath)
+	require.NoError(t, err)
+	writer := zip.NewWriter(out)
+	for _, name := range []string{"drop-1.0/src/main.c", "drop-1.0/src/util.c"} {
+		entry, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = entry.Write([]byte(source.String() + "// " + name + "\n"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	require.NoError(t, out.Close())
+
+	settingsRepo := repoMocks.NewMockScanossSettingsRepository(t)
+	settingsRepo.EXPECT().GetEffectiveScanningSkipPatterns().Return(nil)
+
+	output := filepath.Join(t.TempDir(), "results.json")
+	svc := service.NewScanServiceNativeImpl(settingsRepo, nil, nil)
+	require.NoError(t, svc.Scan([]string{"--quiet", archivePath, "--output", output}))
+
+	assert.ElementsMatch(t, []string{"drop-1.0/src/main.c", "drop-1.0/src/util.c"}, api.files, "paths are relative to the archive")
+}
+
+func TestScanServiceNative_Args(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	svc := service.NewScanServiceNativeImpl(nil, nil, nil)
+
+	tests := []struct {
+		args    []string
+		message string
+	}{
+		{[]string{".", "--dependencies"}, "--dependencies is not supported by the native scanner"},
+		{[]string{".", "--wfp", "files.wfp"}, "--wfp is not supported by the native scanner"},
+		{[]string{".", "--threads", "many"}, `invalid value "many" for --threads`},
+		{[]string{".", "--format", "cyclonedx"}, "only writes plain results"},
+		{[]string{".", "other"}, "only one folder can be scanned"},
+		{[]string{".", "--output"}, "missing value for --output"},
+		{[]string{".", "--unknown"}, "unknown scan argument --unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.args), func(t *testing.T) {
+			err := svc.Scan(tt.args)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.message)
+		})
+	}
+}
+
+func TestScanServiceNative_FilesList(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	api := &scanAPIStandIn{}
+	server := httptest.NewServer(api)
+	defer server.Close()
+	internal_test.UseAPI(t, server.URL, "")
+
+	root := t.TempDir()
+	writeScanFixture(t, root, map[string]string{
+		"a.c": strings.Repeat("int a = 1;\n", 50),
+		"b.c": strings.Repeat("int b = 2;\n", 50),
+		"c.c": strings.Repeat("int c = 3;\n", 50),
+	})
+	a, b, c := filepath.Join(root, "a.c"), filepath.Join(root, "b.c"), filepath.Join(root, "c.c")
+
+	svc := service.NewScanServiceNativeImpl(nil, nil, nil)
+	err := svc.Scan([]string{"--quiet", "--output", filepath.Join(root, "results.json"), "--files", a, b, "--files=" + c})
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{filepath.ToSlash(a), filepath.ToSlash(b), filepath.ToSlash(c)}, api.files)
+}
+
+func TestScanServiceNative_APIError(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid key", http.StatusUnauthorized)
+	}))
+	defer server.Close()
+	internal_test.UseAPI(t, server.URL, "")
+
+	root := t.TempDir()
+	writeScanFixture(t, root, map[string]string{"main.c": strings.Repeat("int x = 1;\n", 50)})
+
+	svc := service.NewScanServiceNativeImpl(nil, nil, nil)
+	err := svc.Scan([]string{root, "--quiet", "--output", filepath.Join(root, "results.json")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 401")
+}
+
+func TestScanServiceNative_APIErrorRedactsKey(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid key "+r.Header.Get("x-api-key"), http.StatusUnauthorized)
+	}))
+	defer server.Close()
+	internal_test.UseAPI(t, server.URL, "secret-key")
+
+	root := t.TempDir()
+	writeScanFixture(t, root, map[string]string{"main.c": strings.Repeat("int x = 1;\n", 50)})
+
+	svc := service.NewScanServiceNativeImpl(nil, nil, nil)
+	err := svc.Scan([]string{root, "--quiet", "--output", filepath.Join(root, "results.json")})
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret-key")
+	assert.Contains(t, err.Error(), "invalid key ****")
+}
+
+func TestScanServiceNative_ScanWithOptions(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	api := &scanAPIStandIn{}
+	server := httptest.NewServer(api)
+	defer server.Close()
+	internal_test.UseAPI(t, server.URL, "")
+
+	root := t.TempDir()
+	writeScanFixture(t, root, map[string]string{"main.c": strings.Repeat("int x = 1;\n", 50)})
+	output := filepath.Join(root, ".scanoss", "results.json")
+
+	settingsRepo := repoMocks.NewMockScanossSettingsRepository(t)
+	settingsRepo.EXPECT().GetEffectiveScanningSkipPatterns().Return([]string{}).Maybe()
+	svc := service.NewScanServiceNativeImpl(settingsRepo, nil, nil)
+
+	t.Run("Invalid options are rejected before scanning", func(t *testing.T) {
+		err := svc.ScanWithOptions(entities.ScanRequest{
+			Path:    root,
+			Options: map[string]any{"threads": float64(0), "output": output},
+		})
+		assert.ErrorIs(t, err, entities.ErrInvalidScanArgs)
+		assert.Zero(t, api.requests)
+		assert.NoFileExists(t, output)
+	})
+
+	t.Run("Valid options are scanned", func(t *testing.T) {
+		err := svc.ScanWithOptions(entities.ScanRequest{
+			Path:      root,
+			Options:   map[string]any{"threads": float64(2), "output": output, "debug": false},
+			ExtraArgs: []string{"--retry", "1"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"main.c"}, api.files)
+		assert.FileExists(t, output)
+	})
+}
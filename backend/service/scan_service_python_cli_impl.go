@@ -31,28 +31,35 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/scanoss/scanoss.cc/backend/entities"
 	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 )
 
+// scannerApiKeyEnv is read by scanoss-py when no --key argument is given
+const scannerApiKeyEnv = "SCANOSS_API_KEY"
+
 type ScanServicePythonImpl struct {
-	cmd        string
 	ctx        context.Context
 	currentCmd *exec.Cmd
 	cmdLock    sync.Mutex
 	cancelFunc context.CancelFunc
+	history    ScanHistoryService
+	hooks      PostScanHookService
 }
 
-func NewScanServicePythonImpl() *ScanServicePythonImpl {
+func NewScanServicePythonImpl(scanHistoryService ScanHistoryService, postScanHookService PostScanHookService) *ScanServicePythonImpl {
 	return &ScanServicePythonImpl{
-		cmd:        "scanoss-py",
 		currentCmd: nil,
 		cancelFunc: nil,
+		history:    scanHistoryService,
+		hooks:      postScanHookService,
 	}
 }
 
@@ -61,9 +68,19 @@ func (s *ScanServicePythonImpl) SetContext(ctx context.Context) {
 }
 
 func (s *ScanServicePythonImpl) Scan(args []string) error {
+	location, err := locateScanossPy()
+	if err != nil {
+		return err
+	}
+
+	args, err = archiveScanArgs(args)
+	if err != nil {
+		return err
+	}
 	cmdArgs := append([]string{"scan"}, args...)
 
-	cmd := exec.Command(s.cmd, cmdArgs...)
+	cmd := exec.Command(location.binary, cmdArgs...)
+	cmd.Env = append(os.Environ(), scannerExtraEnv()...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 
@@ -84,7 +101,13 @@ func (s *ScanServicePythonImpl) ScanStream(args []string) error {
 		s.cmdLock.Unlock()
 	}()
 
-	cmd, stdout, stderr, err := s.executeScanWithPipes(args, scanCtx)
+	scanArgs, err := archiveScanArgs(args)
+	if err != nil {
+		s.emitEvent("scanFailed", err.Error())
+		return err
+	}
+
+	cmd, stdout, stderr, err := s.executeScanWithPipes(scanArgs, scanCtx)
 	if err != nil {
 		return err
 	}
@@ -103,8 +126,12 @@ func (s *ScanServicePythonImpl) ScanStream(args []string) error {
 	go s.processStreamOutput(scanCtx, stdout, stdoutChan)
 	go s.processStreamOutput(scanCtx, stderr, stderrChan)
 
-	go s.emitOutputEvents(outputCtx, stdoutChan, "commandOutput")
-	go s.emitOutputEvents(outputCtx, stderrChan, "commandError")
+	tracker := entities.NewScanProgressTracker(func(progress entities.ScanProgress) {
+		s.emitEvent(entities.ScanProgressEvent, progress)
+	})
+
+	go s.emitOutputEvents(outputCtx, stdoutChan, "commandOutput", tracker)
+	go s.emitOutputEvents(outputCtx, stderrChan, "commandError", tracker)
 
 	go func() {
 		done <- cmd.Wait()
@@ -131,8 +158,16 @@ func (s *ScanServicePythonImpl) ScanStream(args []string) error {
 			return err
 		}
 
+		if len(args) == 0 {
+			args = append([]string{"."}, s.GetDefaultScanArgs()...)
+		}
+		recordScanRun(s.history, s.getOutputPathFromArgs(args), args)
+
+		tracker.SetPhase(entities.ScanPhaseDone)
 		s.emitEvent("scanComplete", nil)
 		s.emitEvent("commandOutput", "Scan completed successfully!")
+
+		runPostScanHooks(s.hooks, scanRootFromArgs(args), s.getOutputPathFromArgs(args))
 		return nil
 
 	case <-scanCtx.Done():
@@ -148,6 +183,15 @@ func (s *ScanServicePythonImpl) ScanStream(args []string) error {
 	}
 }
 
+// ScanWithOptions validates a scan described with typed options, then runs it like ScanStream.
+func (s *ScanServicePythonImpl) ScanWithOptions(request entities.ScanRequest) error {
+	args, err := scanRequestArgs(request, s.emitEvent)
+	if err != nil {
+		return err
+	}
+	return s.ScanStream(args)
+}
+
 func (s *ScanServicePythonImpl) AbortScan() error {
 	s.cmdLock.Lock()
 	defer s.cmdLock.Unlock()
@@ -186,7 +230,7 @@ func (s *ScanServicePythonImpl) executeScanWithPipes(args []string, scanCtx cont
 
 	cmdArgs := []string{"scan"}
 
-	defaultArgs, sensitiveArgs := s.GetDefaultScanArgs(), s.GetSensitiveDefaultScanArgs()
+	defaultArgs := s.GetDefaultScanArgs()
 
 	if len(args) == 0 {
 		cmdArgs = append(cmdArgs, ".") // scan current directory by default
@@ -195,17 +239,18 @@ func (s *ScanServicePythonImpl) executeScanWithPipes(args []string, scanCtx cont
 		cmdArgs = append(cmdArgs, args...)
 	}
 
-	cmdArgs = append(cmdArgs, sensitiveArgs...)
+	cmdArgs = append(cmdArgs, s.apiScanArgs()...)
 
 	// If the output folder does not exist, create it. This should be handled by the python cli
 	s.maybeCreateOutputFolder(args)
 
-	// This is to prevent we don't see anything on screen while scanning small directories
-	env := os.Environ()
-	env = append(env, "PYTHONUNBUFFERED=1")
+	location, err := locateScanossPy()
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
-	cmd := exec.CommandContext(scanCtx, s.cmd, cmdArgs...)
-	cmd.Env = env
+	cmd := exec.CommandContext(scanCtx, location.binary, cmdArgs...)
+	cmd.Env = s.scannerEnv()
 
 	stdout, err := cmd.StdoutPipe()
 	if err != nil {
@@ -236,7 +281,8 @@ func (s *ScanServicePythonImpl) processStreamOutput(ctx context.Context, reader
 	}
 }
 
-func (s *ScanServicePythonImpl) emitOutputEvents(ctx context.Context, input <-chan string, eventName string) {
+// emitOutputEvents forwards raw output lines to the UI and turns the ones it recognises into progress updates.
+func (s *ScanServicePythonImpl) emitOutputEvents(ctx context.Context, input <-chan string, eventName string, tracker *entities.ScanProgressTracker) {
 	for {
 		select {
 		case <-ctx.Done():
@@ -245,23 +291,57 @@ func (s *ScanServicePythonImpl) emitOutputEvents(ctx context.Context, input <-ch
 			if !ok {
 				return
 			}
+			text = utils.RedactSecret(text, config.GetInstance().GetApiToken())
 			s.emitEvent(eventName, text)
+			entities.ParseScanOutputLine(tracker, text)
 		}
 	}
 }
 
 func (s *ScanServicePythonImpl) CheckDependencies() error {
-	if err := s.checkPythonInstalled(); err != nil {
+	location, err := locateScanossPy()
+	if err != nil {
+		// A missing Python install explains the missing scanner better
+		if pythonErr := s.checkPythonInstalled(); pythonErr != nil {
+			return pythonErr
+		}
 		return err
 	}
 
-	if err := s.checkScanossPyInstalled(); err != nil {
+	if _, err := s.scanossPyVersion(location.binary); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// Diagnose reports the scanoss-py executable, version and Python interpreter a scan will use.
+func (s *ScanServicePythonImpl) Diagnose() entities.ScannerDiagnostic {
+	diagnostic := entities.ScannerDiagnostic{Scanner: entities.ScannerPython}
+	for _, entry := range scannerExtraEnv() {
+		name, _, _ := strings.Cut(entry, "=")
+		diagnostic.EnvNames = append(diagnostic.EnvNames, name)
+	}
+
+	location, err := locateScanossPy()
+	if err != nil {
+		diagnostic.Error = err.Error()
+		return diagnostic
+	}
+	diagnostic.Binary = location.binary
+	diagnostic.Source = location.source
+	diagnostic.Python = scriptInterpreter(location.binary)
+
+	version, err := s.scanossPyVersion(location.binary)
+	if err != nil {
+		diagnostic.Error = err.Error()
+		return diagnostic
+	}
+	diagnostic.Version = version
+
+	return diagnostic
+}
+
 func (s *ScanServicePythonImpl) checkPythonInstalled() error {
 	pythonCommands := []string{"python3", "python"}
 
@@ -289,12 +369,16 @@ func (s *ScanServicePythonImpl) checkPythonInstalled() error {
 	return fmt.Errorf("python is not installed or not found in PATH or common locations")
 }
 
-func (s *ScanServicePythonImpl) checkScanossPyInstalled() error {
-	cmd := exec.Command(s.cmd, "--version")
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("scanoss-py is not installed or not in PATH: %w", err)
+// scanossPyVersion runs scanoss-py --version, which also proves the executable and its Python install work.
+func (s *ScanServicePythonImpl) scanossPyVersion(binary string) (string, error) {
+	cmd := exec.Command(binary, "--version")
+	cmd.Env = append(os.Environ(), scannerExtraEnv()...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("%s failed to run: %w", binary, err)
 	}
-	return nil
+	version, _, _ := strings.Cut(strings.TrimSpace(string(output)), "\n")
+	return strings.TrimSpace(version), nil
 }
 
 func (s *ScanServicePythonImpl) GetDefaultScanArgs() []string {
@@ -312,14 +396,11 @@ func (s *ScanServicePythonImpl) GetDefaultScanArgs() []string {
 	return args
 }
 
-func (s *ScanServicePythonImpl) GetSensitiveDefaultScanArgs() []string {
+// apiScanArgs points scanoss-py at the configured API. The API key is not an argument, see scannerEnv.
+func (s *ScanServicePythonImpl) apiScanArgs() []string {
 	args := make([]string, 0)
 	cfg := config.GetInstance()
 
-	if cfg.GetApiToken() != "" {
-		args = append(args, "--key", cfg.GetApiToken())
-	}
-
 	if cfg.GetApiUrl() != "" {
 		args = append(args, "--apiurl", fmt.Sprintf("%s/scan/direct", cfg.GetApiUrl()))
 	}
@@ -327,6 +408,20 @@ func (s *ScanServicePythonImpl) GetSensitiveDefaultScanArgs() []string {
 	return args
 }
 
+// scannerEnv returns the environment for scanoss-py, ending with the scanner.env setting. The API key is passed
+// as SCANOSS_API_KEY so it never shows up in the process list, where the command line of every process is
+// visible to all users.
+func (s *ScanServicePythonImpl) scannerEnv() []string {
+	// This is to prevent we don't see anything on screen while scanning small directories
+	env := append(os.Environ(), "PYTHONUNBUFFERED=1")
+
+	if token := config.GetInstance().GetApiToken(); token != "" {
+		env = append(env, scannerApiKeyEnv+"="+token)
+	}
+
+	return append(env, scannerExtraEnv()...)
+}
+
 func (s *ScanServicePythonImpl) emitEvent(eventName string, data ...any) {
 	if s.ctx != nil {
 		runtime.EventsEmit(s.ctx, eventName, data...)
@@ -26,16 +26,33 @@
 package service_test
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/scanoss/scanoss.cc/backend/entities"
 	"github.com/scanoss/scanoss.cc/backend/service"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/fakeapi"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+// helloMD5 is the MD5 of the sample file the fake API has a file match for.
+const helloMD5 = "c48d764d65801d6545037921baea24b0"
+
 func TestScanServicePythonImpl_Integration(t *testing.T) {
-	scanService := service.NewScanServicePythonImpl()
+	_, server, err := fakeapi.Start(fakeapi.Options{})
+	require.NoError(t, err)
+	defer server.Close()
+
+	internal_test.UseAPI(t, server.URL, "test-key")
+
+	hello, err := fakeapi.Fixture("file_contents/" + helloMD5)
+	require.NoError(t, err)
+
+	scanService := service.NewScanServicePythonImpl(nil, nil)
 
 	t.Run("CheckDependencies", func(t *testing.T) {
 		err := scanService.CheckDependencies()
@@ -43,40 +60,34 @@ func TestScanServicePythonImpl_Integration(t *testing.T) {
 	})
 
 	t.Run("Scan", func(t *testing.T) {
-		content := []byte("package main\n\nfunc main() {\n\tprintln(\"Hello, World!\")\n}")
-		tmpfile, err := os.CreateTemp("", "example.*.go")
-		if err != nil {
-			t.Fatal(err)
-		}
-		defer os.Remove(tmpfile.Name())
+		tmpDir := t.TempDir()
+		testFile := filepath.Join(tmpDir, "hello.c")
+		require.NoError(t, os.WriteFile(testFile, hello, 0644))
+		outputFile := filepath.Join(tmpDir, "results.json")
 
-		if _, err := tmpfile.Write(content); err != nil {
-			t.Fatal(err)
-		}
-		if err := tmpfile.Close(); err != nil {
-			t.Fatal(err)
-		}
+		args := []string{"--files", testFile, "--output", outputFile, "--apiurl", server.URL + "/scan/direct"}
+		err := scanService.Scan(args)
+		require.NoError(t, err)
 
-		args := []string{"--files", tmpfile.Name()}
-		err = scanService.Scan(args)
-		assert.NoError(t, err)
+		data, err := os.ReadFile(outputFile)
+		require.NoError(t, err)
+		var results map[string][]entities.ComponentDTO
+		require.NoError(t, json.Unmarshal(data, &results))
+		require.Len(t, results, 1)
+		for _, matches := range results {
+			require.Len(t, matches, 1)
+			assert.Equal(t, []string{"pkg:github/scanoss/hello"}, matches[0].Purl)
+		}
 	})
 
 	t.Run("ScanStream", func(t *testing.T) {
-		tmpDir, err := os.MkdirTemp("", "scanoss_test")
-		if err != nil {
-			t.Fatal(err)
-		}
-		defer os.RemoveAll(tmpDir)
-
+		tmpDir := t.TempDir()
 		testFile := filepath.Join(tmpDir, "test.go")
 		content := []byte("package main\n\nfunc main() {\n\tprintln(\"Hello, World!\")\n}")
-		if err := os.WriteFile(testFile, content, 0644); err != nil {
-			t.Fatal(err)
-		}
+		require.NoError(t, os.WriteFile(testFile, content, 0644))
 
-		args := []string{"--files", testFile}
-		err = scanService.ScanStream(args)
+		args := []string{"--files", testFile, "--output", filepath.Join(tmpDir, "results.json")}
+		err := scanService.ScanStream(args)
 		assert.NoError(t, err)
 	})
 }
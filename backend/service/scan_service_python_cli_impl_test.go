@@ -0,0 +1,174 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/service"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeFakeScanossPy writes a scanoss-py stand-in into dir that prints its version.
+func writeFakeScanossPy(t *testing.T, dir string) string {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	path := filepath.Join(dir, "scanoss-py")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\necho \"scanoss-py 1.2.3\"\n"), 0o755))
+	return path
+}
+
+func TestScanServicePythonImpl_Diagnose(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a shell script as scanoss-py")
+	}
+
+	setup := func(t *testing.T) *config.Config {
+		cleanup := internal_test.InitializeTestEnvironment(t)
+		t.Cleanup(cleanup)
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv("PATH", t.TempDir())
+		t.Setenv("VIRTUAL_ENV", "")
+		t.Setenv("PIPX_BIN_DIR", "")
+		t.Setenv("PIPX_HOME", "")
+		return config.GetInstance()
+	}
+
+	t.Run("configured binary", func(t *testing.T) {
+		cfg := setup(t)
+		binary := writeFakeScanossPy(t, t.TempDir())
+		cfg.SetScannerEnvironment(binary, "", []string{"HTTPS_PROXY=http://proxy:3128", "invalid"})
+
+		diagnostic := service.NewScanServicePythonImpl(nil, nil).Diagnose()
+
+		assert.Empty(t, diagnostic.Error)
+		assert.Equal(t, entities.ScannerPython, diagnostic.Scanner)
+		assert.Equal(t, binary, diagnostic.Binary)
+		assert.Equal(t, entities.ScannerSourceConfigBinary, diagnostic.Source)
+		assert.Equal(t, "scanoss-py 1.2.3", diagnostic.Version)
+		assert.Equal(t, "/bin/sh", diagnostic.Python)
+		assert.Equal(t, []string{"HTTPS_PROXY"}, diagnostic.EnvNames)
+	})
+
+	t.Run("configured binary missing", func(t *testing.T) {
+		cfg := setup(t)
+		cfg.SetScannerEnvironment(filepath.Join(t.TempDir(), "scanoss-py"), "", nil)
+
+		svc := service.NewScanServicePythonImpl(nil, nil)
+
+		assert.Contains(t, svc.Diagnose().Error, "scanner.binary")
+		assert.ErrorIs(t, svc.Scan([]string{"."}), entities.ErrScannerNotFound)
+	})
+
+	t.Run("configured virtualenv", func(t *testing.T) {
+		cfg := setup(t)
+		venv := t.TempDir()
+		binary := writeFakeScanossPy(t, filepath.Join(venv, "bin"))
+		cfg.SetScannerEnvironment("", venv, nil)
+
+		diagnostic := service.NewScanServicePythonImpl(nil, nil).Diagnose()
+
+		assert.Equal(t, binary, diagnostic.Binary)
+		assert.Equal(t, entities.ScannerSourceConfigPython, diagnostic.Source)
+	})
+
+	t.Run("configured interpreter without scanoss-py", func(t *testing.T) {
+		cfg := setup(t)
+		python := filepath.Join(t.TempDir(), "python3")
+		require.NoError(t, os.WriteFile(python, []byte("#!/bin/sh\n"), 0o755))
+		cfg.SetScannerEnvironment("", python, nil)
+
+		diagnostic := service.NewScanServicePythonImpl(nil, nil).Diagnose()
+
+		assert.Empty(t, diagnostic.Binary)
+		assert.Contains(t, diagnostic.Error, "pip install scanoss")
+	})
+
+	t.Run("project virtualenv is not used without opting in", func(t *testing.T) {
+		cfg := setup(t)
+		writeFakeScanossPy(t, filepath.Join(cfg.GetScanRoot(), ".venv", "bin"))
+		writeFakeScanossPy(t, filepath.Join(cfg.GetScanRoot(), "venv", "bin"))
+		binary := writeFakeScanossPy(t, t.TempDir())
+		t.Setenv("PATH", filepath.Dir(binary))
+
+		diagnostic := service.NewScanServicePythonImpl(nil, nil).Diagnose()
+
+		assert.Equal(t, binary, diagnostic.Binary)
+		assert.Equal(t, entities.ScannerSourcePath, diagnostic.Source)
+	})
+
+	t.Run("project virtualenv is not a fallback", func(t *testing.T) {
+		cfg := setup(t)
+		writeFakeScanossPy(t, filepath.Join(cfg.GetScanRoot(), ".venv", "bin"))
+
+		svc := service.NewScanServicePythonImpl(nil, nil)
+
+		assert.Empty(t, svc.Diagnose().Binary)
+		assert.ErrorIs(t, svc.Scan([]string{"."}), entities.ErrScannerNotFound)
+	})
+
+	t.Run("project virtualenv opted in with scanner.python", func(t *testing.T) {
+		cfg := setup(t)
+		binary := writeFakeScanossPy(t, filepath.Join(cfg.GetScanRoot(), ".venv", "bin"))
+		cfg.SetScannerEnvironment("", ".venv", nil)
+
+		diagnostic := service.NewScanServicePythonImpl(nil, nil).Diagnose()
+
+		assert.Equal(t, binary, diagnostic.Binary)
+		assert.Equal(t, entities.ScannerSourceProjectVenv, diagnostic.Source)
+	})
+
+	t.Run("pipx install", func(t *testing.T) {
+		setup(t)
+		home, err := os.UserHomeDir()
+		require.NoError(t, err)
+		binary := writeFakeScanossPy(t, filepath.Join(home, ".local", "share", "pipx", "venvs", "scanoss", "bin"))
+
+		diagnostic := service.NewScanServicePythonImpl(nil, nil).Diagnose()
+
+		assert.Equal(t, binary, diagnostic.Binary)
+		assert.Equal(t, entities.ScannerSourcePipx, diagnostic.Source)
+	})
+
+	t.Run("PATH", func(t *testing.T) {
+		setup(t)
+		binary := writeFakeScanossPy(t, t.TempDir())
+		t.Setenv("PATH", filepath.Dir(binary))
+
+		diagnostic := service.NewScanServicePythonImpl(nil, nil).Diagnose()
+
+		assert.Equal(t, binary, diagnostic.Binary)
+		assert.Equal(t, entities.ScannerSourcePath, diagnostic.Source)
+		assert.NoError(t, service.NewScanServicePythonImpl(nil, nil).CheckDependencies())
+	})
+}
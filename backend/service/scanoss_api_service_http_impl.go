@@ -24,6 +24,7 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -35,6 +36,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/scanoss/scanoss.cc/backend/entities"
 	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/fetch"
 	"github.com/scanoss/scanoss.cc/internal/utils"
 )
 
@@ -49,10 +51,12 @@ type ScanossApiServiceHttpImpl struct {
 	apiKey  string
 	baseURL string
 	client  HTTPClient
+	cache   APICacheService
 	timeout time.Duration
 }
 
-func NewScanossApiServiceHttpImpl() (ScanossApiService, error) {
+// NewScanossApiServiceHttpImpl returns the SCANOSS API client. apiCacheService may be nil to always query the API.
+func NewScanossApiServiceHttpImpl(apiCacheService APICacheService) (ScanossApiService, error) {
 	cfg := config.GetInstance()
 	baseURL := cfg.GetApiUrl()
 	apiKey := cfg.GetApiToken()
@@ -64,9 +68,10 @@ func NewScanossApiServiceHttpImpl() (ScanossApiService, error) {
 	service := &ScanossApiServiceHttpImpl{
 		apiKey:  apiKey,
 		baseURL: baseURL,
-		client: &http.Client{
+		client: fetch.NewClient(&http.Client{
 			Timeout: 30 * time.Second,
-		},
+		}, fetch.PolicyFromConfig()),
+		cache:   apiCacheService,
 		timeout: 30 * time.Second,
 	}
 
@@ -95,11 +100,24 @@ func (s *ScanossApiServiceHttpImpl) buildURL(endpoint string, params QueryParams
 	return u.String(), nil
 }
 
+// GetWithParams sends a GET request to an endpoint of the API. Successful responses of cached endpoints are
+// answered from the API cache until they expire.
 func (s *ScanossApiServiceHttpImpl) GetWithParams(ctx context.Context, endpoint string, params QueryParams) (*http.Response, error) {
 	fullURL, err := s.buildURL(endpoint, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build URL: %w", err)
 	}
+	if s.cache != nil {
+		if body, ok := s.cache.Get(endpoint, fullURL); ok {
+			return &http.Response{
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(bytes.NewReader(body)),
+			}, nil
+		}
+	}
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -114,6 +132,16 @@ func (s *ScanossApiServiceHttpImpl) GetWithParams(ctx context.Context, endpoint
 		log.Debug().Msgf("Get Request to %s failed: %v", fullURL, err)
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
+
+	if s.cache != nil && resp.StatusCode == http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response: %w", err)
+		}
+		s.cache.Put(endpoint, fullURL, body)
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
 	return resp, nil
 }
 
@@ -170,11 +198,11 @@ func (s *ScanossApiServiceHttpImpl) SearchComponents(request entities.ComponentS
 	if bodyErr != nil {
 		log.Warn().Err(bodyErr).Msg("Failed to read response body")
 	} else {
-		log.Debug().Msgf("Response body: '%v'", string(body))
+		log.Debug().Msgf("Response body: '%v'", s.redactedBody(body))
 	}
 	if resp.StatusCode != http.StatusOK {
-		log.Error().Int("statusCode", resp.StatusCode).Str("body", string(body)).Msg("API returned non-200 status")
-		return entities.ComponentSearchResponse{}, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+		log.Error().Int("statusCode", resp.StatusCode).Str("body", s.redactedBody(body)).Msg("API returned non-200 status")
+		return entities.ComponentSearchResponse{}, fmt.Errorf("API returned status %d: %s", resp.StatusCode, s.redactedBody(body))
 	}
 	var apiResponse entities.ComponentSearchResponse
 	if jsonErr := json.Unmarshal(body, &apiResponse); jsonErr != nil {
@@ -222,11 +250,11 @@ func (s *ScanossApiServiceHttpImpl) GetLicensesByPurl(request entities.Component
 	if bodyErr != nil {
 		log.Warn().Err(bodyErr).Msg("Failed to read response body")
 	} else {
-		log.Debug().Msgf("Response body: '%v'", string(body))
+		log.Debug().Msgf("Response body: '%v'", s.redactedBody(body))
 	}
 	if resp.StatusCode != http.StatusOK {
-		log.Error().Int("statusCode", resp.StatusCode).Str("body", string(body)).Msg("API returned non-200 status")
-		return entities.GetLicensesByPurlResponse{}, fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
+		log.Error().Int("statusCode", resp.StatusCode).Str("body", s.redactedBody(body)).Msg("API returned non-200 status")
+		return entities.GetLicensesByPurlResponse{}, fmt.Errorf("API returned status %d: %s", resp.StatusCode, s.redactedBody(body))
 	}
 	var apiResponse entities.GetLicensesByPurlResponse
 	if jsonErr := json.Unmarshal(body, &apiResponse); jsonErr != nil {
@@ -235,3 +263,8 @@ func (s *ScanossApiServiceHttpImpl) GetLicensesByPurl(request entities.Component
 	}
 	return apiResponse, nil
 }
+
+// redactedBody returns a response body safe to log, since API errors may echo the key back.
+func (s *ScanossApiServiceHttpImpl) redactedBody(body []byte) string {
+	return utils.RedactSecret(string(body), s.apiKey)
+}
@@ -0,0 +1,131 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/backend/service"
+	internal_test "github.com/scanoss/scanoss.cc/internal"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/fakeapi"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeAPIService(t *testing.T, apiCacheService service.APICacheService) (*fakeapi.Server, service.ScanossApiService) {
+	t.Helper()
+	fake, server, err := fakeapi.Start(fakeapi.Options{APIKey: "test-key"})
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+
+	internal_test.UseAPI(t, server.URL, "test-key")
+
+	apiService, err := service.NewScanossApiServiceHttpImpl(apiCacheService)
+	require.NoError(t, err)
+	return fake, apiService
+}
+
+func TestScanossApiServiceHttpImpl(t *testing.T) {
+	cleanup := internal_test.InitializeTestEnvironment(t)
+	defer cleanup()
+
+	t.Run("SearchComponents", func(t *testing.T) {
+		_, apiService := newFakeAPIService(t, nil)
+
+		response, err := apiService.SearchComponents(entities.ComponentSearchRequest{Search: "lodash", Package: "npm"})
+
+		require.NoError(t, err)
+		require.Len(t, response.Components, 1)
+		assert.Equal(t, "pkg:npm/lodash", response.Components[0].Purl)
+	})
+
+	t.Run("GetLicensesByPurl", func(t *testing.T) {
+		_, apiService := newFakeAPIService(t, nil)
+
+		response, err := apiService.GetLicensesByPurl(entities.ComponentRequest{Purl: "pkg:pypi/requests"})
+
+		require.NoError(t, err)
+		require.Len(t, response.Component.Licenses, 1)
+		assert.Equal(t, "Apache-2.0", response.Component.Licenses[0].Id)
+	})
+
+	t.Run("Answers repeated lookups from the API cache", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		config.GetInstance().SetAPICacheSettings(config.APICacheSettings{Enabled: true, TTLSeconds: config.DefaultAPICacheTTLSeconds})
+		defer config.GetInstance().SetAPICacheSettings(config.APICacheSettings{})
+
+		cacheService := service.NewAPICacheServiceImpl(repository.NewAPICacheRepositoryFileImpl(utils.NewDefaultFileReader()))
+		fake, apiService := newFakeAPIService(t, cacheService)
+
+		for range 2 {
+			response, err := apiService.GetLicensesByPurl(entities.ComponentRequest{Purl: "pkg:pypi/requests"})
+			require.NoError(t, err)
+			require.Len(t, response.Component.Licenses, 1)
+		}
+		assert.Equal(t, 1, fake.Requests(fakeapi.ComponentLicenseEndpoint))
+
+		// Other parameters are another entry
+		_, err := apiService.GetLicensesByPurl(entities.ComponentRequest{Purl: "pkg:npm/lodash"})
+		require.NoError(t, err)
+		assert.Equal(t, 2, fake.Requests(fakeapi.ComponentLicenseEndpoint))
+
+		stats, err := cacheService.Stats()
+		require.NoError(t, err)
+		assert.Equal(t, 2, stats.Entries)
+		assert.Equal(t, map[string]int{"/v2/licenses/component": 2}, stats.Endpoints)
+	})
+
+	t.Run("Does not cache failed requests", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		config.GetInstance().SetAPICacheSettings(config.APICacheSettings{Enabled: true, TTLSeconds: config.DefaultAPICacheTTLSeconds})
+		defer config.GetInstance().SetAPICacheSettings(config.APICacheSettings{})
+
+		cacheService := service.NewAPICacheServiceImpl(repository.NewAPICacheRepositoryFileImpl(utils.NewDefaultFileReader()))
+		fake, apiService := newFakeAPIService(t, cacheService)
+		require.NoError(t, fake.SetFaults(fakeapi.Faults{FailFirst: 1, ErrorStatus: http.StatusInternalServerError}))
+
+		_, err := apiService.SearchComponents(entities.ComponentSearchRequest{Search: "engine"})
+		assert.ErrorContains(t, err, "API returned status 500")
+		_, err = apiService.SearchComponents(entities.ComponentSearchRequest{Search: "engine"})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, fake.Requests(fakeapi.ComponentSearchEndpoint))
+	})
+
+	t.Run("Returns an error on a failed request", func(t *testing.T) {
+		fake, apiService := newFakeAPIService(t, nil)
+		require.NoError(t, fake.SetFaults(fakeapi.Faults{FailFirst: 1, ErrorStatus: http.StatusInternalServerError}))
+
+		_, err := apiService.SearchComponents(entities.ComponentSearchRequest{Search: "engine"})
+
+		assert.ErrorContains(t, err, "API returned status 500")
+		assert.Equal(t, 1, fake.Requests(fakeapi.ComponentSearchEndpoint))
+	})
+}
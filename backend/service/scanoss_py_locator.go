@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package service
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/internal/config"
+)
+
+const scanossPyCommand = "scanoss-py"
+
+// scanossPyLocation is the scanoss-py executable a scan runs and where it was found.
+type scanossPyLocation struct {
+	binary string
+	source string
+}
+
+// locateScanossPy finds the scanoss-py executable. A configured binary or Python environment is used as is and
+// reported when broken, otherwise the active virtualenv, PATH, pipx and the usual install folders are tried in
+// turn. GUI launches on macOS get a minimal PATH, hence the explicit folders. The scan root is usually third party
+// code, so its virtualenv is only used when scanner.python opts in to it with a relative path such as ".venv".
+func locateScanossPy() (scanossPyLocation, error) {
+	cfg := config.GetInstance()
+
+	if binary := cfg.GetScannerBinary(); binary != "" {
+		binary = expandHomePath(binary)
+		if !isExecutableFile(binary) {
+			return scanossPyLocation{}, fmt.Errorf("%w: scanner.binary %q is not an executable file", entities.ErrScannerNotFound, binary)
+		}
+		return scanossPyLocation{binary: binary, source: entities.ScannerSourceConfigBinary}, nil
+	}
+
+	if python := cfg.GetScannerPython(); python != "" {
+		python = expandHomePath(python)
+		source := entities.ScannerSourceConfigPython
+		if !filepath.IsAbs(python) {
+			python = filepath.Join(cfg.GetScanRoot(), python)
+			source = entities.ScannerSourceProjectVenv
+		}
+		if binary := pythonEnvScanossPy(python); binary != "" {
+			return scanossPyLocation{binary: binary, source: source}, nil
+		}
+		return scanossPyLocation{}, fmt.Errorf("%w: no %s in the Python environment %q, install it there with pip install scanoss", entities.ErrScannerNotFound, scanossPyCommand, python)
+	}
+
+	if venv := os.Getenv("VIRTUAL_ENV"); venv != "" {
+		if binary := venvScanossPy(venv); binary != "" {
+			return scanossPyLocation{binary: binary, source: entities.ScannerSourceVirtualEnv}, nil
+		}
+	}
+
+	if scanRoot := cfg.GetScanRoot(); scanRoot != "" {
+		for _, name := range []string{".venv", "venv"} {
+			if binary := venvScanossPy(filepath.Join(scanRoot, name)); binary != "" {
+				log.Debug().Msgf("Not using %s from the scan root, set scanner.python to %q to use it", binary, name)
+			}
+		}
+	}
+
+	if binary, err := exec.LookPath(scanossPyCommand); err == nil {
+		return scanossPyLocation{binary: binary, source: entities.ScannerSourcePath}, nil
+	}
+
+	for _, binary := range pipxScanossPyPaths() {
+		if isExecutableFile(binary) {
+			return scanossPyLocation{binary: binary, source: entities.ScannerSourcePipx}, nil
+		}
+	}
+
+	for _, folder := range []string{"/usr/local/bin", "/opt/homebrew/bin", "/usr/bin"} {
+		if binary := filepath.Join(folder, scanossPyCommand); isExecutableFile(binary) {
+			return scanossPyLocation{binary: binary, source: entities.ScannerSourceCommonPath}, nil
+		}
+	}
+
+	return scanossPyLocation{}, fmt.Errorf("%w: %s is not in PATH, a virtualenv or a pipx install, set scanner.binary or scanner.python in the config file", entities.ErrScannerNotFound, scanossPyCommand)
+}
+
+// pythonEnvScanossPy returns scanoss-py from a virtualenv folder or next to a Python interpreter.
+func pythonEnvScanossPy(python string) string {
+	info, err := os.Stat(python)
+	if err != nil {
+		return ""
+	}
+	if info.IsDir() {
+		return venvScanossPy(python)
+	}
+	binary := filepath.Join(filepath.Dir(python), scanossPyExecutableName())
+	if isExecutableFile(binary) {
+		return binary
+	}
+	return ""
+}
+
+func venvScanossPy(venv string) string {
+	binDir := "bin"
+	if runtime.GOOS == "windows" {
+		binDir = "Scripts"
+	}
+	binary := filepath.Join(venv, binDir, scanossPyExecutableName())
+	if isExecutableFile(binary) {
+		return binary
+	}
+	return ""
+}
+
+// pipxScanossPyPaths returns where pipx links and installs scanoss-py, honouring PIPX_BIN_DIR and PIPX_HOME.
+func pipxScanossPyPaths() []string {
+	home, _ := os.UserHomeDir()
+
+	binDir := os.Getenv("PIPX_BIN_DIR")
+	if binDir == "" && home != "" {
+		binDir = filepath.Join(home, ".local", "bin")
+	}
+
+	var pipxHomes []string
+	if pipxHome := os.Getenv("PIPX_HOME"); pipxHome != "" {
+		pipxHomes = append(pipxHomes, pipxHome)
+	} else if home != "" {
+		pipxHomes = append(pipxHomes, filepath.Join(home, ".local", "share", "pipx"), filepath.Join(home, ".local", "pipx"))
+	}
+
+	var paths []string
+	if binDir != "" {
+		paths = append(paths, filepath.Join(binDir, scanossPyExecutableName()))
+	}
+	for _, pipxHome := range pipxHomes {
+		if binary := venvScanossPy(filepath.Join(pipxHome, "venvs", "scanoss")); binary != "" {
+			paths = append(paths, binary)
+		}
+	}
+	return paths
+}
+
+func scanossPyExecutableName() string {
+	if runtime.GOOS == "windows" {
+		return scanossPyCommand + ".exe"
+	}
+	return scanossPyCommand
+}
+
+func isExecutableFile(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	return runtime.GOOS == "windows" || info.Mode()&0o111 != 0
+}
+
+func expandHomePath(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
+
+// scriptInterpreter returns the interpreter named on the shebang line of a script, empty for binaries.
+func scriptInterpreter(path string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	line, err := bufio.NewReader(file).ReadString('\n')
+	if err != nil && line == "" {
+		return ""
+	}
+	interpreter, ok := strings.CutPrefix(strings.TrimSpace(line), "#!")
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(interpreter)
+}
+
+// scannerExtraEnv returns the valid "NAME=value" entries of the scanner.env setting.
+func scannerExtraEnv() []string {
+	var env []string
+	for _, entry := range config.GetInstance().GetScannerEnv() {
+		if name, _, ok := strings.Cut(entry, "="); !ok || strings.TrimSpace(name) == "" {
+			log.Warn().Msgf("Ignoring scanner.env entry %q, expected NAME=value", entry)
+			continue
+		}
+		env = append(env, entry)
+	}
+	return env
+}
@@ -33,6 +33,7 @@ import (
 
 	"github.com/scanoss/scanoss.cc/backend/entities"
 	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/internal/archive"
 	"github.com/scanoss/scanoss.cc/internal/config"
 )
 
@@ -51,7 +52,19 @@ func NewTreeServiceImpl(resultService ResultService, scanossSettingsRepo reposit
 	}
 }
 
+// GetTree returns the files under rootPath with paths relative to the scan root. An archive is listed from its
+// extracted contents, with paths relative to the archive.
 func (s *TreeServiceImpl) GetTree(rootPath string) ([]entities.TreeNode, error) {
+	cfg := config.GetInstance()
+	rootPath, err := archive.ContentRoot(rootPath, cfg.GetArchiveLimits())
+	if err != nil {
+		return nil, err
+	}
+	scanRoot, err := cfg.GetScanContentRoot()
+	if err != nil {
+		return nil, err
+	}
+
 	rootInfo, err := os.Stat(rootPath)
 	if err != nil {
 		return nil, err
@@ -59,7 +72,7 @@ func (s *TreeServiceImpl) GetTree(rootPath string) ([]entities.TreeNode, error)
 
 	root := entities.NewTreeNode(rootInfo.Name(), entities.ResultDTO{}, rootInfo.IsDir())
 
-	err = s.buildTree(rootPath, &root)
+	err = s.buildTree(scanRoot, rootPath, &root)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +80,7 @@ func (s *TreeServiceImpl) GetTree(rootPath string) ([]entities.TreeNode, error)
 	return root.Children, nil
 }
 
-func (s *TreeServiceImpl) buildTree(path string, node *entities.TreeNode) error {
+func (s *TreeServiceImpl) buildTree(scanRoot, path string, node *entities.TreeNode) error {
 	entries, err := os.ReadDir(path)
 	if err != nil {
 		return err
@@ -104,7 +117,6 @@ func (s *TreeServiceImpl) buildTree(path string, node *entities.TreeNode) error
 				return
 			}
 
-			scanRoot := config.GetInstance().GetScanRoot()
 			resultRelativePath, err := filepath.Rel(scanRoot, absPath)
 			if err != nil {
 				errChan <- err
@@ -115,7 +127,7 @@ func (s *TreeServiceImpl) buildTree(path string, node *entities.TreeNode) error
 			childNode := entities.NewTreeNode(resultRelativePath, result, entry.IsDir())
 
 			if entry.IsDir() {
-				if err := s.buildTree(absPath, &childNode); err != nil {
+				if err := s.buildTree(scanRoot, absPath, &childNode); err != nil {
 					errChan <- err
 					return
 				}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/backend/service"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCacheCmd(cacheService service.APICacheService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the local cache of SCANOSS API lookups",
+		Long: "Manage the local cache of SCANOSS API lookups. License and component search responses are kept " +
+			"on disk so repeated lookups don't hit the API until their TTL expires.",
+		Args: cobra.NoArgs,
+	}
+
+	clearCmd := &cobra.Command{
+		Use:          "clear",
+		Short:        "Remove every cached API response",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			removed, err := cacheService.Clear()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Removed %d cached API responses\n", removed)
+			return nil
+		},
+	}
+
+	statsCmd := &cobra.Command{
+		Use:          "stats",
+		Short:        "Show how many API responses are cached and how much space they use",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stats, err := cacheService.Stats()
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(cmd.OutOrStdout(), stats.String())
+			return nil
+		},
+	}
+
+	cmd.AddCommand(clearCmd, statsCmd)
+
+	setupHelpCommand(cmd)
+	return cmd
+}
+
+func init() {
+	cacheCmd := NewCacheCmd(service.NewAPICacheServiceImpl(repository.NewAPICacheRepositoryFileImpl(utils.NewDefaultFileReader())))
+
+	if os.Getenv("GO_TEST") != "true" {
+		for _, subCmd := range cacheCmd.Commands() {
+			subCmd.PostRun = func(cmd *cobra.Command, args []string) {
+				os.Exit(0)
+			}
+		}
+	}
+
+	rootCmd.AddCommand(cacheCmd)
+}
@@ -0,0 +1,88 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package cmd_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/service/mocks"
+	"github.com/scanoss/scanoss.cc/cmd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheCommand(t *testing.T) {
+	t.Run("clear reports the removed entries", func(t *testing.T) {
+		mockService := mocks.NewMockAPICacheService(t)
+		mockService.EXPECT().Clear().Return(3, nil)
+
+		var out bytes.Buffer
+		cacheCmd := cmd.NewCacheCmd(mockService)
+		cacheCmd.SetOut(&out)
+		cacheCmd.SetArgs([]string{"clear"})
+
+		assert.NoError(t, cacheCmd.Execute())
+		assert.Equal(t, "Removed 3 cached API responses\n", out.String())
+	})
+
+	t.Run("clear fails when the cache can't be removed", func(t *testing.T) {
+		mockService := mocks.NewMockAPICacheService(t)
+		mockService.EXPECT().Clear().Return(0, errors.New("permission denied"))
+
+		var out bytes.Buffer
+		cacheCmd := cmd.NewCacheCmd(mockService)
+		cacheCmd.SetOut(&out)
+		cacheCmd.SetErr(&out)
+		cacheCmd.SetArgs([]string{"clear"})
+
+		assert.EqualError(t, cacheCmd.Execute(), "permission denied")
+	})
+
+	t.Run("stats shows the cache usage", func(t *testing.T) {
+		mockService := mocks.NewMockAPICacheService(t)
+		mockService.EXPECT().Stats().Return(entities.APICacheStats{
+			Folder:       "/home/user/.scanoss/cache",
+			Entries:      3,
+			Expired:      1,
+			SizeBytes:    2048,
+			MaxSizeBytes: 256 * 1024 * 1024,
+			Endpoints:    map[string]int{"/v2/licenses/component": 2, "/v2/components/search": 1},
+		}, nil)
+
+		var out bytes.Buffer
+		cacheCmd := cmd.NewCacheCmd(mockService)
+		cacheCmd.SetOut(&out)
+		cacheCmd.SetArgs([]string{"stats"})
+
+		assert.NoError(t, cacheCmd.Execute())
+		assert.Contains(t, out.String(), "Folder: /home/user/.scanoss/cache")
+		assert.Contains(t, out.String(), "Entries: 3 (1 expired)")
+		assert.Contains(t, out.String(), "Size: 2.0 KB of 256.0 MB")
+		assert.Contains(t, out.String(), "/v2/licenses/component: 2")
+	})
+}
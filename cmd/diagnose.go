@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/scanoss/scanoss.cc/backend/service"
+	"github.com/spf13/cobra"
+)
+
+func NewDiagnoseCmd(scanService service.ScanService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diagnose",
+		Short: "Show which scanner executable and version a scan will run",
+		Long: "Show which scanner executable and version a scan will run, where it was found and which extra " +
+			"environment variables it gets. Use --scanner to check the native scanner instead of scanoss-py.",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			diagnostic := scanService.Diagnose()
+			fmt.Fprint(cmd.OutOrStdout(), diagnostic.String())
+			if diagnostic.Error != "" {
+				return errors.New(diagnostic.Error)
+			}
+			return nil
+		},
+	}
+
+	setupHelpCommand(cmd)
+	return cmd
+}
+
+func init() {
+	diagnoseCmd := NewDiagnoseCmd(&configuredScanService{})
+
+	if os.Getenv("GO_TEST") != "true" {
+		diagnoseCmd.PostRun = func(cmd *cobra.Command, args []string) {
+			os.Exit(0)
+		}
+	}
+
+	rootCmd.AddCommand(diagnoseCmd)
+}
@@ -0,0 +1,73 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/service/mocks"
+	"github.com/scanoss/scanoss.cc/cmd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnoseCommand(t *testing.T) {
+	t.Run("reports the scanner that will run", func(t *testing.T) {
+		mockService := mocks.NewMockScanService(t)
+		mockService.EXPECT().Diagnose().Return(entities.ScannerDiagnostic{
+			Scanner: entities.ScannerPython,
+			Binary:  "/home/user/.local/bin/scanoss-py",
+			Source:  entities.ScannerSourcePipx,
+			Version: "scanoss-py 1.2.3",
+		})
+
+		var out bytes.Buffer
+		diagnoseCmd := cmd.NewDiagnoseCmd(mockService)
+		diagnoseCmd.SetOut(&out)
+		diagnoseCmd.SetArgs([]string{})
+
+		assert.NoError(t, diagnoseCmd.Execute())
+		assert.Contains(t, out.String(), "/home/user/.local/bin/scanoss-py (found via pipx)")
+		assert.Contains(t, out.String(), "Version: scanoss-py 1.2.3")
+	})
+
+	t.Run("fails when the scanner is not usable", func(t *testing.T) {
+		mockService := mocks.NewMockScanService(t)
+		mockService.EXPECT().Diagnose().Return(entities.ScannerDiagnostic{
+			Scanner: entities.ScannerPython,
+			Error:   "scanner not found",
+		})
+
+		var out bytes.Buffer
+		diagnoseCmd := cmd.NewDiagnoseCmd(mockService)
+		diagnoseCmd.SetOut(&out)
+		diagnoseCmd.SetErr(&out)
+		diagnoseCmd.SetArgs([]string{})
+
+		assert.EqualError(t, diagnoseCmd.Execute(), "scanner not found")
+	})
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/internal/fakeapi"
+	"github.com/spf13/cobra"
+)
+
+// NewFakeAPICmd creates the hidden command that serves a local fake SCANOSS API, for offline development and testing.
+func NewFakeAPICmd() *cobra.Command {
+	var (
+		addr string
+		opts fakeapi.Options
+	)
+
+	cmd := &cobra.Command{
+		Use:    "fake-api",
+		Short:  "Serve a local fake SCANOSS API from fixture files",
+		Hidden: true,
+		Args:   cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			server, err := fakeapi.New(opts)
+			if err != nil {
+				return err
+			}
+
+			listener, err := net.Listen("tcp", addr)
+			if err != nil {
+				return fmt.Errorf("error listening on %s: %w", addr, err)
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			httpServer := &http.Server{Handler: server, ReadHeaderTimeout: 10 * time.Second}
+			go func() {
+				<-ctx.Done()
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				if err := httpServer.Shutdown(shutdownCtx); err != nil {
+					log.Error().Err(err).Msg("Error shutting down fake API")
+				}
+			}()
+
+			apiURL := fmt.Sprintf("http://%s", listener.Addr())
+			log.Info().Msgf("Fake SCANOSS API listening on %s", apiURL)
+			log.Info().Msgf("Point the app at it with: scanoss-cc --apiUrl %s --key %s", apiURL, fakeAPIKeyHint(opts.APIKey))
+
+			if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return fmt.Errorf("fake API stopped: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&addr, "addr", "127.0.0.1:8765", "Address to listen on")
+	cmd.Flags().StringVar(&opts.FixturesDir, "fixtures", "", "Directory with fixtures overriding the built in ones (optional)")
+	cmd.Flags().StringVar(&opts.APIKey, "api-key", "", "API key required in requests (optional - default: any key is accepted)")
+	cmd.Flags().DurationVar(&opts.Latency, "latency", 0, "Latency added to every response, e.g. 250ms")
+	cmd.Flags().Float64Var(&opts.ErrorRate, "error-rate", 0, "Probability between 0 and 1 that a request fails")
+	cmd.Flags().IntVar(&opts.ErrorStatus, "error-status", http.StatusServiceUnavailable, "HTTP status of injected failures")
+	cmd.Flags().IntVar(&opts.FailFirst, "fail-first", 0, "Number of requests to fail before any succeeds")
+
+	setupHelpCommand(cmd)
+	return cmd
+}
+
+func fakeAPIKeyHint(apiKey string) string {
+	if apiKey == "" {
+		return "<any>"
+	}
+	return apiKey
+}
+
+func init() {
+	fakeAPICmd := NewFakeAPICmd()
+
+	// Exit once the server stops instead of opening the UI
+	if os.Getenv("GO_TEST") != "true" {
+		fakeAPICmd.PostRun = func(cmd *cobra.Command, args []string) {
+			os.Exit(0)
+		}
+	}
+
+	rootCmd.AddCommand(fakeAPICmd)
+}
@@ -0,0 +1,70 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package cmd_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/scanoss/scanoss.cc/cmd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeAPICommand(t *testing.T) {
+	t.Run("stops when the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		fakeAPICmd := cmd.NewFakeAPICmd()
+		fakeAPICmd.SetArgs([]string{"--addr", "127.0.0.1:0", "--latency", "10ms"})
+
+		done := make(chan error, 1)
+		go func() { done <- fakeAPICmd.ExecuteContext(ctx) }()
+
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("fake API did not stop")
+		}
+	})
+
+	t.Run("rejects an invalid error rate", func(t *testing.T) {
+		fakeAPICmd := cmd.NewFakeAPICmd()
+		fakeAPICmd.SetArgs([]string{"--addr", "127.0.0.1:0", "--error-rate", "2"})
+
+		assert.Error(t, fakeAPICmd.Execute())
+	})
+
+	t.Run("rejects a missing fixtures directory", func(t *testing.T) {
+		fakeAPICmd := cmd.NewFakeAPICmd()
+		fakeAPICmd.SetArgs([]string{"--addr", "127.0.0.1:0", "--fixtures", t.TempDir() + "/missing"})
+
+		assert.Error(t, fakeAPICmd.Execute())
+	})
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewHooksCmd(hookRepo repository.PostScanHookRepository) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hooks",
+		Short: "Manage the post-scan hooks of a project",
+		Long: "Manage the post-scan hooks of a project. The hooks in a project's .scanoss/post-scan-hooks.json " +
+			"only run once you trust that file, and editing it needs trusting it again.",
+		Args: cobra.NoArgs,
+	}
+
+	trustCmd := &cobra.Command{
+		Use:          "trust [folder]",
+		Short:        "Allow the post-scan hooks of a project to run",
+		Long:         "Allow the hooks in the current .scanoss/post-scan-hooks.json of folder, or of the working directory, to run. Review the file first: its commands run on your machine after each scan.",
+		Args:         cobra.MaximumNArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			folder := "."
+			if len(args) == 1 {
+				folder = args[0]
+			}
+			folder, err := filepath.Abs(folder)
+			if err != nil {
+				return err
+			}
+
+			hooks, err := hookRepo.Trust(folder)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Trusted the post-scan hooks of %s\n", folder)
+			for _, hook := range hooks {
+				fmt.Fprintf(cmd.OutOrStdout(), "  %s: %s\n", hook.Name, describeHook(hook))
+			}
+			return nil
+		},
+	}
+
+	cmd.AddCommand(trustCmd)
+
+	setupHelpCommand(cmd)
+	return cmd
+}
+
+// describeHook returns what a hook does, as shown when trusting it.
+func describeHook(hook entities.PostScanHook) string {
+	switch hook.Action {
+	case entities.PostScanHookCommand:
+		return strings.Join(hook.Command, " ")
+	case entities.PostScanHookApplyRules:
+		return fmt.Sprintf("%s %s", hook.Action, hook.File)
+	case entities.PostScanHookExport:
+		return fmt.Sprintf("%s %s to %s", hook.Action, hook.Format, hook.Output)
+	default:
+		return string(hook.Action)
+	}
+}
+
+func init() {
+	hooksCmd := NewHooksCmd(repository.NewPostScanHookRepositoryJsonImpl(utils.NewDefaultFileReader()))
+
+	if os.Getenv("GO_TEST") != "true" {
+		for _, subCmd := range hooksCmd.Commands() {
+			subCmd.PostRun = func(cmd *cobra.Command, args []string) {
+				os.Exit(0)
+			}
+		}
+	}
+
+	rootCmd.AddCommand(hooksCmd)
+}
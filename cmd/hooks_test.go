@@ -0,0 +1,72 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package cmd_test
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository/mocks"
+	"github.com/scanoss/scanoss.cc/cmd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHooksCommand(t *testing.T) {
+	t.Run("trust lists the trusted hooks", func(t *testing.T) {
+		folder := t.TempDir()
+		mockRepo := mocks.NewMockPostScanHookRepository(t)
+		mockRepo.EXPECT().Trust(folder).Return([]entities.PostScanHook{
+			{Name: "reload", Action: entities.PostScanHookReload},
+			{Name: "notify", Action: entities.PostScanHookCommand, Command: []string{"./notify.sh", "--all"}},
+		}, nil)
+
+		var out bytes.Buffer
+		hooksCmd := cmd.NewHooksCmd(mockRepo)
+		hooksCmd.SetOut(&out)
+		hooksCmd.SetArgs([]string{"trust", folder})
+
+		assert.NoError(t, hooksCmd.Execute())
+		assert.Equal(t, "Trusted the post-scan hooks of "+folder+"\n  reload: reload\n  notify: ./notify.sh --all\n", out.String())
+	})
+
+	t.Run("trust defaults to the working directory", func(t *testing.T) {
+		cwd, err := filepath.Abs(".")
+		assert.NoError(t, err)
+		mockRepo := mocks.NewMockPostScanHookRepository(t)
+		mockRepo.EXPECT().Trust(cwd).Return(nil, errors.New("project has no post-scan hooks file"))
+
+		var out bytes.Buffer
+		hooksCmd := cmd.NewHooksCmd(mockRepo)
+		hooksCmd.SetOut(&out)
+		hooksCmd.SetErr(&out)
+		hooksCmd.SetArgs([]string{"trust"})
+
+		assert.EqualError(t, hooksCmd.Execute(), "project has no post-scan hooks file")
+	})
+}
@@ -38,10 +38,13 @@ var (
 	apiUrl                  string
 	cfgFile                 string
 	debug                   bool
-	inputFile               string
+	inputFiles              []string
+	inputFormat             string
 	scanossSettingsFilePath string
 	scanRoot                string
+	scanner                 string
 	version                 bool
+	view                    string
 	originalWorkDir         string
 )
 
@@ -79,12 +82,15 @@ func init() {
 
 	rootCmd.Flags().BoolVarP(&version, "version", "v", false, "Show application version")
 	rootCmd.Flags().StringVarP(&cfgFile, "config", "c", "", "Config file (optional - default: $HOME/.scanoss/scanoss-cc-settings.json)")
-	rootCmd.Flags().StringVarP(&inputFile, "input", "i", "", "Path to scan result file (optional - default: $WORKDIR/.scanoss/results.json)")
+	rootCmd.Flags().StringSliceVarP(&inputFiles, "input", "i", nil, "Path or glob of scan result files, repeat or comma separate to merge several (optional - default: $WORKDIR/.scanoss/results.json)")
+	rootCmd.Flags().StringVar(&inputFormat, "input-format", string(entities.InputFormatAuto), "Format of the scan result file: auto, scanoss, cyclonedx, spdx or scancode (optional - default: auto)")
 	rootCmd.Flags().StringVarP(&scanRoot, "scan-root", "s", "", "Scanned folder root path (optional - default: $WORKDIR)")
+	rootCmd.Flags().StringVar(&view, "view", "", "Name of a saved view to apply when the app opens (optional)")
 	rootCmd.Flags().StringVar(&scanossSettingsFilePath, "settings", "", "Path to scanoss settings file (optional - default: $WORKDIR/scanoss.json)")
 	rootCmd.Flags().StringVarP(&apiKey, "key", "k", "", "SCANOSS API Key token (optional)")
 	rootCmd.Flags().StringVarP(&apiUrl, "apiUrl", "u", "", fmt.Sprintf("SCANOSS API URL (optional - default: %s)", config.DefaultAPIURL))
 	rootCmd.Flags().BoolVarP(&debug, "debug", "d", false, "Enable debug mode")
+	rootCmd.PersistentFlags().StringVar(&scanner, "scanner", string(entities.ScannerPython), "Scan engine: python (scanoss-py) or native (built in, no Python required) (optional - default: python)")
 
 	rootCmd.Root().CompletionOptions.HiddenDefaultCmd = true
 
@@ -94,7 +100,21 @@ func init() {
 func initConfig() {
 	cfg := config.GetInstance()
 
-	if err := cfg.InitializeConfig(cfgFile, scanRoot, apiKey, apiUrl, inputFile, scanossSettingsFilePath, originalWorkDir, debug); err != nil {
+	format, err := entities.ParseInputFormat(inputFormat)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Error parsing input format")
+	}
+	cfg.SetInputFormat(string(format))
+
+	selectedScanner, err := entities.ParseScanner(scanner)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Error parsing scanner")
+	}
+	cfg.SetScanner(string(selectedScanner))
+
+	cfg.SetStartupView(view)
+
+	if err := cfg.InitializeConfig(cfgFile, scanRoot, apiKey, apiUrl, inputFiles, scanossSettingsFilePath, originalWorkDir, debug); err != nil {
 		log.Fatal().Err(err).Msg("Error initializing config")
 	}
 }
@@ -25,17 +25,25 @@ package cmd
 
 import (
 	"fmt"
+	"maps"
 	"os"
-	"strings"
+	"path/filepath"
+	"slices"
+	"sync"
 
+	"github.com/rs/zerolog/log"
 	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/backend/repository"
 	"github.com/scanoss/scanoss.cc/backend/service"
+	"github.com/scanoss/scanoss.cc/internal/archive"
+	"github.com/scanoss/scanoss.cc/internal/config"
+	"github.com/scanoss/scanoss.cc/internal/utils"
 	"github.com/spf13/cobra"
 )
 
 func NewScanCmd(scanService service.ScanService) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "scan [scanDirPath]",
+		Use:   "scan [scanDirPath | archive]",
 		Short: "Run a scan on the specified folder",
 		Args: func(cmd *cobra.Command, args []string) error {
 			filesFlag := cmd.Flag("files")
@@ -43,15 +51,37 @@ func NewScanCmd(scanService service.ScanService) *cobra.Command {
 			if filesFlag == nil || !filesFlag.Changed && len(args) == 0 {
 				return fmt.Errorf("you must specify a folder to scan")
 			}
+			if isIncrementalScan(cmd) && (len(args) != 1 || filesFlag.Changed) {
+				return fmt.Errorf("an incremental scan needs a folder to scan and cannot be combined with --files")
+			}
+			if gitRef, _ := cmd.Flags().GetString("git-ref"); gitRef != "" && (len(args) != 1 || filesFlag.Changed || isIncrementalScan(cmd)) {
+				return fmt.Errorf("a git ref scan needs a folder to scan and cannot be combined with --files or --incremental")
+			}
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
+			// Archives given as folder to scan are extracted for the duration of the command
+			defer archive.Cleanup()
+
 			if err := scanService.CheckDependencies(); err != nil {
 				return err
 			}
 
-			scanOptions := make([]string, 0)
-			scanOptions = append(scanOptions, "--quiet")
+			projectRoot := "."
+			if len(args) == 1 && args[0] != "" {
+				projectRoot = args[0]
+			}
+			presetOptions, err := applyScanPreset(cmd, projectRoot)
+			if err != nil {
+				return err
+			}
+
+			if isIncrementalScan(cmd) {
+				return runIncrementalScan(cmd, scanService, args[0], presetOptions)
+			}
+			if gitRef, _ := cmd.Flags().GetString("git-ref"); gitRef != "" {
+				return runGitRefScan(cmd, scanService, args[0], gitRef, presetOptions)
+			}
 
 			var scanDirPath string
 
@@ -59,35 +89,29 @@ func NewScanCmd(scanService service.ScanService) *cobra.Command {
 			// Could be that the user specifies only --files flag (e.g scan --files file1.go file2.go)
 			if len(args) == 1 && args[0] != "" {
 				scanDirPath = args[0]
-				scanOptions = append(scanOptions, scanDirPath)
 			}
 
-			for _, arg := range entities.ScanArguments {
-				flag := cmd.Flag(arg.Name)
-				if flag == nil || !flag.Changed {
-					continue
-				}
-
-				switch arg.Type {
-				case "string":
-					scanOptions = append(scanOptions, fmt.Sprintf("--%s", arg.Name), flag.Value.String())
-				case "stringSlice":
-					values, err := cmd.Flags().GetStringSlice(arg.Name)
-					if err != nil {
-						return fmt.Errorf("an error occurred with argument %s: %w", arg.Name, err)
-					}
-					commaSeparatedValues := strings.Join(values, ",")
-					scanOptions = append(scanOptions, fmt.Sprintf("--%s", arg.Name), commaSeparatedValues)
-				case "int":
-					scanOptions = append(scanOptions, fmt.Sprintf("--%s", arg.Name), flag.Value.String())
-				case "bool":
-					if flag.Value.String() == "true" {
-						scanOptions = append(scanOptions, fmt.Sprintf("--%s", arg.Name))
-					}
-				}
+			values, err := scanFlagValues(cmd)
+			if err != nil {
+				return err
+			}
+			maps.Copy(values, presetOptions)
+			values["quiet"] = true
+
+			scanOptions, err := entities.BuildScanArgs(scanDirPath, values)
+			if err != nil {
+				return err
+			}
+
+			if err := scanService.Scan(scanOptions); err != nil {
+				return err
 			}
 
-			return scanService.Scan(scanOptions)
+			// Without --output the results go to stdout, there is no file to keep
+			if output, _ := cmd.Flags().GetString("output"); output != "" {
+				recordScanRun(output, scanOptions)
+			}
+			return nil
 		},
 	}
 
@@ -104,13 +128,222 @@ func NewScanCmd(scanService service.ScanService) *cobra.Command {
 		}
 	}
 
+	cmd.Flags().Bool("incremental", false, "Only rescan files changed since the last scan and merge them into the existing results")
+	cmd.Flags().String("since", "", "Git ref to find changed files against, implies --incremental (optional - default: compare with the last scan)")
+	cmd.Flags().String("git-ref", "", "Scan the folder as it is at this git ref instead of the working tree, results are tagged with its commit (optional)")
+	cmd.Flags().String("preset", "", "Name of a scan preset from the project or user scan-presets.json, flags given on the command line take precedence (optional)")
+
 	setupHelpCommand(cmd)
 	return cmd
 }
 
+// scanFlagValues returns the scan argument flags set on the command line as typed values keyed by argument name.
+// Flags listed in exclude are left out.
+func scanFlagValues(cmd *cobra.Command, exclude ...string) (map[string]any, error) {
+	values := make(map[string]any)
+
+	for _, arg := range entities.ScanArguments {
+		flag := cmd.Flag(arg.Name)
+		if flag == nil || !flag.Changed || slices.Contains(exclude, arg.Name) {
+			continue
+		}
+
+		var value any
+		var err error
+		switch arg.Type {
+		case "string":
+			value = flag.Value.String()
+		case "stringSlice":
+			value, err = cmd.Flags().GetStringSlice(arg.Name)
+		case "int":
+			value, err = cmd.Flags().GetInt(arg.Name)
+		case "bool":
+			value, err = cmd.Flags().GetBool(arg.Name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("an error occurred with argument %s: %w", arg.Name, err)
+		}
+		values[arg.Name] = value
+	}
+
+	return values, nil
+}
+
+// applyScanPreset sets the flags of the preset selected with --preset that were not given on the command line.
+// Preset arguments without a flag, such as --skip-settings-file, are returned as typed values.
+func applyScanPreset(cmd *cobra.Command, projectRoot string) (map[string]any, error) {
+	name, _ := cmd.Flags().GetString("preset")
+	if name == "" {
+		return nil, nil
+	}
+
+	preset, err := repository.NewScanPresetRepositoryJsonImpl(utils.NewDefaultFileReader()).Get(projectRoot, name)
+	if err != nil {
+		return nil, err
+	}
+	values, err := preset.FlagValues()
+	if err != nil {
+		return nil, err
+	}
+
+	extraOptions := make(map[string]any)
+	for _, arg := range entities.ScanArguments {
+		value, ok := values[arg.Name]
+		if !ok {
+			continue
+		}
+
+		flag := cmd.Flag(arg.Name)
+		if flag == nil {
+			extraOptions[arg.Name] = preset.Args[arg.Name]
+			continue
+		}
+		if flag.Changed {
+			continue
+		}
+		if err := cmd.Flags().Set(arg.Name, value); err != nil {
+			return nil, fmt.Errorf("error applying scan preset %q: %w", name, err)
+		}
+	}
+
+	log.Debug().Msgf("Using %s scan preset %q", preset.Scope, preset.Name)
+	return extraOptions, nil
+}
+
+func isIncrementalScan(cmd *cobra.Command) bool {
+	incremental, _ := cmd.Flags().GetBool("incremental")
+	since, _ := cmd.Flags().GetString("since")
+	return incremental || since != ""
+}
+
+// runIncrementalScan rescans the files of scanDirPath changed since the last scan into its results file,
+// <scanDirPath>/.scanoss/results.json unless --output is set.
+func runIncrementalScan(cmd *cobra.Command, scanService service.ScanService, scanDirPath string, presetOptions map[string]any) error {
+	output, _ := cmd.Flags().GetString("output")
+	if output == "" {
+		output = filepath.Join(scanDirPath, ".scanoss", config.DEFAULT_RESULTS_FILE)
+	}
+	since, _ := cmd.Flags().GetString("since")
+
+	values, err := scanFlagValues(cmd, "output", "files")
+	if err != nil {
+		return err
+	}
+	maps.Copy(values, presetOptions)
+	values["quiet"] = true
+
+	scanOptions, err := entities.BuildScanArgs("", values)
+	if err != nil {
+		return err
+	}
+
+	scanossSettingsRepository := repository.NewScanossSettingsJsonRepository(utils.NewDefaultFileReader())
+	if err := scanossSettingsRepository.Init(); err != nil {
+		log.Error().Err(err).Msg("Error initializing scanoss settings repository")
+	}
+
+	incrementalScanService := service.NewIncrementalScanServiceImpl(
+		scanService,
+		repository.NewScanManifestRepositoryJsonImpl(utils.NewDefaultFileReader()),
+		scanossSettingsRepository,
+	)
+
+	changes, err := incrementalScanService.Scan(entities.IncrementalScanRequest{
+		Root:   scanDirPath,
+		Output: output,
+		Since:  since,
+		Args:   scanOptions,
+	})
+	if err != nil {
+		return err
+	}
+
+	recordScanRun(output, append([]string{scanDirPath, "--output", output, "--incremental"}, scanOptions...))
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Rescanned %d changed files, removed %d deleted files from %s\n", len(changes.Changed), len(changes.Deleted), output)
+	return nil
+}
+
+// runGitRefScan scans scanDirPath as it is at gitRef into its results file,
+// <scanDirPath>/.scanoss/results-<short sha>.json unless --output is set.
+func runGitRefScan(cmd *cobra.Command, scanService service.ScanService, scanDirPath, gitRef string, presetOptions map[string]any) error {
+	output, _ := cmd.Flags().GetString("output")
+
+	values, err := scanFlagValues(cmd, "output", "files")
+	if err != nil {
+		return err
+	}
+	maps.Copy(values, presetOptions)
+	values["quiet"] = true
+
+	scanOptions, err := entities.BuildScanArgs("", values)
+	if err != nil {
+		return err
+	}
+
+	gitRefScanService := service.NewGitRefScanServiceImpl(
+		scanService,
+		repository.NewScanGitSourceRepositoryJsonImpl(utils.NewDefaultFileReader()),
+	)
+
+	scan, err := gitRefScanService.Scan(entities.GitRefScanRequest{
+		Root:   scanDirPath,
+		Ref:    gitRef,
+		Output: output,
+		Args:   scanOptions,
+	})
+	if err != nil {
+		return err
+	}
+
+	recordScanRun(scan.Output, append([]string{scanDirPath, "--output", scan.Output, "--git-ref", gitRef}, scanOptions...))
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Scanned %s at %s (%s) into %s\n", scanDirPath, gitRef, scan.Source.Commit, scan.Output)
+	return nil
+}
+
+// recordScanRun keeps a snapshot of the results file in the scan history next to it.
+func recordScanRun(resultsPath string, args []string) {
+	scanHistoryService := service.NewScanHistoryServiceImpl(repository.NewScanHistoryRepositoryJsonImpl(utils.NewDefaultFileReader()), nil)
+	if _, err := scanHistoryService.Record(resultsPath, args); err != nil {
+		log.Error().Err(err).Msg("Error recording scan run in the scan history")
+	}
+}
+
+// configuredScanService picks the scan engine when the command runs, once the --scanner flag and the config
+// have been parsed.
+type configuredScanService struct {
+	once        sync.Once
+	scanService service.ScanService
+}
+
+func (c *configuredScanService) get() service.ScanService {
+	c.once.Do(func() {
+		scanossSettingsRepository := repository.NewScanossSettingsJsonRepository(utils.NewDefaultFileReader())
+		if err := scanossSettingsRepository.Init(); err != nil {
+			log.Error().Err(err).Msg("Error initializing scanoss settings repository")
+		}
+		// Command line scans are recorded by the scan command itself, once it knows where the results went.
+		// Post-scan hooks act on the review session, so they only follow scans started from the app.
+		c.scanService = service.NewScanService(scanossSettingsRepository, nil, nil)
+	})
+	return c.scanService
+}
+
+func (c *configuredScanService) CheckDependencies() error       { return c.get().CheckDependencies() }
+func (c *configuredScanService) GetDefaultScanArgs() []string   { return c.get().GetDefaultScanArgs() }
+func (c *configuredScanService) Scan(args []string) error       { return c.get().Scan(args) }
+func (c *configuredScanService) ScanStream(args []string) error { return c.get().ScanStream(args) }
+func (c *configuredScanService) AbortScan() error               { return c.get().AbortScan() }
+func (c *configuredScanService) ScanWithOptions(request entities.ScanRequest) error {
+	return c.get().ScanWithOptions(request)
+}
+func (c *configuredScanService) Diagnose() entities.ScannerDiagnostic {
+	return c.get().Diagnose()
+}
+
 func init() {
-	service := service.NewScanServicePythonImpl()
-	scanCmd := NewScanCmd(service)
+	scanCmd := NewScanCmd(&configuredScanService{})
 
 	// This is a workaround to prevent the scan command opening the code compare when running tests
 	if os.Getenv("GO_TEST") != "true" {
@@ -27,6 +27,8 @@ package cmd_test
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"testing"
@@ -35,6 +37,7 @@ import (
 	"github.com/scanoss/scanoss.cc/cmd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestScanCommand(t *testing.T) {
@@ -169,4 +172,61 @@ func TestScanCommand(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "dependency check failed")
 	})
+
+	t.Run("incremental scan needs a folder and no file list", func(t *testing.T) {
+		mockService := mocks.NewMockScanService(t)
+
+		cmd := cmd.NewScanCmd(mockService)
+		cmd.SetArgs([]string{
+			"--incremental",
+			"--files", "file1.go",
+		})
+
+		err := cmd.Execute()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "incremental scan needs a folder")
+	})
+	t.Run("applies a scan preset under the command line flags", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		projectRoot := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(projectRoot, ".scanoss"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(projectRoot, ".scanoss", "scan-presets.json"), []byte(`{
+			"presets": [{"name": "deep", "args": {"threads": 10, "post-size": 64, "dependencies": true, "skip-settings-file": true}}]
+		}`), 0o644))
+
+		mockService := mocks.NewMockScanService(t)
+		mockService.EXPECT().CheckDependencies().Return(nil)
+		mockService.EXPECT().Scan(mock.MatchedBy(func(args []string) bool {
+			expectedArgs := []string{
+				projectRoot,
+				"--quiet",
+				"--threads", "2",
+				"--post-size", "64",
+				"--dependencies",
+				"--skip-settings-file",
+			}
+			sort.Strings(args)
+			sort.Strings(expectedArgs)
+			return reflect.DeepEqual(args, expectedArgs)
+		})).Return(nil)
+
+		cmd := cmd.NewScanCmd(mockService)
+		cmd.SetArgs([]string{projectRoot, "--preset", "deep", "--threads", "2"})
+
+		assert.NoError(t, cmd.Execute())
+	})
+
+	t.Run("fails with an unknown scan preset", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+
+		mockService := mocks.NewMockScanService(t)
+		mockService.EXPECT().CheckDependencies().Return(nil)
+
+		cmd := cmd.NewScanCmd(mockService)
+		cmd.SetArgs([]string{t.TempDir(), "--preset", "missing"})
+
+		err := cmd.Execute()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "scan preset not found")
+	})
 }
@@ -24,8 +24,7 @@
 import * as monaco from 'monaco-editor';
 import { useEffect, useMemo, useRef } from 'react';
 
-import { HighlightRange, MonacoManager } from '@/lib/editor';
-import { getHighlightLineRanges } from '@/modules/results/utils';
+import { EditorSide, HighlightRange, MonacoManager } from '@/lib/editor';
 import useEditorFocusStore from '@/stores/useEditorFocusStore';
 
 // Unbind Monaco keybindings that conflict with app shortcuts.
@@ -51,9 +50,11 @@ interface CodeViewerProps {
   editorId: string;
   error: Error | null;
   height?: string;
-  highlightLines?: string;
+  highlightAll?: boolean;
+  highlightRanges?: HighlightRange[];
   isError: boolean;
   language: string | null | undefined;
+  side?: EditorSide;
   width?: string;
 }
 
@@ -62,13 +63,15 @@ export default function CodeViewer({
   editorId,
   error,
   height = '100%',
-  highlightLines,
+  highlightAll,
+  highlightRanges: matchRanges,
   isError,
   language,
+  side,
   width = '100%',
 }: CodeViewerProps) {
-  const highlightAll = highlightLines === 'all';
   const editor = useRef<monaco.editor.IStandaloneCodeEditor | null>(null);
+  const decorationIds = useRef<string[]>([]);
   const focusListeners = useRef<monaco.IDisposable[]>([]);
   const editorContainer = useRef<HTMLDivElement>(null);
   const monacoManager = MonacoManager.getInstance();
@@ -91,7 +94,7 @@ export default function CodeViewer({
         ...EDITOR_DEFAULT_OPTIONS,
       });
 
-      monacoManager.addEditor(editorId, editor.current);
+      monacoManager.addEditor(editorId, editor.current, { side });
 
       focusListeners.current = [
         editor.current.onDidFocusEditorWidget(() => setEditorFocus(true)),
@@ -118,6 +121,7 @@ export default function CodeViewer({
 
       const nModel = monaco.editor.createModel(content as string, language as string);
       mEditor.setModel(nModel);
+      decorationIds.current = [];
 
       if (oldModel) oldModel.dispose();
     }
@@ -137,8 +141,8 @@ export default function CodeViewer({
         start: 1,
         end: model.getLineCount(),
       });
-    } else if (highlightLines) {
-      highlightRanges = getHighlightLineRanges(highlightLines);
+    } else if (matchRanges) {
+      highlightRanges = matchRanges;
     }
 
     // Create and apply decorations
@@ -147,7 +151,7 @@ export default function CodeViewer({
       options: decorationOptions,
     }));
 
-    editor.current.deltaDecorations([], decorations);
+    decorationIds.current = editor.current.deltaDecorations(decorationIds.current, decorations);
   };
 
   useEffect(() => {
@@ -160,6 +164,11 @@ export default function CodeViewer({
     updateHighlight();
   }, [content, language]);
 
+  // The validated ranges can arrive after the content
+  useEffect(() => {
+    updateHighlight();
+  }, [highlightAll, matchRanges]);
+
   if (isError) {
     return (
       <div className="flex flex-1 items-center justify-center text-sm text-muted-foreground">
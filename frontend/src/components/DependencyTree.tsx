@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+import { useQuery, useQueryClient } from '@tanstack/react-query';
+import clsx from 'clsx';
+import { ChevronDown, ChevronRight, FileText, Package } from 'lucide-react';
+import { useState } from 'react';
+import { NodeRendererProps, Tree } from 'react-arborist';
+import useResizeObserver from 'use-resize-observer';
+
+import { useResults } from '@/hooks/useResults';
+import { FilterAction, filterActionLabelMap } from '@/modules/components/domain';
+import useComponentFilterStore from '@/modules/components/stores/useComponentFilterStore';
+import useConfigStore from '@/stores/useConfigStore';
+
+import { entities } from '../../wailsjs/go/models';
+import { FilterDependencies, GetTree } from '../../wailsjs/go/service/DependencyServiceImpl';
+import { ContextMenu, ContextMenuContent, ContextMenuItem, ContextMenuTrigger } from './ui/context-menu';
+import { toast } from './ui/use-toast';
+
+const TREE_HEIGHT = 180;
+const ROW_HEIGHT = 24;
+
+// Dependency leaves are identified as "<declared file>#<purl>"
+const getDependencyPurl = (node: entities.TreeNode) => node.id.slice(node.id.indexOf('#') + 1);
+
+export default function DependencyTree() {
+  const queryClient = useQueryClient();
+  const { ref, width } = useResizeObserver<HTMLDivElement>();
+  const [isOpen, setIsOpen] = useState(true);
+
+  const scanRoot = useConfigStore((state) => state.scanRoot);
+  const updateUndoRedoState = useComponentFilterStore((state) => state.updateUndoRedoState);
+  const { reset: refetchResults } = useResults();
+
+  const { data: tree } = useQuery({
+    queryKey: ['dependencyTree', scanRoot],
+    queryFn: GetTree,
+  });
+
+  const handleDecision = async (node: entities.TreeNode, action: FilterAction) => {
+    try {
+      await FilterDependencies([entities.ComponentFilterDTO.createFrom({ action, path: node.path, purl: getDependencyPurl(node) })]);
+      await queryClient.invalidateQueries({ queryKey: ['dependencyTree', scanRoot] });
+      await updateUndoRedoState();
+      refetchResults();
+    } catch (e) {
+      toast({
+        variant: 'destructive',
+        title: 'Error',
+        description: `Could not apply the decision to ${node.name}: ${e}`,
+      });
+    }
+  };
+
+  if (!tree?.length) {
+    return null;
+  }
+
+  const dependencyCount = tree.reduce((count, manifest) => count + (manifest.children?.length ?? 0), 0);
+  const pendingCount = tree.reduce(
+    (count, manifest) => count + (manifest.children?.filter((dependency) => dependency.workflowState === 'pending').length ?? 0),
+    0
+  );
+
+  return (
+    <div className="flex flex-col gap-1" ref={ref}>
+      <button className="flex items-center gap-1 text-sm text-muted-foreground hover:text-foreground" onClick={() => setIsOpen(!isOpen)}>
+        {isOpen ? <ChevronDown className="h-3 w-3" /> : <ChevronRight className="h-3 w-3" />}
+        Dependencies{' '}
+        <span className="text-xs">
+          ({pendingCount} pending of {dependencyCount})
+        </span>
+      </button>
+      {isOpen ? (
+        <Tree<entities.TreeNode>
+          data={tree}
+          childrenAccessor={(node) => (node.isFolder ? node.children : null)}
+          openByDefault
+          width={width}
+          height={TREE_HEIGHT}
+          rowHeight={ROW_HEIGHT}
+          disableDrag
+          disableDrop
+        >
+          {(props) => <DependencyTreeNode {...props} onDecision={handleDecision} />}
+        </Tree>
+      ) : null}
+    </div>
+  );
+}
+
+interface DependencyTreeNodeProps extends NodeRendererProps<entities.TreeNode> {
+  onDecision: (node: entities.TreeNode, action: FilterAction) => void;
+}
+
+function DependencyTreeNode({ node, style, onDecision }: DependencyTreeNodeProps) {
+  const isManifest = node.data.isFolder;
+
+  const row = (
+    <div
+      className="flex cursor-pointer items-center gap-1 rounded-md px-2 hover:bg-accent"
+      style={style}
+      onClick={() => isManifest && node.toggle()}
+      title={isManifest ? node.data.path : getDependencyPurl(node.data)}
+    >
+      {isManifest ? (
+        <div className="flex h-4 w-4 items-center justify-center">
+          {node.isOpen ? <ChevronDown className="h-3 w-3" /> : <ChevronRight className="h-3 w-3" />}
+        </div>
+      ) : null}
+      <div className="relative">
+        {isManifest ? <FileText className="h-3 w-3 shrink-0" /> : <Package className="h-3 w-3 shrink-0" />}
+        <span
+          className={clsx(
+            'absolute bottom-0 right-0 h-1 w-1 rounded-full',
+            node.data.workflowState === 'pending' ? 'bg-yellow-500' : 'bg-green-500'
+          )}
+        ></span>
+      </div>
+      <span className="truncate text-sm">{node.data.name}</span>
+    </div>
+  );
+
+  if (isManifest) {
+    return row;
+  }
+
+  return (
+    <ContextMenu>
+      <ContextMenuTrigger>{row}</ContextMenuTrigger>
+      <ContextMenuContent>
+        {[FilterAction.Include, FilterAction.Remove].map((action) => (
+          <ContextMenuItem key={action} onClick={() => onDecision(node.data, action)}>
+            {filterActionLabelMap[action]}
+          </ContextMenuItem>
+        ))}
+      </ContextMenuContent>
+    </ContextMenu>
+  );
+}
@@ -23,16 +23,17 @@
 
 import { useQuery } from '@tanstack/react-query';
 import { FileSearch } from 'lucide-react';
-import { memo } from 'react';
+import { memo, useEffect } from 'react';
 import { v4 as uuidv4 } from 'uuid';
 
 import CodeViewer from '@/components/CodeViewer';
 import useSelectedResult from '@/hooks/useSelectedResult';
+import { MonacoManager } from '@/lib/editor';
 import { getFileName } from '@/lib/utils';
 
 import { entities } from '../../wailsjs/go/models';
 import { GetComponentByPath } from '../../wailsjs/go/service/ComponentServiceImpl';
-import { GetLocalFile, GetRemoteFile } from '../../wailsjs/go/service/FileServiceImpl';
+import { GetLocalFile, GetMatchRanges, GetRemoteFile } from '../../wailsjs/go/service/FileServiceImpl';
 import { EventsEmit } from '../../wailsjs/runtime/runtime';
 import EditorToolbar from './EditorToolbar';
 import EmptyState from './EmptyState';
@@ -74,6 +75,17 @@ export default function MatchComparison() {
     enabled: !!selectedResult?.path,
   });
 
+  // Validated local/remote range pairs, used to highlight both files and keep them aligned while scrolling
+  const { data: matchRanges } = useQuery({
+    queryKey: ['matchRanges', selectedResult?.path],
+    queryFn: () => GetMatchRanges(selectedResult?.path as string),
+    enabled: !!selectedResult?.path && !!component?.lines,
+  });
+
+  useEffect(() => {
+    MonacoManager.getInstance().setLinePairs(matchRanges?.pairs ?? []);
+  }, [matchRanges]);
+
   if (!selectedResult) {
     return (
       <EmptyState
@@ -117,7 +129,9 @@ export default function MatchComparison() {
                 isError={isErrorLocalFileContent}
                 error={errorLocalFileContent}
                 language={localFileContent?.language}
-                highlightLines={component?.lines}
+                highlightAll={component?.matches_all_lines && !matchRanges?.local.length}
+                highlightRanges={matchRanges?.local ?? component?.line_ranges}
+                side="local"
                 editorId={uuidv4()}
               />
             )}
@@ -131,7 +145,9 @@ export default function MatchComparison() {
                 isError={isErrorRemoteFileContent}
                 error={errorRemoteFileContent}
                 language={remoteFileContent?.language}
-                highlightLines={component?.oss_lines}
+                highlightAll={component?.oss_matches_all_lines && !matchRanges?.remote.length}
+                highlightRanges={matchRanges?.remote ?? component?.oss_line_ranges}
+                side="remote"
                 editorId={uuidv4()}
               />
             )}
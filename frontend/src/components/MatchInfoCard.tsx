@@ -88,6 +88,14 @@ export default function MatchInfoCard() {
             <div className={clsx(removedStyles)}>{component.licenses?.[0].name}</div>
           </div>
         ) : null}
+        {component.cryptography?.length ? (
+          <div>
+            <div className={matchPresentation.muted}>Cryptography</div>
+            <div className={clsx(removedStyles)}>
+              {component.cryptography.map((c) => (c.strength ? `${c.algorithm.toUpperCase()}-${c.strength}` : c.algorithm.toUpperCase())).join(', ')}
+            </div>
+          </div>
+        ) : null}
         <div>
           <div className={matchPresentation.muted}>Detected</div>
           <div className={matchPresentation.accent}>{matchPresentation.label}</div>
@@ -21,25 +21,30 @@
  * SOFTWARE.
  */
 
+import { useQuery } from '@tanstack/react-query';
 import { AnimatePresence, motion } from 'framer-motion';
-import { Check, CircleStop, ExternalLink, Folder, Loader2 } from 'lucide-react';
+import { Check, ChevronRight, CircleStop, ExternalLink, Folder, Loader2 } from 'lucide-react';
 import { useEffect, useState } from 'react';
 
 import { Button } from '@/components/ui/button';
+import { Collapsible, CollapsibleContent, CollapsibleTrigger } from '@/components/ui/collapsible';
 import { Dialog, DialogContent, DialogDescription, DialogFooter, DialogHeader, DialogTitle } from '@/components/ui/dialog';
 import { Input } from '@/components/ui/input';
 import { Label } from '@/components/ui/label';
+import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from '@/components/ui/select';
 import { useResults } from '@/hooks/useResults';
 import { withErrorHandling } from '@/lib/errors';
+import { getScanService, ScanProgress } from '@/lib/scanner';
 import useResultsStore from '@/modules/results/stores/useResultsStore';
 import useConfigStore from '@/stores/useConfigStore';
 
 import { GetScanRoot, JoinPaths, SelectDirectory } from '../../wailsjs/go/main/App';
 import { entities } from '../../wailsjs/go/models';
-import { AbortScan, GetScanArgs, ScanStream } from '../../wailsjs/go/service/ScanServicePythonImpl';
+import { GetAll as GetScanPresets } from '../../wailsjs/go/service/ScanPresetServiceImpl';
 import { EventsOn } from '../../wailsjs/runtime/runtime';
 import Link from './Link';
 import ScanOption from './ScanOption';
+import ScanProgressPanel from './ScanProgressPanel';
 import TerminalOutput from './TerminalOutput';
 import { useToast } from './ui/use-toast';
 
@@ -50,6 +55,13 @@ interface OutputLine {
 
 type ScanStatus = 'idle' | 'scanning' | 'completed' | 'failed';
 
+type ScanOptionValue = string | number | boolean | string[];
+
+const NO_PRESET = 'none';
+
+// Options that depend on the scanned folder rather than on a preset
+const FOLDER_OPTIONS = ['output', 'settings'];
+
 interface ScanDialogProps {
   open: boolean;
   onOpenChange: () => void;
@@ -62,13 +74,22 @@ export default function ScanDialog({ open, onOpenChange }: ScanDialogProps) {
   const setScanRoot = useConfigStore((state) => state.setScanRoot);
 
   const [output, setOutput] = useState<OutputLine[]>([]);
+  const [progress, setProgress] = useState<ScanProgress | null>(null);
+  const [showLog, setShowLog] = useState(false);
   const [scanStatus, setScanStatus] = useState<ScanStatus>('idle');
   const [showSuccess, setShowSuccess] = useState(false);
 
   const [directory, setDirectory] = useState('');
   const [scanArgs, setScanArgs] = useState<entities.ScanArgDef[]>([]);
   const [advancedScanArgs, setAdvancedScanArgs] = useState<string[]>([]);
-  const [options, setOptions] = useState<Record<string, string | number | boolean | string[]>>({});
+  const [options, setOptions] = useState<Record<string, ScanOptionValue>>({});
+  const [selectedPreset, setSelectedPreset] = useState(NO_PRESET);
+
+  const { data: presets } = useQuery({
+    queryKey: ['scanPresets', initialScanRoot],
+    queryFn: GetScanPresets,
+    enabled: open,
+  });
 
   const { reset: resetResults } = useResults();
   const setSelectedResults = useResultsStore((state) => state.setSelectedResults);
@@ -116,36 +137,21 @@ export default function ScanDialog({ open, onOpenChange }: ScanDialogProps) {
     asyncFn: async () => {
       setScanStatus('scanning');
       setOutput([]);
+      setProgress(null);
 
-      const cmdArgs: string[] = [directory];
-
-      // Build command arguments based on type:
-      // - bool: send if true (CLI flags are "enable only")
-      // - string: send if non-empty
-      // - int: always send
-      // - stringSlice: send if has items
-      // - unknown: send if differs from default
-      Object.entries(options).forEach(([key, value]) => {
-        const arg = scanArgs.find((a) => a.Name === key);
-
-        if (arg) {
-          if (arg.Type === 'bool' && value === true) {
-            cmdArgs.push(`--${key}`);
-          } else if (arg.Type === 'string' && value) {
-            cmdArgs.push(`--${key}`, String(value));
-          } else if (arg.Type === 'int') {
-            cmdArgs.push(`--${key}`, String(value));
-          } else if (arg.Type === 'stringSlice' && Array.isArray(value) && value.length > 0) {
-            (value as string[]).forEach((v) => cmdArgs.push(`--${key}`, v));
-          } else if (value !== arg.Default) {
-            cmdArgs.push(`--${key}`, String(value));
-          }
-        }
-      });
-
-      cmdArgs.push(...advancedScanArgs);
+      // The review session is opened on the folder first, so the post-scan hooks run on its results
+      await setScanRoot(directory);
 
-      await ScanStream(cmdArgs);
+      // Options are sent typed and validated on the backend, which builds the scanner arguments
+      const scanService = await getScanService();
+      await scanService.ScanWithOptions(
+        entities.ScanRequest.createFrom({
+          path: directory,
+          options,
+          extra_args: advancedScanArgs.filter(Boolean),
+        })
+      );
+      // Opening it again loads the new results
       await setScanRoot(directory);
       setSelectedResults([]);
       resetResults();
@@ -154,7 +160,7 @@ export default function ScanDialog({ open, onOpenChange }: ScanDialogProps) {
       console.error('Failed to scan:', error);
       toast({
         title: 'Error',
-        description: 'An error occurred while scanning. Please try again.',
+        description: typeof error === 'string' ? error : 'An error occurred while scanning. Please try again.',
         variant: 'destructive',
       });
     },
@@ -165,7 +171,8 @@ export default function ScanDialog({ open, onOpenChange }: ScanDialogProps) {
 
   const handleAbortScan = withErrorHandling({
     asyncFn: async () => {
-      await AbortScan();
+      const scanService = await getScanService();
+      await scanService.AbortScan();
     },
     onError: (error) => {
       console.error('Failed to abort scan', error);
@@ -177,10 +184,24 @@ export default function ScanDialog({ open, onOpenChange }: ScanDialogProps) {
     },
   });
 
-  const handleOptionChange = (name: string, value: string | number | boolean | string[]) => {
+  const handleOptionChange = (name: string, value: ScanOptionValue) => {
     setOptions((prev) => ({ ...prev, [name]: value }));
   };
 
+  // Selecting a preset resets every option to its default before applying the preset, so presets do not stack
+  const handlePresetChange = (name: string) => {
+    setSelectedPreset(name);
+    const preset = presets?.find((p) => p.name === name);
+
+    setOptions((prev) => {
+      const next: Record<string, ScanOptionValue> = {};
+      scanArgs.forEach((arg) => {
+        next[arg.Name] = FOLDER_OPTIONS.includes(arg.Name) ? prev[arg.Name] : arg.Default;
+      });
+      return { ...next, ...(preset?.args as Record<string, ScanOptionValue> | undefined) };
+    });
+  };
+
   const handleFileSelect = (name: string) => {
     return withErrorHandling({
       asyncFn: async () => {
@@ -206,15 +227,17 @@ export default function ScanDialog({ open, onOpenChange }: ScanDialogProps) {
     async function initialize() {
       try {
         // Get scan arguments from backend
-        const args = await GetScanArgs();
+        const scanService = await getScanService();
+        const args = await scanService.GetScanArgs();
         setScanArgs(args);
 
         // Initialize options with default values
-        const initialOptions: Record<string, string | number | boolean | string[]> = {};
+        const initialOptions: Record<string, ScanOptionValue> = {};
         args.forEach((arg) => {
           initialOptions[arg.Name] = arg.Default;
         });
         setOptions(initialOptions);
+        setSelectedPreset(NO_PRESET);
 
         const dir = await GetScanRoot();
         setDirectory(dir);
@@ -239,6 +262,9 @@ export default function ScanDialog({ open, onOpenChange }: ScanDialogProps) {
       EventsOn('commandError', (data) => {
         setOutput((prev) => [...prev, { type: 'stderr', text: data }]);
       }),
+      EventsOn('scanProgress', (data: ScanProgress) => {
+        setProgress(data);
+      }),
       EventsOn('scanComplete', () => {
         setScanStatus('completed');
         setShowSuccess(true);
@@ -250,8 +276,18 @@ export default function ScanDialog({ open, onOpenChange }: ScanDialogProps) {
       }),
       EventsOn('scanFailed', (error) => {
         setScanStatus('failed');
+        setShowLog(true);
         setOutput((prev) => [...prev, { type: 'error', text: error }]);
       }),
+      EventsOn('postScanHook', (result: entities.PostScanHookResult) => {
+        const failed = result.status === 'failed';
+        const skipped = result.status === 'skipped';
+        const outcome = failed || skipped ? `${result.status}: ${result.error}` : result.status;
+        setOutput((prev) => [...prev, { type: failed || skipped ? 'error' : 'stdout', text: `Post-scan hook ${result.name} ${outcome} (${result.duration_ms} ms)` }]);
+        if (failed || skipped) {
+          setShowLog(true);
+        }
+      }),
     ];
 
     return () => subs.forEach((unsub) => unsub());
@@ -279,6 +315,29 @@ export default function ScanDialog({ open, onOpenChange }: ScanDialogProps) {
             </div>
           </div>
 
+          {presets && presets.length > 0 && (
+            <div className="space-y-2">
+              <Label htmlFor="preset">Preset</Label>
+              <Select value={selectedPreset} onValueChange={handlePresetChange}>
+                <SelectTrigger id="preset" className="text-sm">
+                  <SelectValue />
+                </SelectTrigger>
+                <SelectContent>
+                  <SelectItem value={NO_PRESET}>Default options</SelectItem>
+                  {presets.map((preset) => (
+                    <SelectItem key={preset.name} value={preset.name}>
+                      <span>{preset.name}</span>
+                      <span className="ml-2 text-xs text-muted-foreground">
+                        {preset.description ? `${preset.description} · ` : ''}
+                        {preset.scope}
+                      </span>
+                    </SelectItem>
+                  ))}
+                </SelectContent>
+              </Select>
+            </div>
+          )}
+
           {/* Core Options */}
           <div className="grid grid-cols-4 gap-6">
             {coreOptions.map((arg) => (
@@ -319,11 +378,18 @@ export default function ScanDialog({ open, onOpenChange }: ScanDialogProps) {
           </div>
         </div>
         <DialogFooter className="flex flex-1 gap-2 sm:flex-col">
+          {progress && <ScanProgressPanel progress={progress} />}
+
           {output.length > 0 && (
-            <div className="space-y-2">
-              <Label>Console Output:</Label>
-              <TerminalOutput lines={output} />
-            </div>
+            <Collapsible open={showLog || !progress} onOpenChange={setShowLog} className="space-y-2">
+              <CollapsibleTrigger className="flex items-center gap-1 text-sm font-medium" disabled={!progress}>
+                {progress && <ChevronRight className={`h-4 w-4 transition-transform ${showLog ? 'rotate-90' : ''}`} />}
+                Console Output
+              </CollapsibleTrigger>
+              <CollapsibleContent>
+                <TerminalOutput lines={output} />
+              </CollapsibleContent>
+            </Collapsible>
           )}
 
           <div className="flex items-center justify-end gap-2">
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+import { useQuery, useQueryClient } from '@tanstack/react-query';
+import { History, Lock, RotateCcw, Trash2 } from 'lucide-react';
+import { useEffect } from 'react';
+
+import { entities } from '../../wailsjs/go/models';
+import { Close, Delete, GetOpenRun, List, Open } from '../../wailsjs/go/service/ScanHistoryServiceImpl';
+import { EventsOn } from '../../wailsjs/runtime/runtime';
+import { Button } from './ui/button';
+import {
+  DropdownMenu,
+  DropdownMenuContent,
+  DropdownMenuItem,
+  DropdownMenuLabel,
+  DropdownMenuSeparator,
+  DropdownMenuTrigger,
+} from './ui/dropdown-menu';
+import { toast } from './ui/use-toast';
+
+const formatRunDate = (run: entities.ScanRun) => new Date(run.created_at).toLocaleString();
+
+export default function ScanHistorySelector() {
+  const queryClient = useQueryClient();
+
+  const { data: runs } = useQuery({
+    queryKey: ['scanHistory'],
+    queryFn: List,
+  });
+
+  const { data: openRun } = useQuery({
+    queryKey: ['openScanRun'],
+    queryFn: GetOpenRun,
+  });
+
+  // A finished scan adds a run and goes back to the latest results
+  useEffect(() => {
+    return EventsOn('scanComplete', () => {
+      queryClient.invalidateQueries({ queryKey: ['scanHistory'] });
+      queryClient.invalidateQueries({ queryKey: ['openScanRun'] });
+    });
+  }, [queryClient]);
+
+  const switchTo = async (action: () => Promise<unknown>, errorMessage: string) => {
+    try {
+      await action();
+      // Every loaded result, component and tree comes from the run being shown
+      await queryClient.invalidateQueries();
+    } catch (e) {
+      toast({
+        variant: 'destructive',
+        title: 'Error',
+        description: `${errorMessage}: ${e}`,
+      });
+    }
+  };
+
+  const handleDelete = async (run: entities.ScanRun) => {
+    try {
+      await Delete(run.id);
+      if (openRun?.id === run.id) {
+        await queryClient.invalidateQueries();
+      } else {
+        await queryClient.invalidateQueries({ queryKey: ['scanHistory'] });
+      }
+    } catch (e) {
+      toast({
+        variant: 'destructive',
+        title: 'Error',
+        description: `Could not delete the scan run: ${e}`,
+      });
+    }
+  };
+
+  if (!runs?.length && !openRun) {
+    return null;
+  }
+
+  return (
+    <DropdownMenu>
+      <DropdownMenuTrigger asChild>
+        <Button size="sm" variant="outline" className="justify-start gap-2 whitespace-normal">
+          {openRun ? <Lock className="h-4 w-4" /> : <History className="h-4 w-4" />}
+          <span className="text-left">Scan: {openRun ? `${formatRunDate(openRun)} (read-only)` : 'Latest'}</span>
+        </Button>
+      </DropdownMenuTrigger>
+      <DropdownMenuContent align="start">
+        {openRun ? (
+          <>
+            <DropdownMenuItem className="gap-2" onClick={() => switchTo(Close, 'Could not go back to the latest results')}>
+              <RotateCcw className="h-4 w-4" />
+              Back to latest results
+            </DropdownMenuItem>
+            <DropdownMenuSeparator />
+          </>
+        ) : null}
+        <DropdownMenuLabel className="text-xs text-muted-foreground">Past scans</DropdownMenuLabel>
+        {runs?.map((run) => (
+          <DropdownMenuItem
+            key={run.id}
+            onClick={() => switchTo(() => Open(run.id), 'Could not open the scan run')}
+            className="justify-between gap-4"
+          >
+            <span className={openRun?.id === run.id ? 'font-semibold' : undefined}>
+              {formatRunDate(run)} · {run.result_count} files · {run.scanner}
+            </span>
+            <Trash2
+              className="h-3 w-3 text-muted-foreground hover:text-destructive"
+              onClick={(e) => {
+                e.stopPropagation();
+                handleDelete(run);
+              }}
+            />
+          </DropdownMenuItem>
+        ))}
+      </DropdownMenuContent>
+    </DropdownMenu>
+  );
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+import { AlertTriangle } from 'lucide-react';
+
+import { ScanPhase, ScanProgress } from '@/lib/scanner';
+
+const PHASE_LABELS: Record<ScanPhase, string> = {
+  collecting: 'Collecting files',
+  fingerprinting: 'Fingerprinting',
+  scanning: 'Scanning',
+  writing: 'Writing results',
+  done: 'Done',
+};
+
+function formatDuration(seconds: number): string {
+  const total = Math.max(0, Math.round(seconds));
+  const minutes = Math.floor(total / 60);
+  return minutes > 0 ? `${minutes}m ${total % 60}s` : `${total}s`;
+}
+
+function percentage(progress: ScanProgress): number {
+  if (progress.phase === 'done') return 100;
+  if (progress.batches_total > 0) return (progress.batches_completed / progress.batches_total) * 100;
+  return 0;
+}
+
+interface ScanProgressPanelProps {
+  progress: ScanProgress;
+}
+
+export default function ScanProgressPanel({ progress }: ScanProgressPanelProps) {
+  const percent = percentage(progress);
+
+  return (
+    <div className="space-y-2 rounded border p-3 text-sm">
+      <div className="flex items-center justify-between">
+        <span className="font-medium">{PHASE_LABELS[progress.phase] ?? progress.phase}</span>
+        <span className="text-xs text-muted-foreground">
+          {formatDuration(progress.elapsed_seconds)} elapsed
+          {progress.eta_seconds !== undefined && progress.phase !== 'done' && ` · ~${formatDuration(progress.eta_seconds)} left`}
+        </span>
+      </div>
+
+      <div className="h-2 w-full overflow-hidden rounded bg-muted">
+        <div className="h-full bg-primary transition-all" style={{ width: `${percent}%` }} />
+      </div>
+
+      <div className="grid grid-cols-2 gap-x-4 gap-y-1 text-xs text-muted-foreground">
+        <span>
+          Files fingerprinted: {progress.files_fingerprinted}
+          {progress.files_found > 0 && ` / ${progress.files_found}`}
+        </span>
+        <span>
+          Batches completed: {progress.batches_completed}
+          {progress.batches_total > 0 && ` / ${progress.batches_total}`}
+        </span>
+        <span>Batches sent: {progress.batches_sent}</span>
+        <span>Errors: {progress.errors.length}</span>
+      </div>
+
+      {progress.errors.length > 0 && (
+        <ul className="max-h-24 space-y-1 overflow-y-auto text-xs text-orange-500">
+          {progress.errors.map((error, i) => (
+            <li key={i} className="flex items-start gap-1">
+              <AlertTriangle className="mt-0.5 h-3 w-3 shrink-0" />
+              <span>
+                {error.batch > 0 && `Batch ${error.batch}${error.attempt > 0 ? `, attempt ${error.attempt}` : ''}: `}
+                {error.message}
+              </span>
+            </li>
+          ))}
+        </ul>
+      )}
+    </div>
+  );
+}
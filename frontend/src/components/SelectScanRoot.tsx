@@ -22,7 +22,7 @@
  */
 
 import { useQueryClient } from '@tanstack/react-query';
-import { Folder } from 'lucide-react';
+import { FileArchive, Folder } from 'lucide-react';
 
 import { useResults } from '@/hooks/useResults';
 import useSelectedResult from '@/hooks/useSelectedResult';
@@ -30,7 +30,7 @@ import { withErrorHandling } from '@/lib/errors';
 import { truncatePath } from '@/lib/utils';
 import useConfigStore from '@/stores/useConfigStore';
 
-import { SelectDirectory } from '../../wailsjs/go/main/App';
+import { SelectArchive, SelectDirectory } from '../../wailsjs/go/main/App';
 import { Tooltip, TooltipContent, TooltipTrigger } from './ui/tooltip';
 import { toast } from './ui/use-toast';
 
@@ -44,38 +44,55 @@ export default function SelectScanRoot() {
 
   const { reset: resetResults } = useResults();
 
-  const handleSelectScanRoot = withErrorHandling({
-    asyncFn: async () => {
-      const selectedDir = await SelectDirectory();
-      if (selectedDir) {
-        await setScanRoot(selectedDir);
-        resetResults();
-        await queryClient.invalidateQueries({
-          queryKey: ['localFileContent', selectedResult?.path],
+  const selectScanRoot = (select: () => Promise<string>) =>
+    withErrorHandling({
+      asyncFn: async () => {
+        const selectedPath = await select();
+        if (selectedPath) {
+          await setScanRoot(selectedPath);
+          resetResults();
+          await queryClient.invalidateQueries({
+            queryKey: ['localFileContent', selectedResult?.path],
+          });
+        }
+      },
+      onError: () => {
+        toast({
+          variant: 'destructive',
+          title: 'Error',
+          description: 'An error occurred while selecting the scan root. Please try again.',
         });
-      }
-    },
-    onError: () => {
-      toast({
-        variant: 'destructive',
-        title: 'Error',
-        description: 'An error occurred while selecting the scan root. Please try again.',
-      });
-    },
-  });
+      },
+    });
+
+  const handleSelectScanRoot = selectScanRoot(SelectDirectory);
+  const handleSelectArchive = selectScanRoot(SelectArchive);
 
   return (
-    <Tooltip>
-      <TooltipTrigger>
-        <span
-          className="flex h-full cursor-pointer items-center gap-1 p-1 text-xs hover:bg-accent hover:text-accent-foreground"
-          onClick={handleSelectScanRoot}
-        >
-          <Folder className="h-3 w-3 flex-shrink-0" />
-          {truncatePath(scanRoot)}
-        </span>
-      </TooltipTrigger>
-      <TooltipContent>{scanRoot}</TooltipContent>
-    </Tooltip>
+    <div className="flex h-full items-center">
+      <Tooltip>
+        <TooltipTrigger>
+          <span
+            className="flex h-full cursor-pointer items-center gap-1 p-1 text-xs hover:bg-accent hover:text-accent-foreground"
+            onClick={handleSelectScanRoot}
+          >
+            <Folder className="h-3 w-3 flex-shrink-0" />
+            {truncatePath(scanRoot)}
+          </span>
+        </TooltipTrigger>
+        <TooltipContent>{scanRoot}</TooltipContent>
+      </Tooltip>
+      <Tooltip>
+        <TooltipTrigger>
+          <span
+            className="flex h-full cursor-pointer items-center p-1 text-xs hover:bg-accent hover:text-accent-foreground"
+            onClick={handleSelectArchive}
+          >
+            <FileArchive className="h-3 w-3 flex-shrink-0" />
+          </span>
+        </TooltipTrigger>
+        <TooltipContent>Open an archive (zip, jar, tar.gz) as scan root</TooltipContent>
+      </Tooltip>
+    </div>
   );
 }
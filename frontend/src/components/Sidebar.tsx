@@ -37,11 +37,15 @@ import { FilterAction } from '@/modules/components/domain';
 import { MatchType, stateInfoPresentation } from '@/modules/results/domain';
 import useResultsStore from '@/modules/results/stores/useResultsStore';
 
+import DependencyTree from './DependencyTree';
 import Loading from './Loading';
 import MatchTypeSelector from './MatchTypeSelector';
+import ScanHistorySelector from './ScanHistorySelector';
 import SelectScanRoot from './SelectScanRoot';
 import SortSelector from './SortSelector';
+import ViewSelector from './ViewSelector';
 import { ScrollArea } from './ui/scroll-area';
+import { Skeleton } from './ui/skeleton';
 import { Tooltip, TooltipContent, TooltipTrigger } from './ui/tooltip';
 
 export default function Sidebar() {
@@ -57,9 +61,13 @@ export default function Sidebar() {
   const moveToNextResult = useResultsStore((state) => state.moveToNextResult);
   const moveToPreviousResult = useResultsStore((state) => state.moveToPreviousResult);
 
-  const { data: results, isLoading: isLoadingResults } = useResults();
+  const { isLoading: isLoadingResults } = useResults();
 
-  const { pendingResults = [], completedResults = [] } = results ?? {};
+  // Results are loaded page by page, the summary has the counts of every matching result
+  const pendingResults = useResultsStore((state) => state.pendingResults);
+  const completedResults = useResultsStore((state) => state.completedResults);
+  const summary = useResultsStore((state) => state.summary);
+  const pendingCount = summary?.pending ?? 0;
 
   const handleSelectFiles = (e: React.MouseEvent, result: entities.ResultDTO, selectionType: 'pending' | 'completed') => {
     e.preventDefault();
@@ -106,10 +114,10 @@ export default function Sidebar() {
     <aside className="flex h-full flex-col border-r border-border bg-black/20 backdrop-blur-md">
       <div className="flex h-auto min-h-[65px] items-center border-b border-b-border px-4">
         <div className="break-all text-xs">
-          {pendingResults?.length ? (
+          {pendingCount ? (
             <div className="flex flex-wrap items-center gap-1">
               <span className="font-semibold">
-                {pendingResults?.length} decision{pendingResults.length > 1 ? 's' : ''} to make in
+                {pendingCount} decision{pendingCount > 1 ? 's' : ''} to make in
               </span>{' '}
               <SelectScanRoot />
             </div>
@@ -125,8 +133,11 @@ export default function Sidebar() {
         <div className="flex flex-col gap-2">
           <MatchTypeSelector />
           <SortSelector />
+          <ViewSelector />
+          <ScanHistorySelector />
         </div>
         <ResultSearchBar searchInputRef={searchInputRef} />
+        <DependencyTree />
       </div>
 
       <div className="min-h-0 flex-1">
@@ -135,6 +146,7 @@ export default function Sidebar() {
             <ResultSection
               title="Pending files"
               results={pendingResults}
+              total={summary?.pending ?? 0}
               onSelect={handleSelectFiles}
               selectionType="pending"
               isLoading={isLoadingResults}
@@ -142,6 +154,7 @@ export default function Sidebar() {
             <ResultSection
               title="Completed files"
               results={completedResults}
+              total={summary?.completed ?? 0}
               onSelect={handleSelectFiles}
               selectionType="completed"
               isLoading={isLoadingResults}
@@ -156,19 +169,22 @@ export default function Sidebar() {
 interface ResultSectionProps {
   title: string;
   results: entities.ResultDTO[];
+  total: number;
   onSelect: (e: React.MouseEvent, result: entities.ResultDTO, selectionType: 'pending' | 'completed') => void;
   selectionType: 'pending' | 'completed';
   isLoading: boolean;
 }
 
-function ResultSection({ title, results, onSelect, selectionType, isLoading }: ResultSectionProps) {
+function ResultSection({ title, results, total, onSelect, selectionType, isLoading }: ResultSectionProps) {
   const parentRef = useRef<HTMLDivElement>(null);
   const ITEM_HEIGHT = 32;
   const lastSelectedIndex = useResultsStore((state) => state.lastSelectedIndex);
   const lastSelectionType = useResultsStore((state) => state.lastSelectionType);
+  const fetchMoreResults = useResultsStore((state) => state.fetchMoreResults);
 
+  // Rows past the loaded results are placeholders until their page is loaded
   const virtualizer = useVirtualizer({
-    count: results.length,
+    count: Math.max(total, results.length),
     getScrollElement: () => parentRef.current?.querySelector('[data-radix-scroll-area-viewport]') as Element,
     estimateSize: () => ITEM_HEIGHT,
     overscan: 5,
@@ -180,11 +196,20 @@ function ResultSection({ title, results, onSelect, selectionType, isLoading }: R
     }
   }, [lastSelectedIndex, lastSelectionType, selectionType, results.length, virtualizer]);
 
+  const virtualItems = virtualizer.getVirtualItems();
+  const lastVisibleIndex = virtualItems[virtualItems.length - 1]?.index ?? -1;
+
+  useEffect(() => {
+    if (lastVisibleIndex >= results.length - 1 && results.length < total) {
+      fetchMoreResults(selectionType);
+    }
+  }, [lastVisibleIndex, results.length, total, selectionType, fetchMoreResults]);
+
   return (
     <div className="flex h-1/2 flex-col">
       <div className="flex flex-shrink-0 items-center gap-1 border-b border-border px-3 pb-1">
         <span className="text-sm text-muted-foreground">
-          {title} {results.length > 0 ? <span className="text-xs">({results.length})</span> : null}
+          {title} {total > 0 ? <span className="text-xs">({total})</span> : null}
         </span>
       </div>
       <div className="min-h-0 flex-1" ref={parentRef}>
@@ -201,7 +226,7 @@ function ResultSection({ title, results, onSelect, selectionType, isLoading }: R
                 position: 'relative',
               }}
             >
-              {virtualizer.getVirtualItems().map((virtualRow) => (
+              {virtualItems.map((virtualRow) => (
                 <div
                   key={virtualRow.key}
                   style={{
@@ -213,7 +238,13 @@ function ResultSection({ title, results, onSelect, selectionType, isLoading }: R
                     transform: `translateY(${virtualRow.start}px)`,
                   }}
                 >
-                  <SidebarItem result={results[virtualRow.index]} onSelect={onSelect} selectionType={selectionType} />
+                  {results[virtualRow.index] ? (
+                    <SidebarItem result={results[virtualRow.index]} onSelect={onSelect} selectionType={selectionType} />
+                  ) : (
+                    <div className="px-4 py-1">
+                      <Skeleton className="h-4 w-full" />
+                    </div>
+                  )}
                 </div>
               ))}
             </div>
@@ -265,7 +296,8 @@ function SidebarItem({ result, onSelect, selectionType }: SidebarItemProps) {
         </div>
       </TooltipTrigger>
       <TooltipContent side="right" sideOffset={15}>
-        {result.path}
+        <p>{result.path}</p>
+        {result.origin && <p className="text-xs text-muted-foreground">From {result.origin}</p>}
       </TooltipContent>
     </Tooltip>
   );
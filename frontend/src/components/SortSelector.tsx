@@ -22,7 +22,21 @@
  */
 
 import clsx from 'clsx';
-import { ArrowDownNarrowWide, ArrowUpNarrowWide, FileText, Percent } from 'lucide-react';
+import {
+  ArrowDownNarrowWide,
+  ArrowUpNarrowWide,
+  Building2,
+  CalendarDays,
+  CircleCheck,
+  FileText,
+  FolderTree,
+  Gavel,
+  ListOrdered,
+  Package,
+  Percent,
+  Scale,
+  ShieldAlert,
+} from 'lucide-react';
 import { ReactNode } from 'react';
 
 import useDebounce from '@/hooks/useDebounce';
@@ -53,6 +67,60 @@ const sortOptions: SortOption[] = [
     icon: <FileText className="h-4 w-4" />,
     description: 'Sort alphabetically by file path',
   },
+  {
+    value: 'vulnerability_severity',
+    label: 'Vulnerability Severity',
+    icon: <ShieldAlert className="h-4 w-4" />,
+    description: 'Sort by the most severe known vulnerability',
+  },
+  {
+    value: 'component',
+    label: 'Component',
+    icon: <Package className="h-4 w-4" />,
+    description: 'Sort alphabetically by matched component name',
+  },
+  {
+    value: 'vendor',
+    label: 'Vendor',
+    icon: <Building2 className="h-4 w-4" />,
+    description: 'Sort alphabetically by component vendor',
+  },
+  {
+    value: 'license',
+    label: 'License',
+    icon: <Scale className="h-4 w-4" />,
+    description: 'Sort by the primary license of the match',
+  },
+  {
+    value: 'matched_lines',
+    label: 'Matched Lines',
+    icon: <ListOrdered className="h-4 w-4" />,
+    description: 'Sort by the number of matched lines',
+  },
+  {
+    value: 'workflow_state',
+    label: 'Workflow State',
+    icon: <CircleCheck className="h-4 w-4" />,
+    description: 'Group pending and completed files',
+  },
+  {
+    value: 'decision',
+    label: 'Decision',
+    icon: <Gavel className="h-4 w-4" />,
+    description: 'Group files by include, remove or replace decision',
+  },
+  {
+    value: 'release_date',
+    label: 'Release Date',
+    icon: <CalendarDays className="h-4 w-4" />,
+    description: 'Sort by the release date of the matched version',
+  },
+  {
+    value: 'folder_depth',
+    label: 'Folder Depth',
+    icon: <FolderTree className="h-4 w-4" />,
+    description: 'Sort by how deeply nested the file is',
+  },
 ];
 
 export default function SortSelector() {
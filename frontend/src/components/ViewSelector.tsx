@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+import { useQuery, useQueryClient } from '@tanstack/react-query';
+import { Bookmark, Save, Trash2 } from 'lucide-react';
+import { useEffect, useState } from 'react';
+
+import useResultsStore from '@/modules/results/stores/useResultsStore';
+
+import { entities } from '../../wailsjs/go/models';
+import { Delete, GetAll, GetStartupView, Save as SaveView } from '../../wailsjs/go/service/ResultViewServiceImpl';
+import { Button } from './ui/button';
+import { Dialog, DialogContent, DialogFooter, DialogHeader, DialogTitle } from './ui/dialog';
+import {
+  DropdownMenu,
+  DropdownMenuContent,
+  DropdownMenuItem,
+  DropdownMenuSeparator,
+  DropdownMenuTrigger,
+} from './ui/dropdown-menu';
+import { Input } from './ui/input';
+import { toast } from './ui/use-toast';
+
+export default function ViewSelector() {
+  const queryClient = useQueryClient();
+  const applyView = useResultsStore((state) => state.applyView);
+  const getCurrentFilter = useResultsStore((state) => state.getCurrentFilter);
+
+  const [activeView, setActiveView] = useState<string | null>(null);
+  const [isSaving, setIsSaving] = useState(false);
+  const [name, setName] = useState('');
+
+  const { data: views } = useQuery({
+    queryKey: ['views'],
+    queryFn: GetAll,
+  });
+
+  // Apply the view passed with --view once, when the app opens
+  useEffect(() => {
+    GetStartupView()
+      .then((view) => {
+        if (view) {
+          applyView(view);
+          setActiveView(view.name);
+        }
+      })
+      .catch(() => {});
+  }, [applyView]);
+
+  const handleApply = (view: entities.ResultView) => {
+    applyView(view);
+    setActiveView(view.name);
+  };
+
+  const handleSave = async () => {
+    try {
+      await SaveView(entities.ResultView.createFrom({ name, filter: getCurrentFilter() }));
+      await queryClient.invalidateQueries({ queryKey: ['views'] });
+      setActiveView(name.trim());
+      setIsSaving(false);
+    } catch (e) {
+      toast({
+        variant: 'destructive',
+        title: 'Error',
+        description: `Could not save the view: ${e}`,
+      });
+    }
+  };
+
+  const handleDelete = async (view: entities.ResultView) => {
+    try {
+      await Delete(view.name);
+      await queryClient.invalidateQueries({ queryKey: ['views'] });
+      if (activeView === view.name) {
+        setActiveView(null);
+      }
+    } catch (e) {
+      toast({
+        variant: 'destructive',
+        title: 'Error',
+        description: `Could not delete the view: ${e}`,
+      });
+    }
+  };
+
+  return (
+    <>
+      <DropdownMenu>
+        <DropdownMenuTrigger asChild>
+          <Button size="sm" variant="outline" className="justify-start gap-2 whitespace-normal">
+            <Bookmark className="h-4 w-4" />
+            <span className="text-left">View: {activeView ?? 'None'}</span>
+          </Button>
+        </DropdownMenuTrigger>
+        <DropdownMenuContent align="start">
+          {views?.map((view) => (
+            <DropdownMenuItem key={view.name} onClick={() => handleApply(view)} className="justify-between gap-4">
+              <span>{view.name}</span>
+              <Trash2
+                className="h-3 w-3 text-muted-foreground hover:text-destructive"
+                onClick={(e) => {
+                  e.stopPropagation();
+                  handleDelete(view);
+                }}
+              />
+            </DropdownMenuItem>
+          ))}
+          {views?.length ? <DropdownMenuSeparator /> : null}
+          <DropdownMenuItem
+            className="gap-2"
+            onClick={() => {
+              setName(activeView ?? '');
+              setIsSaving(true);
+            }}
+          >
+            <Save className="h-4 w-4" />
+            Save current view...
+          </DropdownMenuItem>
+        </DropdownMenuContent>
+      </DropdownMenu>
+
+      <Dialog open={isSaving} onOpenChange={setIsSaving}>
+        <DialogContent className="p-4">
+          <DialogHeader>
+            <DialogTitle>Save View</DialogTitle>
+          </DialogHeader>
+          <form
+            className="space-y-4"
+            onSubmit={(e) => {
+              e.preventDefault();
+              handleSave();
+            }}
+          >
+            <Input autoFocus placeholder="e.g. Pending snippets in src/" value={name} onChange={(e) => setName(e.target.value)} />
+            <DialogFooter>
+              <Button type="button" variant="ghost" onClick={() => setIsSaving(false)}>
+                Cancel
+              </Button>
+              <Button type="submit" disabled={!name.trim()}>
+                Save
+              </Button>
+            </DialogFooter>
+          </form>
+        </DialogContent>
+      </Dialog>
+    </>
+  );
+}
@@ -9,12 +9,13 @@ export const useResults = () => {
   const filterByMatchType = useResultsStore((state) => state.filterByMatchType);
   const query = useResultsStore((state) => state.query);
   const sort = useResultsStore((state) => state.sort);
+  const viewFilter = useResultsStore((state) => state.viewFilter);
   const fetchResults = useResultsStore((state) => state.fetchResults);
 
   const debouncedQuery = useDebounce<string>(query, DEBOUNCE_QUERY_MS);
 
   const { data, isLoading, refetch } = useQuery({
-    queryKey: ['results', debouncedQuery, filterByMatchType, sort] as const,
+    queryKey: ['results', debouncedQuery, filterByMatchType, sort, viewFilter] as const,
     queryFn: () => fetchResults(),
   });
 
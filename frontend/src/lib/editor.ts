@@ -30,8 +30,11 @@ export interface EditorManager {
   getScrollSyncEnabled(): boolean;
 }
 
+export type EditorSide = 'local' | 'remote';
+
 interface AddEditorOptions {
   revealLine?: number;
+  side?: EditorSide;
 }
 
 export interface HighlightRange {
@@ -39,9 +42,41 @@ export interface HighlightRange {
   end: number;
 }
 
+export interface LinePair {
+  local: HighlightRange;
+  remote: HighlightRange;
+}
+
+// mapLine returns the line of the other side matching a line, following the offset of the closest
+// pair at or before it. Lines before the first pair keep the offset of the first pair.
+export function mapLine(line: number, pairs: LinePair[], from: EditorSide): number {
+  const to: EditorSide = from === 'local' ? 'remote' : 'local';
+  const sorted = [...pairs].sort((a, b) => a[from].start - b[from].start);
+
+  let pair = sorted[0];
+  for (const candidate of sorted) {
+    if (candidate[from].start > line) break;
+    pair = candidate;
+  }
+  if (!pair) return line;
+
+  const offset = line - pair[from].start;
+  const length = pair[from].end - pair[from].start;
+  if (offset >= 0 && offset <= length) {
+    // Inside the pair, scale the position to the length of the other range
+    const ratio = length > 0 ? offset / length : 0;
+    return Math.round(pair[to].start + ratio * (pair[to].end - pair[to].start));
+  }
+  if (offset > length) {
+    return pair[to].end + (offset - length);
+  }
+  return pair[to].start + offset;
+}
+
 export class MonacoManager implements EditorManager {
   private static instance: MonacoManager;
-  private editors: { id: string; editor: monaco.editor.IStandaloneCodeEditor }[] = [];
+  private editors: { id: string; editor: monaco.editor.IStandaloneCodeEditor; side?: EditorSide }[] = [];
+  private linePairs: LinePair[] = [];
   private scrollSyncListeners: { [id: string]: monaco.IDisposable } = {};
   private scrollSyncEnabled = true;
   private isScrolling = false;
@@ -70,9 +105,9 @@ export class MonacoManager implements EditorManager {
   public addEditor(id: string, editor: monaco.editor.IStandaloneCodeEditor, options?: AddEditorOptions) {
     const existingEditorIndex = this.editors.findIndex((e) => e.id === id);
     if (existingEditorIndex > -1) {
-      this.editors[existingEditorIndex] = { id, editor };
+      this.editors[existingEditorIndex] = { id, editor, side: options?.side };
     } else {
-      this.editors.push({ id, editor });
+      this.editors.push({ id, editor, side: options?.side });
     }
 
     if (options?.revealLine) {
@@ -91,6 +126,11 @@ export class MonacoManager implements EditorManager {
     }
   }
 
+  // setLinePairs sets the local/remote line pairs of the match, so scrolling keeps matching lines aligned
+  public setLinePairs(pairs: LinePair[]) {
+    this.linePairs = pairs;
+  }
+
   public getScrollSyncEnabled(): boolean {
     return this.scrollSyncEnabled;
   }
@@ -136,18 +176,27 @@ export class MonacoManager implements EditorManager {
           const sourceLineHeight = editor.getOption(monaco.editor.EditorOption.lineHeight);
           const linesScrolled = deltaY / sourceLineHeight;
 
+          const sourceSide = this.editors.find((e) => e.id === id)?.side;
+
           // Pre-calculate scroll values for better performance
           const scrollUpdates = this.editors
             .filter(({ id: otherId }) => otherId !== id)
-            .map(({ editor: otherEditor }) => {
+            .map(({ editor: otherEditor, side: otherSide }) => {
               const targetLineHeight = otherEditor.getOption(monaco.editor.EditorOption.lineHeight);
               const currentOtherScrollTop = otherEditor.getScrollTop();
               const maxScrollTop = otherEditor.getScrollHeight() - otherEditor.getLayoutInfo().height;
-              const targetDeltaY = linesScrolled * targetLineHeight;
+
+              let scrollTop = currentOtherScrollTop + linesScrolled * targetLineHeight;
+              if (sourceSide && otherSide && sourceSide !== otherSide && this.linePairs.length) {
+                // Align the matching line of the other side with the top line of the scrolled editor
+                const topLine = currentScrollTop / sourceLineHeight + 1;
+                const targetLine = mapLine(Math.floor(topLine), this.linePairs, sourceSide);
+                scrollTop = otherEditor.getTopForLineNumber(targetLine) + (topLine % 1) * targetLineHeight;
+              }
 
               return {
                 editor: otherEditor,
-                scrollTop: Math.max(0, Math.min(currentOtherScrollTop + targetDeltaY, maxScrollTop)),
+                scrollTop: Math.max(0, Math.min(scrollTop, maxScrollTop)),
                 scrollLeft: currentScrollLeft,
               };
             });
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+import { GetScanner } from '../../wailsjs/go/main/App';
+import * as NativeScanner from '../../wailsjs/go/service/ScanServiceNativeImpl';
+import * as PythonScanner from '../../wailsjs/go/service/ScanServicePythonImpl';
+
+// The scan engine is selected with --scanner when the app starts
+export async function getScanService() {
+  return (await GetScanner()) === 'native' ? NativeScanner : PythonScanner;
+}
+
+export type ScanPhase = 'collecting' | 'fingerprinting' | 'scanning' | 'writing' | 'done';
+
+export interface ScanBatchError {
+  batch: number;
+  attempt: number;
+  message: string;
+}
+
+// Payload of the scanProgress event, see entities.ScanProgress
+export interface ScanProgress {
+  phase: ScanPhase;
+  files_found: number;
+  files_fingerprinted: number;
+  batches_total: number;
+  batches_sent: number;
+  batches_completed: number;
+  elapsed_seconds: number;
+  eta_seconds?: number;
+  errors: ScanBatchError[];
+}
@@ -28,6 +28,7 @@ import useResultsStore from '@/modules/results/stores/useResultsStore';
 
 import { entities } from '../../../../wailsjs/go/models';
 import { CanRedo, CanUndo, FilterComponents, Redo, Undo } from '../../../../wailsjs/go/service/ComponentServiceImpl';
+import { GetAll } from '../../../../wailsjs/go/service/ResultServiceImpl';
 import { FilterAction } from '../domain';
 
 interface ComponentFilterState {
@@ -74,9 +75,9 @@ const useComponentFilterStore = create<ComponentFilterStore>()(
         // Ensure folder path ends with /
         const normalizedFolderPath = folderPath.endsWith('/') ? folderPath : folderPath + '/';
 
-        // Get all unique purls for files in this folder (from both pending and completed results)
-        const { pendingResults, completedResults } = useResultsStore.getState();
-        const allResults = [...pendingResults, ...completedResults];
+        // Get all unique purls for files in this folder. Only some pages of results are loaded, so the
+        // folder results are requested from the backend
+        const allResults = await GetAll(entities.RequestResultDTO.createFrom({ query: `path:"${normalizedFolderPath}**"` }));
         const purlsInFolder = [...new Set(
           allResults
             .filter(r => r.path.startsWith(normalizedFolderPath))
@@ -23,3 +23,5 @@
 
 export const DEBOUNCE_QUERY_MS = 300;
 export const DEBOUNCE_RESET_RESULTS_MS = 300;
+// Results are loaded in pages as the lists are scrolled, so large scans don't block the UI
+export const RESULTS_PAGE_SIZE = 500;
@@ -25,26 +25,52 @@ import { create } from 'zustand';
 import { devtools } from 'zustand/middleware';
 
 import { entities } from '../../../../wailsjs/go/models';
-import { GetAll } from '../../../../wailsjs/go/service/ResultServiceImpl';
+import { GetPage, GetSummary } from '../../../../wailsjs/go/service/ResultServiceImpl';
+import { RESULTS_PAGE_SIZE } from '../constants';
 import { MatchType } from '../domain';
 
+type SelectionType = 'pending' | 'completed';
+
+// fetchPages loads the first count results of one workflow state, one page at a time in parallel
+const fetchPages = async (filter: entities.RequestResultDTO, workflowState: SelectionType, count: number) => {
+  const pageFilter = entities.RequestResultDTO.createFrom({ ...filter, workflow_state: workflowState });
+  const offsets = Array.from({ length: Math.max(1, Math.ceil(count / RESULTS_PAGE_SIZE)) }, (_, i) => i * RESULTS_PAGE_SIZE);
+  const pages = await Promise.all(
+    offsets.map((offset) => GetPage(entities.RequestResultPageDTO.createFrom({ filter: pageFilter, offset, limit: RESULTS_PAGE_SIZE })))
+  );
+  return pages.flatMap((page) => page.results ?? []);
+};
+
 interface ResultsState {
   completedResults: entities.ResultDTO[];
   lastSelectedIndex: number;
   lastSelectionType: 'pending' | 'completed' | null;
   pendingResults: entities.ResultDTO[];
   selectedResults: entities.ResultDTO[];
+  // Counts of every result matching the filter, including the ones not loaded yet
+  summary: entities.ResultSummaryDTO | null;
+  loadingMore: Record<SelectionType, boolean>;
+  // Identifies the filter of the loaded pages, so pages of a previous filter are not appended
+  loadedFilterKey: string;
   query: string;
   filterByMatchType: MatchType | 'all';
   sort: {
     option: string;
     order: 'asc' | 'desc';
+    then_by?: entities.SortKey[];
   };
+  // Filters from the applied saved view that have no control in the sidebar
+  viewFilter: Partial<entities.RequestResultDTO>;
 }
 
 interface ResultsActions {
-  fetchResults: () => Promise<{ pendingResults: entities.ResultDTO[]; completedResults: entities.ResultDTO[] }>;
-  moveToNextResult: (skip?: (r: entities.ResultDTO) => boolean) => void;
+  fetchResults: () => Promise<{
+    pendingResults: entities.ResultDTO[];
+    completedResults: entities.ResultDTO[];
+    summary: entities.ResultSummaryDTO;
+  }>;
+  fetchMoreResults: (type: SelectionType) => Promise<void>;
+  moveToNextResult: (skip?: (r: entities.ResultDTO) => boolean) => Promise<void>;
   moveToPreviousResult: () => void;
   selectResultRange: (endResult: entities.ResultDTO, selectionType: 'pending' | 'completed') => void;
   setLastSelectedIndex: (index: number) => void;
@@ -54,6 +80,8 @@ interface ResultsActions {
   setQuery: (query: string) => void;
   setFilterByMatchType: (matchType: MatchType | 'all') => void;
   setSort: (option: string, order: 'asc' | 'desc') => void;
+  applyView: (view: entities.ResultView) => void;
+  getCurrentFilter: () => entities.RequestResultDTO;
 }
 
 type ResultsStore = ResultsState & ResultsActions;
@@ -66,6 +94,9 @@ const useResultsStore = create<ResultsStore>()(
     error: null,
     lastSelectedIndex: -1,
     selectedResults: [],
+    summary: null,
+    loadingMore: { pending: false, completed: false },
+    loadedFilterKey: '',
     lastSelectionType: null,
     query: '',
     filterByMatchType: 'all',
@@ -73,6 +104,40 @@ const useResultsStore = create<ResultsStore>()(
       option: 'path',
       order: 'asc' as const,
     },
+    viewFilter: {},
+
+    applyView: (view) => {
+      const { match_type, query, sort, ...viewFilter } = view.filter;
+      set(
+        {
+          query: query ?? '',
+          filterByMatchType: (match_type as MatchType) || 'all',
+          sort: {
+            option: sort?.option || 'path',
+            order: sort?.order === 'desc' ? 'desc' : 'asc',
+            then_by: sort?.then_by,
+          },
+          viewFilter,
+          selectedResults: [],
+        },
+        false,
+        'APPLY_VIEW'
+      );
+    },
+
+    getCurrentFilter: () => {
+      const { filterByMatchType, query, sort, viewFilter } = get();
+      return entities.RequestResultDTO.createFrom({
+        ...viewFilter,
+        match_type: filterByMatchType === 'all' ? undefined : filterByMatchType,
+        query,
+        sort: {
+          option: sort.option,
+          order: sort.order,
+          then_by: sort.then_by,
+        },
+      });
+    },
 
     setSort: (option, order) => {
       set({ sort: { option, order } }, false, 'SET_SORT');
@@ -84,22 +149,20 @@ const useResultsStore = create<ResultsStore>()(
     setLastSelectionType: (type: 'pending' | 'completed') => set({ lastSelectionType: type }, false, 'SET_LAST_SELECTION_TYPE'),
 
     fetchResults: async () => {
-      const { selectedResults, filterByMatchType, query, sort } = get();
-      const results = await GetAll(
-        entities.RequestResultDTO.createFrom({
-          match_type: filterByMatchType === 'all' ? undefined : filterByMatchType,
-          query,
-          sort: {
-            option: sort.option,
-            order: sort.order,
-          },
-        })
-      );
+      const { selectedResults, getCurrentFilter, loadedFilterKey, pendingResults: loadedPending, completedResults: loadedCompleted } = get();
+      const filter = getCurrentFilter();
+      const filterKey = JSON.stringify(filter);
 
-      const pendingResults = results.filter((r) => r.workflow_state === 'pending');
-      const completedResults = results.filter((r) => r.workflow_state === 'completed');
+      // Reloading the same filter, e.g. after a decision, keeps as many rows loaded as before so the
+      // selection and scroll position don't jump back to the first page
+      const sameFilter = filterKey === loadedFilterKey;
+      const summary = await GetSummary(filter);
+      const [pendingResults, completedResults] = await Promise.all([
+        summary.pending ? fetchPages(filter, 'pending', sameFilter ? loadedPending.length : RESULTS_PAGE_SIZE) : [],
+        summary.completed ? fetchPages(filter, 'completed', sameFilter ? loadedCompleted.length : RESULTS_PAGE_SIZE) : [],
+      ]);
 
-      set({ pendingResults, completedResults });
+      set({ pendingResults, completedResults, summary, loadedFilterKey: filterKey });
 
       // Sync selectedResults with fresh data so stale objects don't persist
       if (selectedResults.length) {
@@ -121,7 +184,34 @@ const useResultsStore = create<ResultsStore>()(
         set({ selectedResults, lastSelectionType: firstSelectedResult?.workflow_state as 'pending' | 'completed', lastSelectedIndex: 0 });
       }
 
-      return { pendingResults, completedResults };
+      return { pendingResults, completedResults, summary };
+    },
+
+    fetchMoreResults: async (type) => {
+      const { summary, loadingMore, loadedFilterKey, getCurrentFilter } = get();
+      const loaded = type === 'pending' ? get().pendingResults : get().completedResults;
+      const total = type === 'pending' ? summary?.pending : summary?.completed;
+      if (loadingMore[type] || !total || loaded.length >= total) return;
+
+      set({ loadingMore: { ...get().loadingMore, [type]: true } }, false, 'FETCH_MORE_RESULTS');
+      try {
+        const filter = getCurrentFilter();
+        const page = await GetPage(
+          entities.RequestResultPageDTO.createFrom({
+            filter: entities.RequestResultDTO.createFrom({ ...filter, workflow_state: type }),
+            offset: loaded.length,
+            limit: RESULTS_PAGE_SIZE,
+          })
+        );
+
+        // The filter changed or the results were reloaded while the page was loading
+        const current = type === 'pending' ? get().pendingResults : get().completedResults;
+        if (get().loadedFilterKey !== loadedFilterKey || current.length !== loaded.length) return;
+
+        set(type === 'pending' ? { pendingResults: [...current, ...(page.results ?? [])] } : { completedResults: [...current, ...(page.results ?? [])] });
+      } finally {
+        set({ loadingMore: { ...get().loadingMore, [type]: false } });
+      }
     },
 
     toggleResultSelection: (result, selectionType) =>
@@ -177,17 +267,27 @@ const useResultsStore = create<ResultsStore>()(
         };
       }),
 
-    moveToNextResult: (skip?: (r: entities.ResultDTO) => boolean) => {
-      const { pendingResults, completedResults, lastSelectionType, selectedResults } = get();
-
-      const resultsOfType = lastSelectionType === 'pending' ? pendingResults : completedResults;
+    moveToNextResult: async (skip?: (r: entities.ResultDTO) => boolean) => {
+      const { lastSelectionType, selectedResults } = get();
       const lastResultInSelection = selectedResults[selectedResults.length - 1]; // To handle multi select as well
+      if (!lastResultInSelection) return;
+
+      const currentType: SelectionType = lastSelectionType ?? 'pending';
+      let resultsOfType = currentType === 'pending' ? get().pendingResults : get().completedResults;
       const currentResultIndex = resultsOfType.findIndex((r) => r.path === lastResultInSelection.path);
 
-      // Find next result that passes the skip filter
+      // Find next result that passes the skip filter, loading the next page when reaching the end of the loaded ones
       let nextIndex = currentResultIndex + 1;
-      while (nextIndex < resultsOfType.length && skip?.(resultsOfType[nextIndex])) {
-        nextIndex++;
+      for (;;) {
+        while (nextIndex < resultsOfType.length && skip?.(resultsOfType[nextIndex])) {
+          nextIndex++;
+        }
+        if (nextIndex < resultsOfType.length) break;
+
+        const loadedCount = resultsOfType.length;
+        await get().fetchMoreResults(currentType);
+        resultsOfType = currentType === 'pending' ? get().pendingResults : get().completedResults;
+        if (resultsOfType.length === loadedCount) break;
       }
 
       if (nextIndex < resultsOfType.length) {
@@ -200,7 +300,7 @@ const useResultsStore = create<ResultsStore>()(
       }
 
       // Reached end of current list — try opposite type
-      const oppositeResults = lastSelectionType === 'pending' ? completedResults : pendingResults;
+      const oppositeResults = lastSelectionType === 'pending' ? get().completedResults : get().pendingResults;
       const nextSelectionType = lastSelectionType === 'pending' ? 'completed' : 'pending';
       const nextResult = skip ? oppositeResults.find((r) => !skip(r)) : oppositeResults[0];
 
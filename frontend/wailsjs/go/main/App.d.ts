@@ -6,7 +6,7 @@ import {menu} from '../models';
 
 export function BeforeClose(arg1:context.Context):Promise<boolean>;
 
-export function BuildMenu(arg1:service.KeyboardService):Promise<menu.Menu>;
+export function BuildMenu(arg1:service.KeyboardService,arg2:service.CryptographyService):Promise<menu.Menu>;
 
 export function GetRecentScanRoots():Promise<Array<string>>;
 
@@ -16,10 +16,14 @@ export function GetScanRoot():Promise<string>;
 
 export function GetScanSettingsFilePath():Promise<string>;
 
+export function GetScanner():Promise<string>;
+
 export function Init(arg1:context.Context,arg2:service.ScanossSettingsService,arg3:service.KeyboardService):Promise<void>;
 
 export function JoinPaths(arg1:Array<string>):Promise<string>;
 
+export function SelectArchive():Promise<string>;
+
 export function SelectDirectory():Promise<string>;
 
 export function SelectFile(arg1:string):Promise<string>;
@@ -6,8 +6,8 @@ export function BeforeClose(arg1) {
   return window['go']['main']['App']['BeforeClose'](arg1);
 }
 
-export function BuildMenu(arg1) {
-  return window['go']['main']['App']['BuildMenu'](arg1);
+export function BuildMenu(arg1, arg2) {
+  return window['go']['main']['App']['BuildMenu'](arg1, arg2);
 }
 
 export function GetRecentScanRoots() {
@@ -26,6 +26,10 @@ export function GetScanSettingsFilePath() {
   return window['go']['main']['App']['GetScanSettingsFilePath']();
 }
 
+export function GetScanner() {
+  return window['go']['main']['App']['GetScanner']();
+}
+
 export function Init(arg1, arg2, arg3) {
   return window['go']['main']['App']['Init'](arg1, arg2, arg3);
 }
@@ -34,6 +38,10 @@ export function JoinPaths(arg1) {
   return window['go']['main']['App']['JoinPaths'](arg1);
 }
 
+export function SelectArchive() {
+  return window['go']['main']['App']['SelectArchive']();
+}
+
 export function SelectDirectory() {
   return window['go']['main']['App']['SelectDirectory']();
 }
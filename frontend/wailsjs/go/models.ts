@@ -29,6 +29,10 @@ export namespace entities {
 	    ToggleSyncScrollPosition = "toggleSyncScrollPosition",
 	    Undo = "undo",
 	}
+	export enum ScanPresetScope {
+	    Project = "project",
+	    User = "user",
+	}
 	export class ComponentFilter {
 	    path?: string;
 	    purl?: string;
@@ -87,6 +91,118 @@ export namespace entities {
 		    return a;
 		}
 	}
+	export class ComponentCopyright {
+	    name: string;
+	    source: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ComponentCopyright(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.source = source["source"];
+	    }
+	}
+	export class LineRange {
+	    start: number;
+	    end: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new LineRange(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.start = source["start"];
+	        this.end = source["end"];
+	    }
+	}
+	export class Cryptography {
+	    algorithm: string;
+	    strength?: number;
+	    category?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Cryptography(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.algorithm = source["algorithm"];
+	        this.strength = source["strength"];
+	        this.category = source["category"];
+	    }
+	}
+	export class ComponentIndicators {
+	    years_since_last_push?: number;
+	    is_stale: boolean;
+	    copyright_differs_from_vendor: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ComponentIndicators(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.years_since_last_push = source["years_since_last_push"];
+	        this.is_stale = source["is_stale"];
+	        this.copyright_differs_from_vendor = source["copyright_differs_from_vendor"];
+	    }
+	}
+	export class ComponentHealth {
+	    creation_date: string;
+	    last_update: string;
+	    last_push: string;
+	    stars: number;
+	    issues: number;
+	    forks: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ComponentHealth(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.creation_date = source["creation_date"];
+	        this.last_update = source["last_update"];
+	        this.last_push = source["last_push"];
+	        this.stars = source["stars"];
+	        this.issues = source["issues"];
+	        this.forks = source["forks"];
+	    }
+	}
+	export class Vulnerability {
+	    id: string;
+	    cve?: string;
+	    severity?: string;
+	    cvss?: number;
+	    cvss_vector?: string;
+	    introduced?: string;
+	    reported?: string;
+	    patched?: string;
+	    summary?: string;
+	    source?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Vulnerability(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.cve = source["cve"];
+	        this.severity = source["severity"];
+	        this.cvss = source["cvss"];
+	        this.cvss_vector = source["cvss_vector"];
+	        this.introduced = source["introduced"];
+	        this.reported = source["reported"];
+	        this.patched = source["patched"];
+	        this.summary = source["summary"];
+	        this.source = source["source"];
+	    }
+	}
 	export class ComponentDTO {
 	    id: string;
 	    lines?: string;
@@ -109,6 +225,15 @@ export namespace entities {
 	    url_stats?: any;
 	    provenance?: string;
 	    licenses?: any[];
+	    vulnerabilities?: Vulnerability[];
+	    health?: ComponentHealth;
+	    copyrights?: ComponentCopyright[];
+	    indicators: ComponentIndicators;
+	    cryptography?: Cryptography[];
+	    line_ranges?: LineRange[];
+	    oss_line_ranges?: LineRange[];
+	    matches_all_lines?: boolean;
+	    oss_matches_all_lines?: boolean;
 	    // Go type: struct { Version string "json:\"version,omitempty\""; KbVersion struct { Monthly string "json:\"monthly,omitempty\""; Daily string "json:\"daily,omitempty\"" } "json:\"kb_version\""; Hostname string "json:\"hostname,omitempty\""; Flags string "json:\"flags,omitempty\""; Elapsed string "json:\"elapsed,omitempty\"" }
 	    server: any;
 	
@@ -138,6 +263,15 @@ export namespace entities {
 	        this.url_stats = this.convertValues(source["url_stats"], Object);
 	        this.provenance = source["provenance"];
 	        this.licenses = source["licenses"];
+	        this.vulnerabilities = this.convertValues(source["vulnerabilities"], Vulnerability);
+	        this.health = this.convertValues(source["health"], ComponentHealth);
+	        this.copyrights = this.convertValues(source["copyrights"], ComponentCopyright);
+	        this.indicators = this.convertValues(source["indicators"], ComponentIndicators);
+	        this.cryptography = this.convertValues(source["cryptography"], Cryptography);
+	        this.line_ranges = this.convertValues(source["line_ranges"], LineRange);
+	        this.oss_line_ranges = this.convertValues(source["oss_line_ranges"], LineRange);
+	        this.matches_all_lines = source["matches_all_lines"];
+	        this.oss_matches_all_lines = source["oss_matches_all_lines"];
 	        this.server = this.convertValues(source["server"], Object);
 	    }
 	
@@ -184,6 +318,8 @@ export namespace entities {
 	        this.license = source["license"];
 	    }
 	}
+	
+	
 	export class LicenseInfo {
 	    id: string;
 	    full_name: string;
@@ -334,6 +470,61 @@ export namespace entities {
 		    return a;
 		}
 	}
+	
+	export class CryptographyAsset {
+	    algorithm: string;
+	    strength?: number;
+	    category?: string;
+	    files: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CryptographyAsset(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.algorithm = source["algorithm"];
+	        this.strength = source["strength"];
+	        this.category = source["category"];
+	        this.files = source["files"];
+	    }
+	}
+	export class CryptographyInventoryItem {
+	    purl: string;
+	    component: string;
+	    version?: string;
+	    assets: CryptographyAsset[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CryptographyInventoryItem(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.purl = source["purl"];
+	        this.component = source["component"];
+	        this.version = source["version"];
+	        this.assets = this.convertValues(source["assets"], CryptographyAsset);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
 	export class DeclaredComponent {
 	    name: string;
 	    purl: string;
@@ -348,6 +539,97 @@ export namespace entities {
 	        this.purl = source["purl"];
 	    }
 	}
+	export class FilterConfig {
+	    action?: string;
+	    type?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new FilterConfig(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.action = source["action"];
+	        this.type = source["type"];
+	    }
+	}
+	export class DependencyLicense {
+	    name: string;
+	    spdx_id?: string;
+	    is_spdx_approved?: boolean;
+	    url?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DependencyLicense(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.spdx_id = source["spdx_id"];
+	        this.is_spdx_approved = source["is_spdx_approved"];
+	        this.url = source["url"];
+	    }
+	}
+	export class DependencyDTO {
+	    declared_file: string;
+	    component: string;
+	    purl: string;
+	    purl_url?: string;
+	    requirement?: string;
+	    version?: string;
+	    scope?: string;
+	    url?: string;
+	    licenses?: DependencyLicense[];
+	    workflow_state?: string;
+	    filter_config?: FilterConfig;
+	    comment?: string;
+	    concluded_purl?: string;
+	    concluded_purl_url?: string;
+	    concluded_name?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DependencyDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.declared_file = source["declared_file"];
+	        this.component = source["component"];
+	        this.purl = source["purl"];
+	        this.purl_url = source["purl_url"];
+	        this.requirement = source["requirement"];
+	        this.version = source["version"];
+	        this.scope = source["scope"];
+	        this.url = source["url"];
+	        this.licenses = this.convertValues(source["licenses"], DependencyLicense);
+	        this.workflow_state = source["workflow_state"];
+	        this.filter_config = this.convertValues(source["filter_config"], FilterConfig);
+	        this.comment = source["comment"];
+	        this.concluded_purl = source["concluded_purl"];
+	        this.concluded_purl_url = source["concluded_purl_url"];
+	        this.concluded_name = source["concluded_name"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
 	export class FileDTO {
 	    name: string;
 	    path: string;
@@ -390,20 +672,7 @@ export namespace entities {
 	        this.skip_headers_limit = source["skip_headers_limit"];
 	    }
 	}
-	export class FilterConfig {
-	    action?: string;
-	    type?: string;
 	
-	    static createFrom(source: any = {}) {
-	        return new FilterConfig(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.action = source["action"];
-	        this.type = source["type"];
-	    }
-	}
 	export class GetLicensesByPurlResponse {
 	    status: StatusResponse;
 	    component: ComponentLicenseInfo;
@@ -487,9 +756,197 @@ export namespace entities {
 	    }
 	}
 	
+	
+	export class LineRangePair {
+	    local: LineRange;
+	    remote: LineRange;
+	
+	    static createFrom(source: any = {}) {
+	        return new LineRangePair(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.local = this.convertValues(source["local"], LineRange);
+	        this.remote = this.convertValues(source["remote"], LineRange);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MatchCoverage {
+	    matched_lines: number;
+	    local_total_lines: number;
+	    local_percentage: number;
+	    remote_matched_lines: number;
+	    remote_total_lines: number;
+	    remote_percentage: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MatchCoverage(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.matched_lines = source["matched_lines"];
+	        this.local_total_lines = source["local_total_lines"];
+	        this.local_percentage = source["local_percentage"];
+	        this.remote_matched_lines = source["remote_matched_lines"];
+	        this.remote_total_lines = source["remote_total_lines"];
+	        this.remote_percentage = source["remote_percentage"];
+	    }
+	}
+	export class MatchRangesDTO {
+	    local: LineRange[];
+	    remote: LineRange[];
+	    pairs: LineRangePair[];
+	    coverage: MatchCoverage;
+	    warnings?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new MatchRangesDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.local = this.convertValues(source["local"], LineRange);
+	        this.remote = this.convertValues(source["remote"], LineRange);
+	        this.pairs = this.convertValues(source["pairs"], LineRangePair);
+	        this.coverage = this.convertValues(source["coverage"], MatchCoverage);
+	        this.warnings = source["warnings"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PostScanHook {
+	    name: string;
+	    action: string;
+	    file?: string;
+	    format?: string;
+	    output?: string;
+	    command?: string[];
+	    timeout_seconds?: number;
+	    scope?: ScanPresetScope;
+	    trusted?: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new PostScanHook(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.action = source["action"];
+	        this.file = source["file"];
+	        this.format = source["format"];
+	        this.output = source["output"];
+	        this.command = source["command"];
+	        this.timeout_seconds = source["timeout_seconds"];
+	        this.scope = source["scope"];
+	        this.trusted = source["trusted"];
+	    }
+	}
+	export class PostScanHookResult {
+	    name: string;
+	    action: string;
+	    status: string;
+	    error?: string;
+	    output?: string;
+	    duration_ms: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PostScanHookResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.action = source["action"];
+	        this.status = source["status"];
+	        this.error = source["error"];
+	        this.output = source["output"];
+	        this.duration_ms = source["duration_ms"];
+	    }
+	}
+	export class RequestCryptographyDTO {
+	    algorithm?: string;
+	    min_strength?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RequestCryptographyDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.algorithm = source["algorithm"];
+	        this.min_strength = source["min_strength"];
+	    }
+	}
+	export class RequestDependencyDTO {
+	    query?: string;
+	    scope?: string;
+	    workflow_state?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RequestDependencyDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.query = source["query"];
+	        this.scope = source["scope"];
+	        this.workflow_state = source["workflow_state"];
+	    }
+	}
+	export class SortKey {
+	    option: string;
+	    order: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SortKey(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.option = source["option"];
+	        this.order = source["order"];
+	    }
+	}
 	export class SortConfig {
 	    option: string;
 	    order: string;
+	    then_by?: SortKey[];
 	
 	    static createFrom(source: any = {}) {
 	        return new SortConfig(source);
@@ -499,12 +956,39 @@ export namespace entities {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.option = source["option"];
 	        this.order = source["order"];
+	        this.then_by = this.convertValues(source["then_by"], SortKey);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
 	export class RequestResultDTO {
 	    match_type?: string;
 	    query?: string;
 	    sort?: SortConfig;
+	    has_vulnerabilities?: boolean;
+	    min_severity?: string;
+	    last_push_older_than_years?: number;
+	    copyright_differs_from_vendor?: boolean;
+	    has_cryptography?: boolean;
+	    crypto_algorithm?: string;
+	    min_crypto_strength?: number;
+	    workflow_state?: string;
 	
 	    static createFrom(source: any = {}) {
 	        return new RequestResultDTO(source);
@@ -515,6 +999,48 @@ export namespace entities {
 	        this.match_type = source["match_type"];
 	        this.query = source["query"];
 	        this.sort = this.convertValues(source["sort"], SortConfig);
+	        this.has_vulnerabilities = source["has_vulnerabilities"];
+	        this.min_severity = source["min_severity"];
+	        this.last_push_older_than_years = source["last_push_older_than_years"];
+	        this.copyright_differs_from_vendor = source["copyright_differs_from_vendor"];
+	        this.has_cryptography = source["has_cryptography"];
+	        this.crypto_algorithm = source["crypto_algorithm"];
+	        this.min_crypto_strength = source["min_crypto_strength"];
+	        this.workflow_state = source["workflow_state"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class RequestResultPageDTO {
+	    filter: RequestResultDTO;
+	    offset: number;
+	    limit: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new RequestResultPageDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.filter = this.convertValues(source["filter"], RequestResultDTO);
+	        this.offset = source["offset"];
+	        this.limit = source["limit"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -547,6 +1073,10 @@ export namespace entities {
 	    concluded_purl?: string;
 	    concluded_purl_url?: string;
 	    concluded_name?: string;
+	    vulnerability_count?: number;
+	    max_severity?: string;
+	    origin?: string;
+	    crypto_algorithms?: string[];
 	
 	    static createFrom(source: any = {}) {
 	        return new ResultDTO(source);
@@ -565,6 +1095,100 @@ export namespace entities {
 	        this.concluded_purl = source["concluded_purl"];
 	        this.concluded_purl_url = source["concluded_purl_url"];
 	        this.concluded_name = source["concluded_name"];
+	        this.vulnerability_count = source["vulnerability_count"];
+	        this.max_severity = source["max_severity"];
+	        this.origin = source["origin"];
+	        this.crypto_algorithms = source["crypto_algorithms"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ResultPageDTO {
+	    results: ResultDTO[];
+	    offset: number;
+	    limit: number;
+	    total: number;
+	    has_more: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ResultPageDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.results = this.convertValues(source["results"], ResultDTO);
+	        this.offset = source["offset"];
+	        this.limit = source["limit"];
+	        this.total = source["total"];
+	        this.has_more = source["has_more"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ResultSummaryDTO {
+	    total: number;
+	    pending: number;
+	    completed: number;
+	    file: number;
+	    snippet: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ResultSummaryDTO(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.total = source["total"];
+	        this.pending = source["pending"];
+	        this.completed = source["completed"];
+	        this.file = source["file"];
+	        this.snippet = source["snippet"];
+	    }
+	}
+	export class ResultView {
+	    name: string;
+	    filter: RequestResultDTO;
+	
+	    static createFrom(source: any = {}) {
+	        return new ResultView(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.filter = this.convertValues(source["filter"], RequestResultDTO);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -611,6 +1235,107 @@ export namespace entities {
 	        this.IsFileSelector = source["IsFileSelector"];
 	    }
 	}
+	export class ScanPreset {
+	    name: string;
+	    description?: string;
+	    args: Record<string, any>;
+	    scope?: ScanPresetScope;
+	
+	    static createFrom(source: any = {}) {
+	        return new ScanPreset(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.description = source["description"];
+	        this.args = source["args"];
+	        this.scope = source["scope"];
+	    }
+	}
+	export class ScanRequest {
+	    path: string;
+	    options: Record<string, any>;
+	    extra_args?: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ScanRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.path = source["path"];
+	        this.options = source["options"];
+	        this.extra_args = source["extra_args"];
+	    }
+	}
+	export class ScanRun {
+	    id: string;
+	    // Go type: time
+	    created_at: any;
+	    scanner: string;
+	    args: string[];
+	    settings_hash?: string;
+	    result_count: number;
+	    results_file: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ScanRun(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.created_at = this.convertValues(source["created_at"], null);
+	        this.scanner = source["scanner"];
+	        this.args = source["args"];
+	        this.settings_hash = source["settings_hash"];
+	        this.result_count = source["result_count"];
+	        this.results_file = source["results_file"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ScannerDiagnostic {
+	    scanner: string;
+	    binary?: string;
+	    source?: string;
+	    version?: string;
+	    python?: string;
+	    env_names?: string[];
+	    error?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ScannerDiagnostic(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.scanner = source["scanner"];
+	        this.binary = source["binary"];
+	        this.source = source["source"];
+	        this.version = source["version"];
+	        this.python = source["python"];
+	        this.env_names = source["env_names"];
+	        this.error = source["error"];
+	    }
+	}
 	export class SizesSkipSettings {
 	    patterns?: string[];
 	    min?: number;
@@ -818,6 +1543,7 @@ export namespace entities {
 	
 	
 	
+	
 	export class TreeNode {
 	    id: string;
 	    name: string;
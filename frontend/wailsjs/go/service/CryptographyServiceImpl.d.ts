@@ -0,0 +1,7 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {entities} from '../models';
+
+export function ExportCBOM(arg1:string):Promise<void>;
+
+export function GetInventory(arg1:entities.RequestCryptographyDTO):Promise<Array<entities.CryptographyInventoryItem>>;
@@ -0,0 +1,11 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function ExportCBOM(arg1) {
+  return window['go']['service']['CryptographyServiceImpl']['ExportCBOM'](arg1);
+}
+
+export function GetInventory(arg1) {
+  return window['go']['service']['CryptographyServiceImpl']['GetInventory'](arg1);
+}
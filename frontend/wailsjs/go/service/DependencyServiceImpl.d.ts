@@ -0,0 +1,9 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {entities} from '../models';
+
+export function FilterDependencies(arg1:Array<entities.ComponentFilterDTO>):Promise<void>;
+
+export function GetAll(arg1:entities.RequestDependencyDTO):Promise<Array<entities.DependencyDTO>>;
+
+export function GetTree():Promise<Array<entities.TreeNode>>;
@@ -0,0 +1,15 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function FilterDependencies(arg1) {
+  return window['go']['service']['DependencyServiceImpl']['FilterDependencies'](arg1);
+}
+
+export function GetAll(arg1) {
+  return window['go']['service']['DependencyServiceImpl']['GetAll'](arg1);
+}
+
+export function GetTree() {
+  return window['go']['service']['DependencyServiceImpl']['GetTree']();
+}
@@ -4,4 +4,6 @@ import {entities} from '../models';
 
 export function GetLocalFile(arg1:string):Promise<entities.FileDTO>;
 
+export function GetMatchRanges(arg1:string):Promise<entities.MatchRangesDTO>;
+
 export function GetRemoteFile(arg1:string):Promise<entities.FileDTO>;
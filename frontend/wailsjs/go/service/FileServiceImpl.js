@@ -6,6 +6,10 @@ export function GetLocalFile(arg1) {
   return window['go']['service']['FileServiceImpl']['GetLocalFile'](arg1);
 }
 
+export function GetMatchRanges(arg1) {
+  return window['go']['service']['FileServiceImpl']['GetMatchRanges'](arg1);
+}
+
 export function GetRemoteFile(arg1) {
   return window['go']['service']['FileServiceImpl']['GetRemoteFile'](arg1);
 }
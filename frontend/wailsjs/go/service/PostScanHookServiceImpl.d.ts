@@ -0,0 +1,10 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {entities} from '../models';
+import {context} from '../models';
+
+export function GetAll():Promise<Array<entities.PostScanHook>>;
+
+export function Run(arg1:string,arg2:string):Promise<Array<entities.PostScanHookResult>>;
+
+export function SetContext(arg1:context.Context):Promise<void>;
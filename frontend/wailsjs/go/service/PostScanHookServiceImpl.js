@@ -0,0 +1,15 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function GetAll() {
+  return window['go']['service']['PostScanHookServiceImpl']['GetAll']();
+}
+
+export function Run(arg1, arg2) {
+  return window['go']['service']['PostScanHookServiceImpl']['Run'](arg1, arg2);
+}
+
+export function SetContext(arg1) {
+  return window['go']['service']['PostScanHookServiceImpl']['SetContext'](arg1);
+}
@@ -7,4 +7,8 @@ export function GetAll(arg1:entities.RequestResultDTO):Promise<Array<entities.Re
 
 export function GetByPath(arg1:string):Promise<entities.ResultDTO>;
 
+export function GetPage(arg1:entities.RequestResultPageDTO):Promise<entities.ResultPageDTO>;
+
+export function GetSummary(arg1:entities.RequestResultDTO):Promise<entities.ResultSummaryDTO>;
+
 export function SetContext(arg1:context.Context):Promise<void>;
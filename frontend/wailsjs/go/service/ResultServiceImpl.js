@@ -10,6 +10,14 @@ export function GetByPath(arg1) {
   return window['go']['service']['ResultServiceImpl']['GetByPath'](arg1);
 }
 
+export function GetPage(arg1) {
+  return window['go']['service']['ResultServiceImpl']['GetPage'](arg1);
+}
+
+export function GetSummary(arg1) {
+  return window['go']['service']['ResultServiceImpl']['GetSummary'](arg1);
+}
+
 export function SetContext(arg1) {
   return window['go']['service']['ResultServiceImpl']['SetContext'](arg1);
 }
@@ -0,0 +1,13 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {entities} from '../models';
+
+export function Delete(arg1:string):Promise<void>;
+
+export function Get(arg1:string):Promise<entities.ResultView>;
+
+export function GetAll():Promise<Array<entities.ResultView>>;
+
+export function GetStartupView():Promise<entities.ResultView>;
+
+export function Save(arg1:entities.ResultView):Promise<void>;
@@ -0,0 +1,23 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function Delete(arg1) {
+  return window['go']['service']['ResultViewServiceImpl']['Delete'](arg1);
+}
+
+export function Get(arg1) {
+  return window['go']['service']['ResultViewServiceImpl']['Get'](arg1);
+}
+
+export function GetAll() {
+  return window['go']['service']['ResultViewServiceImpl']['GetAll']();
+}
+
+export function GetStartupView() {
+  return window['go']['service']['ResultViewServiceImpl']['GetStartupView']();
+}
+
+export function Save(arg1) {
+  return window['go']['service']['ResultViewServiceImpl']['Save'](arg1);
+}
@@ -0,0 +1,15 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {entities} from '../models';
+
+export function Close():Promise<void>;
+
+export function Delete(arg1:string):Promise<void>;
+
+export function GetOpenRun():Promise<entities.ScanRun>;
+
+export function List():Promise<Array<entities.ScanRun>>;
+
+export function Open(arg1:string):Promise<entities.ScanRun>;
+
+export function Record(arg1:string,arg2:Array<string>):Promise<entities.ScanRun>;
@@ -0,0 +1,27 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function Close() {
+  return window['go']['service']['ScanHistoryServiceImpl']['Close']();
+}
+
+export function Delete(arg1) {
+  return window['go']['service']['ScanHistoryServiceImpl']['Delete'](arg1);
+}
+
+export function GetOpenRun() {
+  return window['go']['service']['ScanHistoryServiceImpl']['GetOpenRun']();
+}
+
+export function List() {
+  return window['go']['service']['ScanHistoryServiceImpl']['List']();
+}
+
+export function Open(arg1) {
+  return window['go']['service']['ScanHistoryServiceImpl']['Open'](arg1);
+}
+
+export function Record(arg1, arg2) {
+  return window['go']['service']['ScanHistoryServiceImpl']['Record'](arg1, arg2);
+}
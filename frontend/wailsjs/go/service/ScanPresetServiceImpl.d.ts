@@ -0,0 +1,11 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+import {entities} from '../models';
+
+export function Delete(arg1:string,arg2:entities.ScanPresetScope):Promise<void>;
+
+export function Get(arg1:string):Promise<entities.ScanPreset>;
+
+export function GetAll():Promise<Array<entities.ScanPreset>>;
+
+export function Save(arg1:entities.ScanPreset):Promise<void>;
@@ -0,0 +1,19 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function Delete(arg1, arg2) {
+  return window['go']['service']['ScanPresetServiceImpl']['Delete'](arg1, arg2);
+}
+
+export function Get(arg1) {
+  return window['go']['service']['ScanPresetServiceImpl']['Get'](arg1);
+}
+
+export function GetAll() {
+  return window['go']['service']['ScanPresetServiceImpl']['GetAll']();
+}
+
+export function Save(arg1) {
+  return window['go']['service']['ScanPresetServiceImpl']['Save'](arg1);
+}
@@ -0,0 +1,39 @@
+// @ts-check
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+export function AbortScan() {
+  return window['go']['service']['ScanServiceNativeImpl']['AbortScan']();
+}
+
+export function CheckDependencies() {
+  return window['go']['service']['ScanServiceNativeImpl']['CheckDependencies']();
+}
+
+export function Diagnose() {
+  return window['go']['service']['ScanServiceNativeImpl']['Diagnose']();
+}
+
+export function GetDefaultScanArgs() {
+  return window['go']['service']['ScanServiceNativeImpl']['GetDefaultScanArgs']();
+}
+
+export function GetScanArgs() {
+  return window['go']['service']['ScanServiceNativeImpl']['GetScanArgs']();
+}
+
+export function Scan(arg1) {
+  return window['go']['service']['ScanServiceNativeImpl']['Scan'](arg1);
+}
+
+export function ScanStream(arg1) {
+  return window['go']['service']['ScanServiceNativeImpl']['ScanStream'](arg1);
+}
+
+export function ScanWithOptions(arg1) {
+  return window['go']['service']['ScanServiceNativeImpl']['ScanWithOptions'](arg1);
+}
+
+export function SetContext(arg1) {
+  return window['go']['service']['ScanServiceNativeImpl']['SetContext'](arg1);
+}
@@ -7,14 +7,16 @@ export function AbortScan():Promise<void>;
 
 export function CheckDependencies():Promise<void>;
 
+export function Diagnose():Promise<entities.ScannerDiagnostic>;
+
 export function GetDefaultScanArgs():Promise<Array<string>>;
 
 export function GetScanArgs():Promise<Array<entities.ScanArgDef>>;
 
-export function GetSensitiveDefaultScanArgs():Promise<Array<string>>;
-
 export function Scan(arg1:Array<string>):Promise<void>;
 
 export function ScanStream(arg1:Array<string>):Promise<void>;
 
+export function ScanWithOptions(arg1:entities.ScanRequest):Promise<void>;
+
 export function SetContext(arg1:context.Context):Promise<void>;
@@ -10,6 +10,10 @@ export function CheckDependencies() {
   return window['go']['service']['ScanServicePythonImpl']['CheckDependencies']();
 }
 
+export function Diagnose() {
+  return window['go']['service']['ScanServicePythonImpl']['Diagnose']();
+}
+
 export function GetDefaultScanArgs() {
   return window['go']['service']['ScanServicePythonImpl']['GetDefaultScanArgs']();
 }
@@ -18,10 +22,6 @@ export function GetScanArgs() {
   return window['go']['service']['ScanServicePythonImpl']['GetScanArgs']();
 }
 
-export function GetSensitiveDefaultScanArgs() {
-  return window['go']['service']['ScanServicePythonImpl']['GetSensitiveDefaultScanArgs']();
-}
-
 export function Scan(arg1) {
   return window['go']['service']['ScanServicePythonImpl']['Scan'](arg1);
 }
@@ -30,6 +30,10 @@ export function ScanStream(arg1) {
   return window['go']['service']['ScanServicePythonImpl']['ScanStream'](arg1);
 }
 
+export function ScanWithOptions(arg1) {
+  return window['go']['service']['ScanServicePythonImpl']['ScanWithOptions'](arg1);
+}
+
 export function SetContext(arg1) {
   return window['go']['service']['ScanServicePythonImpl']['SetContext'](arg1);
 }
@@ -0,0 +1,312 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+// Package archive extracts source archives (zip, jar, tar, tar.gz) so they can be scanned and reviewed like a
+// folder. Extraction refuses entries escaping the destination folder and stops at the configured limits.
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrUnsupportedArchive = errors.New("unsupported archive format")
+	ErrUnsafeArchiveEntry = errors.New("unsafe archive entry")
+	ErrArchiveTooLarge    = errors.New("archive exceeds the extraction limits")
+)
+
+// FilePatterns matches the supported archive formats, for file dialogs.
+const FilePatterns = "*.zip;*.jar;*.war;*.tar;*.tar.gz;*.tgz"
+
+// Limits bound what an extraction may write, a zero value disables the limit.
+type Limits struct {
+	MaxBytes int64
+	MaxFiles int
+}
+
+type format int
+
+const (
+	formatNone format = iota
+	formatZip
+	formatTar
+	formatTarGz
+)
+
+func archiveFormat(name string) format {
+	name = strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return formatTarGz
+	case strings.HasSuffix(name, ".tar"):
+		return formatTar
+	case strings.HasSuffix(name, ".zip"), strings.HasSuffix(name, ".jar"), strings.HasSuffix(name, ".war"):
+		return formatZip
+	}
+	return formatNone
+}
+
+// IsArchive reports whether path is an existing file in one of the supported archive formats.
+func IsArchive(path string) bool {
+	if archiveFormat(path) == formatNone {
+		return false
+	}
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
+
+// Extract writes the regular files and folders of an archive into dest. Symlinks, hard links and special files
+// are skipped. Entries with absolute paths or escaping dest fail with ErrUnsafeArchiveEntry, and going over the
+// limits, whatever sizes the archive declares, fails with ErrArchiveTooLarge.
+func Extract(archivePath, dest string, limits Limits) error {
+	e := &extractor{dest: dest, limits: limits}
+
+	switch archiveFormat(archivePath) {
+	case formatZip:
+		return e.extractZip(archivePath)
+	case formatTar, formatTarGz:
+		file, err := os.Open(archivePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		var reader io.Reader = file
+		if archiveFormat(archivePath) == formatTarGz {
+			gz, err := gzip.NewReader(file)
+			if err != nil {
+				return fmt.Errorf("error reading %s: %w", archivePath, err)
+			}
+			defer gz.Close()
+			reader = gz
+		}
+		return e.extractTar(reader)
+	}
+	return fmt.Errorf("%w: %s", ErrUnsupportedArchive, archivePath)
+}
+
+type extractor struct {
+	dest    string
+	limits  Limits
+	written int64
+	files   int
+}
+
+func (e *extractor) extractZip(archivePath string) error {
+	reader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", archivePath, err)
+	}
+	defer reader.Close()
+
+	for _, entry := range reader.File {
+		mode := entry.Mode()
+		if mode.IsDir() {
+			if err := e.mkdir(entry.Name); err != nil {
+				return err
+			}
+			continue
+		}
+		if !mode.IsRegular() {
+			continue
+		}
+
+		file, err := entry.Open()
+		if err != nil {
+			return fmt.Errorf("error reading %s: %w", entry.Name, err)
+		}
+		err = e.writeFile(entry.Name, mode, file)
+		file.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *extractor) extractTar(reader io.Reader) error {
+	tr := tar.NewReader(reader)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error reading tar archive: %w", err)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := e.mkdir(header.Name); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := e.writeFile(header.Name, header.FileInfo().Mode(), tr); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// target returns where an entry is written, rejecting names that would land outside dest.
+func (e *extractor) target(name string) (string, error) {
+	clean := path.Clean(strings.ReplaceAll(name, `\`, "/"))
+	if path.IsAbs(clean) || filepath.VolumeName(clean) != "" || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("%w: %s", ErrUnsafeArchiveEntry, name)
+	}
+
+	target := filepath.Join(e.dest, filepath.FromSlash(clean))
+	rel, err := filepath.Rel(e.dest, target)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: %s", ErrUnsafeArchiveEntry, name)
+	}
+	return target, nil
+}
+
+func (e *extractor) mkdir(name string) error {
+	target, err := e.target(name)
+	if err != nil {
+		return err
+	}
+	return os.MkdirAll(target, 0o755)
+}
+
+func (e *extractor) writeFile(name string, mode os.FileMode, reader io.Reader) error {
+	target, err := e.target(name)
+	if err != nil {
+		return err
+	}
+
+	e.files++
+	if e.limits.MaxFiles > 0 && e.files > e.limits.MaxFiles {
+		return fmt.Errorf("%w: more than %d files", ErrArchiveTooLarge, e.limits.MaxFiles)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+
+	perm := os.FileMode(0o644)
+	if mode&0o111 != 0 {
+		perm = 0o755
+	}
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	// Sizes declared in headers can lie, only the bytes actually written count towards the limit
+	if e.limits.MaxBytes > 0 {
+		remaining := e.limits.MaxBytes - e.written
+		n, err := io.Copy(out, io.LimitReader(reader, remaining+1))
+		e.written += n
+		if err != nil {
+			return fmt.Errorf("error extracting %s: %w", name, err)
+		}
+		if n > remaining {
+			return fmt.Errorf("%w: more than %d bytes", ErrArchiveTooLarge, e.limits.MaxBytes)
+		}
+		return out.Close()
+	}
+
+	n, err := io.Copy(out, reader)
+	e.written += n
+	if err != nil {
+		return fmt.Errorf("error extracting %s: %w", name, err)
+	}
+	return out.Close()
+}
+
+type extraction struct {
+	dir     string
+	size    int64
+	modTime time.Time
+}
+
+var (
+	extractionsMu sync.Mutex
+	extractions   = map[string]extraction{}
+)
+
+// ContentRoot returns the folder holding the contents of path. Archives are extracted into a temporary folder
+// once and reused until the archive changes, any other path is returned as is.
+func ContentRoot(path string, limits Limits) (string, error) {
+	if !IsArchive(path) {
+		return path, nil
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(absPath)
+	if err != nil {
+		return "", err
+	}
+
+	extractionsMu.Lock()
+	defer extractionsMu.Unlock()
+
+	if previous, ok := extractions[absPath]; ok {
+		if previous.size == info.Size() && previous.modTime.Equal(info.ModTime()) {
+			return previous.dir, nil
+		}
+		os.RemoveAll(previous.dir)
+		delete(extractions, absPath)
+	}
+
+	dir, err := os.MkdirTemp("", "scanoss-cc-archive-*")
+	if err != nil {
+		return "", err
+	}
+	if err := Extract(absPath, dir, limits); err != nil {
+		os.RemoveAll(dir)
+		return "", fmt.Errorf("error extracting %s: %w", path, err)
+	}
+
+	extractions[absPath] = extraction{dir: dir, size: info.Size(), modTime: info.ModTime()}
+	return dir, nil
+}
+
+// Cleanup removes every folder extracted by ContentRoot.
+func Cleanup() {
+	extractionsMu.Lock()
+	defer extractionsMu.Unlock()
+
+	for path, extracted := range extractions {
+		os.RemoveAll(extracted.dir)
+		delete(extractions, path)
+	}
+}
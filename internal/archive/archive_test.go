@@ -0,0 +1,230 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package archive_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scanoss/scanoss.cc/internal/archive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type archiveEntry struct {
+	name    string
+	content string
+	mode    os.FileMode
+}
+
+func writeZip(t *testing.T, path string, entries []archiveEntry) {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for _, entry := range entries {
+		header := &zip.FileHeader{Name: entry.name, Method: zip.Deflate}
+		mode := entry.mode
+		if mode == 0 {
+			mode = 0o644
+		}
+		header.SetMode(mode)
+		w, err := writer.CreateHeader(header)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(entry.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+}
+
+func writeTarGz(t *testing.T, path string, headers []*tar.Header, contents []string) {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	writer := tar.NewWriter(gz)
+	for i, header := range headers {
+		header.Size = int64(len(contents[i]))
+		require.NoError(t, writer.WriteHeader(header))
+		_, err := writer.Write([]byte(contents[i]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	require.NoError(t, gz.Close())
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o644))
+}
+
+func TestIsArchive(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"drop.zip", "lib.JAR", "src.tar", "src.tar.gz", "src.tgz"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o644))
+		assert.True(t, archive.IsArchive(filepath.Join(dir, name)), name)
+	}
+	assert.False(t, archive.IsArchive(dir))
+	assert.False(t, archive.IsArchive(filepath.Join(dir, "missing.zip")))
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "folder.zip"), 0o755))
+	assert.False(t, archive.IsArchive(filepath.Join(dir, "folder.zip")))
+}
+
+func TestExtract(t *testing.T) {
+	t.Run("Zip", func(t *testing.T) {
+		archivePath := filepath.Join(t.TempDir(), "drop.jar")
+		writeZip(t, archivePath, []archiveEntry{
+			{name: "drop/"},
+			{name: "drop/src/main.c", content: "int main(void) { return 0; }\n"},
+			{name: `drop\windows\path.c`, content: "int win;\n"},
+			{name: "drop/run.sh", content: "#!/bin/sh\n", mode: 0o755},
+			{name: "drop/link", content: "/etc/passwd", mode: os.ModeSymlink | 0o777},
+		})
+
+		dest := t.TempDir()
+		require.NoError(t, archive.Extract(archivePath, dest, archive.Limits{}))
+
+		content, err := os.ReadFile(filepath.Join(dest, "drop", "src", "main.c"))
+		require.NoError(t, err)
+		assert.Equal(t, "int main(void) { return 0; }\n", string(content))
+		assert.FileExists(t, filepath.Join(dest, "drop", "windows", "path.c"))
+		info, err := os.Stat(filepath.Join(dest, "drop", "run.sh"))
+		require.NoError(t, err)
+		assert.NotZero(t, info.Mode()&0o100)
+		assert.NoFileExists(t, filepath.Join(dest, "drop", "link"))
+	})
+
+	t.Run("Tar gz", func(t *testing.T) {
+		archivePath := filepath.Join(t.TempDir(), "src.tar.gz")
+		writeTarGz(t, archivePath, []*tar.Header{
+			{Name: "src/", Typeflag: tar.TypeDir, Mode: 0o755},
+			{Name: "src/lib.c", Typeflag: tar.TypeReg, Mode: 0o644},
+			{Name: "src/link", Typeflag: tar.TypeSymlink, Linkname: "../../etc/passwd"},
+			{Name: "src/hard", Typeflag: tar.TypeLink, Linkname: "/etc/passwd"},
+		}, []string{"", "int lib;\n", "", ""})
+
+		dest := t.TempDir()
+		require.NoError(t, archive.Extract(archivePath, dest, archive.Limits{}))
+
+		assert.FileExists(t, filepath.Join(dest, "src", "lib.c"))
+		assert.NoFileExists(t, filepath.Join(dest, "src", "link"))
+		assert.NoFileExists(t, filepath.Join(dest, "src", "hard"))
+	})
+
+	t.Run("Refuses entries escaping the destination", func(t *testing.T) {
+		for _, name := range []string{"../evil.c", "drop/../../evil.c", "/etc/evil.c", `..\evil.c`} {
+			archivePath := filepath.Join(t.TempDir(), "slip.zip")
+			writeZip(t, archivePath, []archiveEntry{{name: name, content: "evil"}})
+
+			parent := t.TempDir()
+			dest := filepath.Join(parent, "dest")
+			require.NoError(t, os.Mkdir(dest, 0o755))
+
+			err := archive.Extract(archivePath, dest, archive.Limits{})
+			assert.ErrorIs(t, err, archive.ErrUnsafeArchiveEntry, name)
+			assert.NoFileExists(t, filepath.Join(parent, "evil.c"))
+		}
+
+		archivePath := filepath.Join(t.TempDir(), "slip.tar.gz")
+		writeTarGz(t, archivePath, []*tar.Header{{Name: "../evil.c", Typeflag: tar.TypeReg, Mode: 0o644}}, []string{"evil"})
+		err := archive.Extract(archivePath, t.TempDir(), archive.Limits{})
+		assert.ErrorIs(t, err, archive.ErrUnsafeArchiveEntry)
+	})
+
+	t.Run("Stops at the size limit", func(t *testing.T) {
+		// Highly compressible content, a few KB in the archive
+		archivePath := filepath.Join(t.TempDir(), "bomb.zip")
+		writeZip(t, archivePath, []archiveEntry{
+			{name: "a.txt", content: strings.Repeat("0", 600*1024)},
+			{name: "b.txt", content: strings.Repeat("0", 600*1024)},
+		})
+		info, err := os.Stat(archivePath)
+		require.NoError(t, err)
+		assert.Less(t, info.Size(), int64(64*1024))
+
+		err = archive.Extract(archivePath, t.TempDir(), archive.Limits{MaxBytes: 1024 * 1024})
+		assert.ErrorIs(t, err, archive.ErrArchiveTooLarge)
+
+		assert.NoError(t, archive.Extract(archivePath, t.TempDir(), archive.Limits{MaxBytes: 2 * 1024 * 1024}))
+	})
+
+	t.Run("Stops at the file limit", func(t *testing.T) {
+		archivePath := filepath.Join(t.TempDir(), "many.tar.gz")
+		writeTarGz(t, archivePath, []*tar.Header{
+			{Name: "a.c", Typeflag: tar.TypeReg, Mode: 0o644},
+			{Name: "b.c", Typeflag: tar.TypeReg, Mode: 0o644},
+			{Name: "c.c", Typeflag: tar.TypeReg, Mode: 0o644},
+		}, []string{"a", "b", "c"})
+
+		err := archive.Extract(archivePath, t.TempDir(), archive.Limits{MaxFiles: 2})
+		assert.ErrorIs(t, err, archive.ErrArchiveTooLarge)
+	})
+
+	t.Run("Rejects unsupported formats", func(t *testing.T) {
+		err := archive.Extract(filepath.Join(t.TempDir(), "file.rar"), t.TempDir(), archive.Limits{})
+		assert.ErrorIs(t, err, archive.ErrUnsupportedArchive)
+	})
+}
+
+func TestContentRoot(t *testing.T) {
+	defer archive.Cleanup()
+
+	dir := t.TempDir()
+	root, err := archive.ContentRoot(dir, archive.Limits{})
+	require.NoError(t, err)
+	assert.Equal(t, dir, root, "folders are used as they are")
+
+	archivePath := filepath.Join(dir, "drop.zip")
+	writeZip(t, archivePath, []archiveEntry{{name: "main.c", content: "v1"}})
+
+	root, err = archive.ContentRoot(archivePath, archive.Limits{})
+	require.NoError(t, err)
+	content, err := os.ReadFile(filepath.Join(root, "main.c"))
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(content))
+
+	again, err := archive.ContentRoot(archivePath, archive.Limits{})
+	require.NoError(t, err)
+	assert.Equal(t, root, again, "an unchanged archive is extracted once")
+
+	writeZip(t, archivePath, []archiveEntry{{name: "main.c", content: "v2"}})
+	later := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(archivePath, later, later))
+
+	updated, err := archive.ContentRoot(archivePath, archive.Limits{})
+	require.NoError(t, err)
+	assert.NotEqual(t, root, updated)
+	assert.NoDirExists(t, root)
+	content, err = os.ReadFile(filepath.Join(updated, "main.c"))
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(content))
+
+	archive.Cleanup()
+	assert.NoDirExists(t, updated)
+}
@@ -27,8 +27,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -36,17 +38,37 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/internal/archive"
 	"github.com/spf13/viper"
 )
 
 var (
-	ErrReadingFile       = errors.New("error reading file")
-	ErrUnmarshallingFile = errors.New("error unmarshalling file")
+	ErrReadingFile          = errors.New("error reading file")
+	ErrUnmarshallingFile    = errors.New("error unmarshalling file")
+	ErrNoResultFilesMatched = errors.New("no results files match the input pattern")
 )
 
 const (
 	DEFAULT_RESULTS_FILE          = "results.json"
 	DEFAULT_SCANOSS_SETTINGS_FILE = "scanoss.json"
+	DEFAULT_RESULT_VIEWS_FILE     = "views.json"
+	DEFAULT_SCAN_MANIFEST_FILE    = "scan-manifest.json"
+	DEFAULT_SCAN_PRESETS_FILE     = "scan-presets.json"
+	DEFAULT_POST_SCAN_HOOKS_FILE  = "post-scan-hooks.json"
+	DEFAULT_TRUSTED_HOOKS_FILE    = "trusted-post-scan-hooks.json"
+	SCAN_GIT_SOURCE_FILE_SUFFIX   = ".git.json"
+	DEFAULT_SCAN_HISTORY_FOLDER   = "history"
+	DEFAULT_API_CACHE_FOLDER      = "cache"
+	DEFAULT_API_CACHE_MAX_SIZE_MB = 256
+	DEFAULT_SCAN_HISTORY_MAX_RUNS = 20
+	DEFAULT_ARCHIVE_MAX_SIZE_MB   = 4096
+	DEFAULT_ARCHIVE_MAX_FILES     = 200000
+	DEFAULT_HTTP_MAX_RETRIES      = 3
+	DEFAULT_HTTP_RETRY_BASE_MS    = 500
+	DEFAULT_HTTP_RETRY_MAX_MS     = 10000
+	DEFAULT_HTTP_MAX_RETRY_AFTER  = 60
+	DEFAULT_HTTP_BREAKER_FAILURES = 5
+	DEFAULT_HTTP_BREAKER_COOLDOWN = 30
 	DEFAULT_CONFIG_FILE_NAME      = "scanoss-cc-settings"
 	DEFAULT_CONFIG_FILE_TYPE      = "json"
 	ROOT_FOLDER                   = "."
@@ -54,6 +76,13 @@ const (
 	SCANOSS_PREMIUM_API_URL       = "https://api.scanoss.com"
 )
 
+// DefaultAPICacheTTLSeconds is how long responses of the cached API endpoints are kept, endpoints missing from
+// it are not cached. Licenses of a version rarely change, component searches follow new releases.
+var DefaultAPICacheTTLSeconds = map[string]int{
+	"/v2/licenses/component": 7 * 24 * 60 * 60,
+	"/v2/components/search":  24 * 60 * 60,
+}
+
 // DefaultAPIURL Build-time overridable default. Can be set with:
 // go build -ldflags "-X 'github.com/scanoss/scanoss.cc/internal/config.DefaultAPIURL=https://...'"
 var DefaultAPIURL = "https://api.osskb.org"
@@ -62,14 +91,44 @@ type Config struct {
 	apiToken             string
 	apiUrl               string
 	resultFilePath       string
+	resultFilePaths      []string
+	inputFormat          string
+	startupView          string
+	scanner              string
 	scanRoot             string
 	scanSettingsFilePath string
 	recentScanRoots      []string
+	scanHistoryMaxRuns   int
+	scanHistoryMaxAge    int
+	scannerBinary        string
+	scannerPython        string
+	scannerEnv           []string
+	archiveLimits        archive.Limits
+	httpSettings         HTTPSettings
+	apiCacheSettings     APICacheSettings
 	debug                bool
 	mu                   sync.RWMutex
 	listeners            []func(*Config)
 }
 
+// HTTPSettings tune the retries and circuit breaking of requests to the SCANOSS API, as read from the "http"
+// section of the config file. A zero value disables the setting.
+type HTTPSettings struct {
+	MaxRetries              int
+	RetryBaseDelayMs        int
+	RetryMaxDelayMs         int
+	MaxRetryAfterSeconds    int
+	BreakerFailureThreshold int
+	BreakerCooldownSeconds  int
+}
+
+// APICacheSettings control the on-disk cache of API lookups, as read from the "cache" section of the config file.
+type APICacheSettings struct {
+	Enabled    bool
+	MaxSizeMB  int            // 0 for no limit
+	TTLSeconds map[string]int // Keyed by endpoint
+}
+
 type ConfigDTO struct {
 	ApiToken             string   `json:"apitoken"`
 	ApiUrl               string   `json:"apiurl"`
@@ -143,14 +202,26 @@ func (c *Config) notifyListeners() {
 	}
 }
 
-func (c *Config) getDefaultResultFilePath(scanRoot string) string {
+func (c *Config) GetDefaultResultFilePath(scanRoot string) string {
+	if archive.IsArchive(scanRoot) {
+		return filepath.Join(archiveProjectFolder(scanRoot), DEFAULT_RESULTS_FILE)
+	}
 	return filepath.Join(scanRoot, SCANOSS_HIDDEN_FOLDER, DEFAULT_RESULTS_FILE)
 }
 
-func (c *Config) getDefaultScanSettingsFilePath(scanRoot string) string {
+func (c *Config) GetDefaultScanSettingsFilePath(scanRoot string) string {
+	if archive.IsArchive(scanRoot) {
+		return filepath.Join(archiveProjectFolder(scanRoot), DEFAULT_SCANOSS_SETTINGS_FILE)
+	}
 	return filepath.Join(scanRoot, DEFAULT_SCANOSS_SETTINGS_FILE)
 }
 
+// archiveProjectFolder returns where the results and settings of an archive scan root are kept, since they
+// cannot be written into the archive: .scanoss/<archive name>/ next to it.
+func archiveProjectFolder(archivePath string) string {
+	return filepath.Join(filepath.Dir(archivePath), SCANOSS_HIDDEN_FOLDER, filepath.Base(archivePath))
+}
+
 func (c *Config) GetApiToken() string {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -169,6 +240,126 @@ func (c *Config) GetResultFilePath() string {
 	return c.resultFilePath
 }
 
+// GetResultFilePaths returns every results file of the review session. It only holds more than one
+// path when several inputs are merged, the first one is always the result file path.
+func (c *Config) GetResultFilePaths() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if len(c.resultFilePaths) > 0 {
+		return slices.Clone(c.resultFilePaths)
+	}
+	if c.resultFilePath == "" {
+		return []string{}
+	}
+	return []string{c.resultFilePath}
+}
+
+// GetInputFormat returns the format the results file should be read as. It is only set from the command line
+// and is not persisted, an empty value means the format is detected from the file contents.
+func (c *Config) GetInputFormat() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.inputFormat
+}
+
+// GetStartupView returns the name of the saved view to apply when the app opens. Like the input format, it is
+// only set from the command line and is not persisted.
+func (c *Config) GetStartupView() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.startupView
+}
+
+// GetResultViewsFilePath returns where saved views are stored, next to the (first) results file.
+func (c *Config) GetResultViewsFilePath() string {
+	return filepath.Join(filepath.Dir(c.GetResultFilePath()), DEFAULT_RESULT_VIEWS_FILE)
+}
+
+// GetScanner returns the scan engine selected on the command line, python or native. It is not persisted.
+func (c *Config) GetScanner() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.scanner
+}
+
+// GetHTTPSettings returns how requests to the SCANOSS API are retried and when they stop being sent.
+func (c *Config) GetHTTPSettings() HTTPSettings {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.httpSettings
+}
+
+// GetAPICacheSettings returns whether API lookups are cached, how much disk the cache may use and how long the
+// responses of each endpoint are kept.
+func (c *Config) GetAPICacheSettings() APICacheSettings {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	settings := c.apiCacheSettings
+	settings.TTLSeconds = maps.Clone(settings.TTLSeconds)
+	return settings
+}
+
+// GetAPICacheFolder returns the folder API lookups are cached in, $HOME/.scanoss/cache.
+func (c *Config) GetAPICacheFolder() string {
+	configFolder := c.GetDefaultConfigFolder()
+	if configFolder == "" {
+		return ""
+	}
+	return filepath.Join(configFolder, DEFAULT_API_CACHE_FOLDER)
+}
+
+// GetArchiveLimits returns how much an archive used as scan root may extract. It is read from the
+// "archive.maxsizemb" and "archive.maxfiles" settings of the config file, 0 disables a limit.
+func (c *Config) GetArchiveLimits() archive.Limits {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.archiveLimits
+}
+
+// GetScanContentRoot returns the folder holding the files of the scan root. It is the scan root itself, or the
+// folder it was extracted to when the scan root is an archive.
+func (c *Config) GetScanContentRoot() (string, error) {
+	return archive.ContentRoot(c.GetScanRoot(), c.GetArchiveLimits())
+}
+
+// GetScanHistoryMaxRuns returns how many past scans are kept in the scan history, 0 for no limit.
+// It is read from the "scanhistory.maxruns" setting of the config file.
+func (c *Config) GetScanHistoryMaxRuns() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.scanHistoryMaxRuns
+}
+
+// GetScanHistoryMaxAgeDays returns after how many days past scans are removed from the scan history, 0 to keep
+// them. It is read from the "scanhistory.maxagedays" setting of the config file.
+func (c *Config) GetScanHistoryMaxAgeDays() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.scanHistoryMaxAge
+}
+
+// GetScannerBinary returns the scanoss-py executable set with the "scanner.binary" setting, empty to look it up.
+func (c *Config) GetScannerBinary() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.scannerBinary
+}
+
+// GetScannerPython returns the Python interpreter or virtualenv folder set with the "scanner.python" setting,
+// used to find scanoss-py when no binary is configured. A relative path is inside the scan root.
+func (c *Config) GetScannerPython() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.scannerPython
+}
+
+// GetScannerEnv returns the extra "NAME=value" environment variables for the scanner, from the "scanner.env" setting.
+func (c *Config) GetScannerEnv() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return slices.Clone(c.scannerEnv)
+}
+
 func (c *Config) GetScanRoot() string {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -239,15 +430,83 @@ func (c *Config) SetApiUrl(url string) error {
 func (c *Config) SetResultFilePath(path string) {
 	c.mu.Lock()
 	c.resultFilePath = path
+	c.resultFilePaths = nil
+	c.mu.Unlock()
+	c.notifyListeners()
+}
+
+// SetResultFilePaths sets the results files merged into the review session.
+// Only the first one is persisted as the result file path.
+func (c *Config) SetResultFilePaths(paths []string) {
+	if len(paths) == 0 {
+		return
+	}
+	c.mu.Lock()
+	c.resultFilePath = paths[0]
+	c.resultFilePaths = slices.Clone(paths)
+	c.mu.Unlock()
+	c.notifyListeners()
+}
+
+func (c *Config) SetInputFormat(format string) {
+	c.mu.Lock()
+	c.inputFormat = format
 	c.mu.Unlock()
 	c.notifyListeners()
 }
 
+func (c *Config) SetStartupView(name string) {
+	c.mu.Lock()
+	c.startupView = name
+	c.mu.Unlock()
+}
+
+func (c *Config) SetScanner(scanner string) {
+	c.mu.Lock()
+	c.scanner = scanner
+	c.mu.Unlock()
+}
+
+func (c *Config) SetScanHistoryRetention(maxRuns, maxAgeDays int) {
+	c.mu.Lock()
+	c.scanHistoryMaxRuns = maxRuns
+	c.scanHistoryMaxAge = maxAgeDays
+	c.mu.Unlock()
+}
+
+func (c *Config) SetAPICacheSettings(settings APICacheSettings) {
+	c.mu.Lock()
+	settings.TTLSeconds = maps.Clone(settings.TTLSeconds)
+	c.apiCacheSettings = settings
+	c.mu.Unlock()
+}
+
+func (c *Config) SetHTTPSettings(settings HTTPSettings) {
+	c.mu.Lock()
+	c.httpSettings = settings
+	c.mu.Unlock()
+}
+
+func (c *Config) SetArchiveLimits(maxSizeMB, maxFiles int) {
+	c.mu.Lock()
+	c.archiveLimits = archive.Limits{MaxBytes: int64(maxSizeMB) * 1024 * 1024, MaxFiles: maxFiles}
+	c.mu.Unlock()
+}
+
+func (c *Config) SetScannerEnvironment(binary, python string, env []string) {
+	c.mu.Lock()
+	c.scannerBinary = binary
+	c.scannerPython = python
+	c.scannerEnv = slices.Clone(env)
+	c.mu.Unlock()
+}
+
 func (c *Config) SetScanRoot(path string) {
 	c.mu.Lock()
 	c.scanRoot = path
-	c.resultFilePath = c.getDefaultResultFilePath(path)
-	c.scanSettingsFilePath = c.getDefaultScanSettingsFilePath(path)
+	c.resultFilePath = c.GetDefaultResultFilePath(path)
+	c.resultFilePaths = nil
+	c.scanSettingsFilePath = c.GetDefaultScanSettingsFilePath(path)
 	c.mu.Unlock()
 	if err := c.AddRecentScanRoot(path); err != nil {
 		log.Error().Err(err).Msg("Error adding recent scan root")
@@ -315,6 +574,21 @@ func (c *Config) setupLogger(debug bool) error {
 func (c *Config) initializeConfigFile(cfgFile string) error {
 	viper.SetDefault("apiurl", DefaultAPIURL)
 	viper.SetDefault("apitoken", "")
+	viper.SetDefault("scanhistory.maxruns", DEFAULT_SCAN_HISTORY_MAX_RUNS)
+	viper.SetDefault("scanhistory.maxagedays", 0)
+	viper.SetDefault("archive.maxsizemb", DEFAULT_ARCHIVE_MAX_SIZE_MB)
+	viper.SetDefault("archive.maxfiles", DEFAULT_ARCHIVE_MAX_FILES)
+	viper.SetDefault("cache.enabled", true)
+	viper.SetDefault("cache.maxsizemb", DEFAULT_API_CACHE_MAX_SIZE_MB)
+	viper.SetDefault("http.maxretries", DEFAULT_HTTP_MAX_RETRIES)
+	viper.SetDefault("http.retrybasedelayms", DEFAULT_HTTP_RETRY_BASE_MS)
+	viper.SetDefault("http.retrymaxdelayms", DEFAULT_HTTP_RETRY_MAX_MS)
+	viper.SetDefault("http.maxretryafterseconds", DEFAULT_HTTP_MAX_RETRY_AFTER)
+	viper.SetDefault("http.breakerfailurethreshold", DEFAULT_HTTP_BREAKER_FAILURES)
+	viper.SetDefault("http.breakercooldownseconds", DEFAULT_HTTP_BREAKER_COOLDOWN)
+	viper.SetDefault("scanner.binary", "")
+	viper.SetDefault("scanner.python", "")
+	viper.SetDefault("scanner.env", []string{})
 
 	if cfgFile != "" {
 		absCfgFile, _ := filepath.Abs(cfgFile)
@@ -382,7 +656,7 @@ func (c *Config) initializeApiConfig(apiKey, apiUrl string) error {
 	return nil
 }
 
-func (c *Config) initializePathConfig(scanRoot, inputFile, scanossSettingsFilePath, originalWorkDir string) error {
+func (c *Config) initializePathConfig(scanRoot string, inputFiles []string, scanossSettingsFilePath, originalWorkDir string) error {
 	c.SetRecentScanRoots(viper.GetStringSlice("recentscanroots"))
 
 	if scanRoot != "" {
@@ -402,19 +676,23 @@ func (c *Config) initializePathConfig(scanRoot, inputFile, scanossSettingsFilePa
 		}
 	}
 	if c.GetResultFilePath() == "" {
-		defaultPath := c.getDefaultResultFilePath(originalWorkDir)
+		defaultPath := c.GetDefaultResultFilePath(originalWorkDir)
 		if !filepath.IsAbs(defaultPath) {
 			defaultPath = filepath.Join(c.GetScanRoot(), defaultPath)
 		}
 		c.SetResultFilePath(defaultPath)
 	}
 	if c.GetScanSettingsFilePath() == "" {
-		c.SetScanSettingsFilePath(c.getDefaultScanSettingsFilePath(originalWorkDir))
+		c.SetScanSettingsFilePath(c.GetDefaultScanSettingsFilePath(originalWorkDir))
 	}
 
 	// Apply explicit CLI overrides last so they always win over defaults
-	if inputFile != "" {
-		c.SetResultFilePath(inputFile)
+	if len(inputFiles) > 0 {
+		paths, err := expandResultFilePatterns(inputFiles)
+		if err != nil {
+			return err
+		}
+		c.SetResultFilePaths(paths)
 	}
 	if scanossSettingsFilePath != "" {
 		c.SetScanSettingsFilePath(scanossSettingsFilePath)
@@ -423,7 +701,24 @@ func (c *Config) initializePathConfig(scanRoot, inputFile, scanossSettingsFilePa
 	return nil
 }
 
-func (c *Config) InitializeConfig(cfgFile, scanRoot, apiKey, apiUrl, inputFile, scanossSettingsFilePath string, originalWorkDir string, debug bool) error {
+// apiCacheTTLSeconds returns the default cache TTLs overridden by the "cache.ttlSeconds" entries of the config
+// file, keyed by endpoint. A TTL of 0 stops caching an endpoint.
+func apiCacheTTLSeconds() map[string]int {
+	ttls := maps.Clone(DefaultAPICacheTTLSeconds)
+	for endpoint, value := range viper.GetStringMap("cache.ttlseconds") {
+		switch ttl := value.(type) {
+		case float64:
+			ttls[endpoint] = int(ttl)
+		case int:
+			ttls[endpoint] = ttl
+		default:
+			log.Warn().Msgf("Ignoring cache TTL of %s, expected a number of seconds", endpoint)
+		}
+	}
+	return ttls
+}
+
+func (c *Config) InitializeConfig(cfgFile, scanRoot, apiKey, apiUrl string, inputFiles []string, scanossSettingsFilePath string, originalWorkDir string, debug bool) error {
 	if err := c.setupLogger(debug); err != nil {
 		return fmt.Errorf("error setting up logger: %w", err)
 	}
@@ -437,10 +732,58 @@ func (c *Config) InitializeConfig(cfgFile, scanRoot, apiKey, apiUrl, inputFile,
 	}
 
 	c.SetDebug(debug)
+	c.SetScanHistoryRetention(viper.GetInt("scanhistory.maxruns"), viper.GetInt("scanhistory.maxagedays"))
+	c.SetArchiveLimits(viper.GetInt("archive.maxsizemb"), viper.GetInt("archive.maxfiles"))
+	c.SetAPICacheSettings(APICacheSettings{
+		Enabled:    viper.GetBool("cache.enabled"),
+		MaxSizeMB:  viper.GetInt("cache.maxsizemb"),
+		TTLSeconds: apiCacheTTLSeconds(),
+	})
+	c.SetHTTPSettings(HTTPSettings{
+		MaxRetries:              viper.GetInt("http.maxretries"),
+		RetryBaseDelayMs:        viper.GetInt("http.retrybasedelayms"),
+		RetryMaxDelayMs:         viper.GetInt("http.retrymaxdelayms"),
+		MaxRetryAfterSeconds:    viper.GetInt("http.maxretryafterseconds"),
+		BreakerFailureThreshold: viper.GetInt("http.breakerfailurethreshold"),
+		BreakerCooldownSeconds:  viper.GetInt("http.breakercooldownseconds"),
+	})
+	c.SetScannerEnvironment(viper.GetString("scanner.binary"), viper.GetString("scanner.python"), viper.GetStringSlice("scanner.env"))
 
-	if err := c.initializePathConfig(scanRoot, inputFile, scanossSettingsFilePath, originalWorkDir); err != nil {
+	if err := c.initializePathConfig(scanRoot, inputFiles, scanossSettingsFilePath, originalWorkDir); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// expandResultFilePatterns resolves the --input values into results file paths.
+// Values containing glob patterns must match at least one file, plain paths are kept as given
+// since the file may be created later by a scan.
+func expandResultFilePatterns(patterns []string) ([]string, error) {
+	var paths []string
+	for _, pattern := range patterns {
+		if pattern == "" {
+			continue
+		}
+		if !strings.ContainsAny(pattern, "*?[") {
+			if !slices.Contains(paths, pattern) {
+				paths = append(paths, pattern)
+			}
+			continue
+		}
+
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input pattern %q: %w", pattern, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("%w: %q", ErrNoResultFilesMatched, pattern)
+		}
+		for _, match := range matches {
+			if !slices.Contains(paths, match) {
+				paths = append(paths, match)
+			}
+		}
+	}
+	return paths, nil
+}
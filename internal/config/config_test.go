@@ -37,6 +37,16 @@ import (
 
 func initConfig(t *testing.T, scanRoot, inputFile, settingsFile, workDir string) *config.Config {
 	t.Helper()
+
+	var inputFiles []string
+	if inputFile != "" {
+		inputFiles = []string{inputFile}
+	}
+	return initConfigWithInputs(t, scanRoot, inputFiles, settingsFile, workDir)
+}
+
+func initConfigWithInputs(t *testing.T, scanRoot string, inputFiles []string, settingsFile, workDir string) *config.Config {
+	t.Helper()
 	config.ResetInstance()
 
 	f, err := os.CreateTemp("", "scanoss-cc-settings-*.json")
@@ -47,7 +57,7 @@ func initConfig(t *testing.T, scanRoot, inputFile, settingsFile, workDir string)
 	t.Cleanup(func() { os.Remove(f.Name()) })
 
 	cfg := config.GetInstance()
-	err = cfg.InitializeConfig(f.Name(), scanRoot, "", "", inputFile, settingsFile, workDir, false)
+	err = cfg.InitializeConfig(f.Name(), scanRoot, "", "", inputFiles, settingsFile, workDir, false)
 	require.NoError(t, err)
 	return cfg
 }
@@ -64,7 +74,7 @@ func initConfigWithApiArgs(t *testing.T, apiKey, apiUrl string) *config.Config {
 	t.Cleanup(func() { os.Remove(f.Name()) })
 
 	cfg := config.GetInstance()
-	err = cfg.InitializeConfig(f.Name(), "", apiKey, apiUrl, "", "", "", false)
+	err = cfg.InitializeConfig(f.Name(), "", apiKey, apiUrl, nil, "", "", false)
 	require.NoError(t, err)
 	return cfg
 }
@@ -164,3 +174,101 @@ func TestInitializePathConfig(t *testing.T) {
 		assert.Equal(t, expectedSettings, cfg.GetScanSettingsFilePath())
 	})
 }
+
+func TestInitializeMultipleInputs(t *testing.T) {
+	root := t.TempDir()
+	for _, service := range []string{"api", "web"} {
+		dir := filepath.Join(root, "services", service, ".scanoss")
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "results.json"), []byte(`{}`), 0o644))
+	}
+	apiResults := filepath.Join(root, "services", "api", ".scanoss", "results.json")
+	webResults := filepath.Join(root, "services", "web", ".scanoss", "results.json")
+
+	t.Run("glob is expanded into every matching file", func(t *testing.T) {
+		cfg := initConfigWithInputs(t, root, []string{filepath.Join(root, "services", "*", ".scanoss", "results.json")}, "", root)
+
+		assert.Equal(t, []string{apiResults, webResults}, cfg.GetResultFilePaths())
+		assert.Equal(t, apiResults, cfg.GetResultFilePath())
+	})
+
+	t.Run("repeated inputs are merged without duplicates", func(t *testing.T) {
+		cfg := initConfigWithInputs(t, root, []string{webResults, apiResults, webResults}, "", root)
+
+		assert.Equal(t, []string{webResults, apiResults}, cfg.GetResultFilePaths())
+	})
+
+	t.Run("glob without matches is an error", func(t *testing.T) {
+		config.ResetInstance()
+		settings := filepath.Join(t.TempDir(), "scanoss-cc-settings.json")
+		require.NoError(t, os.WriteFile(settings, []byte(`{}`), 0o644))
+
+		err := config.GetInstance().InitializeConfig(settings, root, "", "", []string{filepath.Join(root, "missing", "*.json")}, "", root, false)
+
+		assert.ErrorIs(t, err, config.ErrNoResultFilesMatched)
+	})
+
+	t.Run("changing scan root goes back to a single results file", func(t *testing.T) {
+		cfg := initConfigWithInputs(t, root, []string{apiResults, webResults}, "", root)
+		cfg.SetScanRoot(filepath.Join(root, "services", "api"))
+
+		assert.Equal(t, []string{apiResults}, cfg.GetResultFilePaths())
+	})
+}
+
+func TestInitializeScannerEnvironment(t *testing.T) {
+	config.ResetInstance()
+	root := t.TempDir()
+	settings := filepath.Join(t.TempDir(), "scanoss-cc-settings.json")
+	require.NoError(t, os.WriteFile(settings, []byte(`{
+		"scanner": {
+			"binary": "/opt/scanoss/bin/scanoss-py",
+			"python": "~/.venvs/scanoss",
+			"env": ["HTTPS_PROXY=http://proxy:3128", "SCANOSS_DEBUG=1"]
+		}
+	}`), 0o644))
+
+	cfg := config.GetInstance()
+	require.NoError(t, cfg.InitializeConfig(settings, root, "", "", nil, "", root, false))
+
+	assert.Equal(t, "/opt/scanoss/bin/scanoss-py", cfg.GetScannerBinary())
+	assert.Equal(t, "~/.venvs/scanoss", cfg.GetScannerPython())
+	assert.Equal(t, []string{"HTTPS_PROXY=http://proxy:3128", "SCANOSS_DEBUG=1"}, cfg.GetScannerEnv())
+}
+
+func TestInitializeArchiveScanRoot(t *testing.T) {
+	config.ResetInstance()
+	dir := t.TempDir()
+	archivePath := filepath.Join(dir, "drop-1.0.zip")
+	require.NoError(t, os.WriteFile(archivePath, nil, 0o644))
+	settings := filepath.Join(t.TempDir(), "scanoss-cc-settings.json")
+	require.NoError(t, os.WriteFile(settings, []byte(`{"archive": {"maxSizeMB": 10, "maxFiles": 500}}`), 0o644))
+
+	cfg := config.GetInstance()
+	require.NoError(t, cfg.InitializeConfig(settings, archivePath, "", "", nil, "", dir, false))
+
+	assert.Equal(t, archivePath, cfg.GetScanRoot())
+	assert.Equal(t, filepath.Join(dir, ".scanoss", "drop-1.0.zip", "results.json"), cfg.GetResultFilePath())
+	assert.Equal(t, filepath.Join(dir, ".scanoss", "drop-1.0.zip", "scanoss.json"), cfg.GetScanSettingsFilePath())
+	assert.Equal(t, int64(10*1024*1024), cfg.GetArchiveLimits().MaxBytes)
+	assert.Equal(t, 500, cfg.GetArchiveLimits().MaxFiles)
+}
+
+func TestInitializeHTTPSettings(t *testing.T) {
+	config.ResetInstance()
+	root := t.TempDir()
+	settings := filepath.Join(t.TempDir(), "scanoss-cc-settings.json")
+	require.NoError(t, os.WriteFile(settings, []byte(`{"http": {"maxRetries": 5, "breakerFailureThreshold": 0}}`), 0o644))
+
+	cfg := config.GetInstance()
+	require.NoError(t, cfg.InitializeConfig(settings, root, "", "", nil, "", root, false))
+
+	assert.Equal(t, config.HTTPSettings{
+		MaxRetries:              5,
+		RetryBaseDelayMs:        500,
+		RetryMaxDelayMs:         10000,
+		MaxRetryAfterSeconds:    60,
+		BreakerFailureThreshold: 0,
+		BreakerCooldownSeconds:  30,
+	}, cfg.GetHTTPSettings())
+}
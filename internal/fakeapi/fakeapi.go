@@ -0,0 +1,485 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+// Package fakeapi is a local stand-in for the SCANOSS API. It answers the endpoints used by the application
+// from fixture directories, with optional latency and error injection, so the app and its tests can run offline.
+package fakeapi
+
+import (
+	"bufio"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/scanoss/scanoss.cc/backend/entities"
+)
+
+const (
+	ComponentSearchEndpoint  = "/v2/components/search"
+	ComponentLicenseEndpoint = "/v2/licenses/component"
+	FileContentsEndpoint     = "/file_contents/"
+	ScanDirectEndpoint       = "/scan/direct"
+
+	componentSearchFixture = "components/search.json"
+	licensesFixture        = "licenses/licenses.json"
+	fileContentsDir        = "file_contents"
+	scanDir                = "scan"
+
+	defaultSearchLimit = 50
+	maxScanUploadSize  = 32 << 20
+)
+
+//go:embed fixtures
+var embeddedFixtures embed.FS
+
+var (
+	md5Pattern  = regexp.MustCompile(`^[a-f0-9]{32}$`)
+	wfpFileLine = regexp.MustCompile(`^file=([a-f0-9]{32}),\d+,(.+)$`)
+)
+
+// Faults describes the failures injected into every request.
+type Faults struct {
+	// Latency is added before each response is written.
+	Latency time.Duration
+	// ErrorRate is the probability, between 0 and 1, that a request fails with ErrorStatus.
+	ErrorRate float64
+	// ErrorStatus is the HTTP status of injected failures. Defaults to 503 Service Unavailable.
+	ErrorStatus int
+	// FailFirst makes the next FailFirst requests fail with ErrorStatus before any succeed.
+	FailFirst int
+}
+
+// Options configures a Server.
+type Options struct {
+	// FixturesDir overrides the embedded fixtures. Files missing from it fall back to the embedded ones.
+	FixturesDir string
+	// APIKey, when set, is required in the x-api-key header of every request.
+	APIKey string
+	Faults
+}
+
+// Server serves the fake SCANOSS API. It is safe for concurrent use.
+type Server struct {
+	fixtures []fs.FS
+	apiKey   string
+
+	mu       sync.Mutex
+	faults   Faults
+	random   *rand.Rand
+	requests map[string]int
+	mux      *http.ServeMux
+}
+
+// New creates a Server from the given options.
+func New(opts Options) (*Server, error) {
+	embedded, err := fs.Sub(embeddedFixtures, "fixtures")
+	if err != nil {
+		return nil, err
+	}
+
+	fixtures := []fs.FS{embedded}
+	if opts.FixturesDir != "" {
+		info, err := os.Stat(opts.FixturesDir)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fixtures directory: %w", err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("invalid fixtures directory: %s is not a directory", opts.FixturesDir)
+		}
+		fixtures = append([]fs.FS{os.DirFS(opts.FixturesDir)}, fixtures...)
+	}
+
+	if err := validateFaults(opts.Faults); err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		fixtures: fixtures,
+		apiKey:   opts.APIKey,
+		faults:   opts.Faults,
+		random:   rand.New(rand.NewSource(time.Now().UnixNano())),
+		requests: make(map[string]int),
+		mux:      http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc(ComponentSearchEndpoint, s.handleComponentSearch)
+	s.mux.HandleFunc(ComponentLicenseEndpoint, s.handleComponentLicense)
+	s.mux.HandleFunc(FileContentsEndpoint, s.handleFileContents)
+	s.mux.HandleFunc(ScanDirectEndpoint, s.handleScanDirect)
+
+	return s, nil
+}
+
+// Start runs a Server on a random local port. Close the returned httptest.Server when done; its URL is the API base URL.
+func Start(opts Options) (*Server, *httptest.Server, error) {
+	s, err := New(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s, httptest.NewServer(s), nil
+}
+
+// Fixture returns the contents of an embedded fixture, e.g. "file_contents/<md5>" to recreate a file the fake API knows.
+func Fixture(name string) ([]byte, error) {
+	return fs.ReadFile(embeddedFixtures, path.Join("fixtures", name))
+}
+
+// SetFaults replaces the injected faults for subsequent requests.
+func (s *Server) SetFaults(faults Faults) error {
+	if err := validateFaults(faults); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = faults
+	return nil
+}
+
+// Requests returns how many requests were received for the given endpoint, including failed ones.
+func (s *Server) Requests(endpoint string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[endpoint]
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	endpoint := r.URL.Path
+	if strings.HasPrefix(endpoint, FileContentsEndpoint) {
+		endpoint = FileContentsEndpoint
+	}
+
+	latency, failStatus := s.nextFault(endpoint)
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	log.Debug().Str("method", r.Method).Str("path", r.URL.Path).Int("injectedStatus", failStatus).Msg("Fake API request")
+
+	if failStatus != 0 {
+		writeStatus(w, failStatus, "injected failure")
+		return
+	}
+
+	if s.apiKey != "" && r.Header.Get("x-api-key") != s.apiKey {
+		writeStatus(w, http.StatusUnauthorized, "invalid API key")
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) nextFault(endpoint string) (time.Duration, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests[endpoint]++
+
+	status := s.faults.ErrorStatus
+	if status == 0 {
+		status = http.StatusServiceUnavailable
+	}
+
+	if s.faults.FailFirst > 0 {
+		s.faults.FailFirst--
+		return s.faults.Latency, status
+	}
+	if s.faults.ErrorRate > 0 && s.random.Float64() < s.faults.ErrorRate {
+		return s.faults.Latency, status
+	}
+	return s.faults.Latency, 0
+}
+
+func (s *Server) handleComponentSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeStatus(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	query := r.URL.Query()
+	search := strings.ToLower(query.Get("search"))
+	vendor := strings.ToLower(query.Get("vendor"))
+	component := strings.ToLower(query.Get("component"))
+	pkg := strings.ToLower(query.Get("package"))
+	if search == "" && component == "" {
+		writeStatus(w, http.StatusBadRequest, "search or component is required")
+		return
+	}
+
+	limit, err := intParam(query.Get("limit"), defaultSearchLimit)
+	if err != nil {
+		writeStatus(w, http.StatusBadRequest, "invalid limit")
+		return
+	}
+	offset, err := intParam(query.Get("offset"), 0)
+	if err != nil {
+		writeStatus(w, http.StatusBadRequest, "invalid offset")
+		return
+	}
+
+	var catalog entities.ComponentSearchResponse
+	if err := s.readJSON(componentSearchFixture, &catalog); err != nil {
+		writeStatus(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	matches := make([]entities.SearchedComponent, 0)
+	for _, c := range catalog.Components {
+		name := strings.ToLower(c.Component)
+		purl := strings.ToLower(c.Purl)
+		if search != "" && !strings.Contains(name, search) && !strings.Contains(purl, search) {
+			continue
+		}
+		if component != "" && !strings.Contains(name, component) {
+			continue
+		}
+		if vendor != "" && purlNamespace(purl) != vendor {
+			continue
+		}
+		if pkg != "" && purlType(purl) != pkg {
+			continue
+		}
+		matches = append(matches, c)
+	}
+
+	if offset > len(matches) {
+		offset = len(matches)
+	}
+	matches = matches[offset:]
+	if limit > 0 && limit < len(matches) {
+		matches = matches[:limit]
+	}
+
+	writeJSON(w, http.StatusOK, entities.ComponentSearchResponse{
+		Components: matches,
+		Status:     entities.StatusResponse{Status: "SUCCESS", Message: "Components Successfully retrieved"},
+	})
+}
+
+func (s *Server) handleComponentLicense(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeStatus(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	purl := r.URL.Query().Get("purl")
+	if purl == "" {
+		writeStatus(w, http.StatusBadRequest, "purl is required")
+		return
+	}
+
+	var licenses map[string]entities.ComponentLicenseInfo
+	if err := s.readJSON(licensesFixture, &licenses); err != nil {
+		writeStatus(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	info, ok := licenses[purl]
+	if !ok {
+		writeStatus(w, http.StatusNotFound, fmt.Sprintf("component %s not found", purl))
+		return
+	}
+	if requirement := r.URL.Query().Get("requirement"); requirement != "" {
+		info.Version = requirement
+	}
+
+	writeJSON(w, http.StatusOK, entities.GetLicensesByPurlResponse{
+		Status:    entities.StatusResponse{Status: "SUCCESS", Message: "Licenses Successfully retrieved"},
+		Component: info,
+	})
+}
+
+func (s *Server) handleFileContents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeStatus(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	md5 := strings.TrimPrefix(r.URL.Path, FileContentsEndpoint)
+	if !md5Pattern.MatchString(md5) {
+		writeStatus(w, http.StatusBadRequest, "invalid file MD5")
+		return
+	}
+
+	contents, err := s.readFixture(path.Join(fileContentsDir, md5))
+	if errors.Is(err, fs.ErrNotExist) {
+		writeStatus(w, http.StatusNotFound, fmt.Sprintf("file %s not found", md5))
+		return
+	}
+	if err != nil {
+		writeStatus(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write(contents)
+}
+
+// handleScanDirect answers a WFP upload with the scan fixture of each fingerprinted file, keyed by the file path.
+// Files without a fixture get a "none" match, as the real API returns for unknown files.
+func (s *Server) handleScanDirect(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeStatus(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxScanUploadSize)
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		writeStatus(w, http.StatusBadRequest, "missing WFP file")
+		return
+	}
+	defer file.Close()
+
+	results := make(map[string]json.RawMessage)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxScanUploadSize)
+	for scanner.Scan() {
+		match := wfpFileLine.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		result, err := s.scanResult(match[1])
+		if err != nil {
+			writeStatus(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		results[match[2]] = result
+	}
+	if err := scanner.Err(); err != nil {
+		writeStatus(w, http.StatusBadRequest, "invalid WFP file")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, results)
+}
+
+func (s *Server) scanResult(md5 string) (json.RawMessage, error) {
+	contents, err := s.readFixture(path.Join(scanDir, md5+".json"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return json.RawMessage(`[{"id":"none"}]`), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(contents) {
+		return nil, fmt.Errorf("invalid scan fixture for %s", md5)
+	}
+	return json.RawMessage(contents), nil
+}
+
+// readFixture returns the first fixture found for name, looking at the fixtures directory before the embedded ones.
+func (s *Server) readFixture(name string) ([]byte, error) {
+	for _, fixtures := range s.fixtures {
+		contents, err := fs.ReadFile(fixtures, name)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		return contents, err
+	}
+	return nil, fs.ErrNotExist
+}
+
+func (s *Server) readJSON(name string, v any) error {
+	contents, err := s.readFixture(name)
+	if err != nil {
+		return fmt.Errorf("error reading fixture %s: %w", name, err)
+	}
+	if err := json.Unmarshal(contents, v); err != nil {
+		return fmt.Errorf("error parsing fixture %s: %w", name, err)
+	}
+	return nil
+}
+
+func validateFaults(faults Faults) error {
+	if faults.ErrorRate < 0 || faults.ErrorRate > 1 {
+		return fmt.Errorf("error rate must be between 0 and 1, got %v", faults.ErrorRate)
+	}
+	if faults.ErrorStatus != 0 && (faults.ErrorStatus < 400 || faults.ErrorStatus > 599) {
+		return fmt.Errorf("error status must be a 4xx or 5xx HTTP status, got %d", faults.ErrorStatus)
+	}
+	if faults.Latency < 0 || faults.FailFirst < 0 {
+		return fmt.Errorf("latency and fail-first must not be negative")
+	}
+	return nil
+}
+
+func intParam(value string, fallback int) (int, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	return n, nil
+}
+
+// purlType returns the type of a purl, e.g. "github" for "pkg:github/scanoss/engine".
+func purlType(purl string) string {
+	rest, ok := strings.CutPrefix(purl, "pkg:")
+	if !ok {
+		return ""
+	}
+	t, _, _ := strings.Cut(rest, "/")
+	return t
+}
+
+// purlNamespace returns the namespace of a purl, e.g. "scanoss" for "pkg:github/scanoss/engine".
+func purlNamespace(purl string) string {
+	parts := strings.Split(strings.TrimPrefix(purl, "pkg:"), "/")
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[1]
+}
+
+func writeStatus(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]entities.StatusResponse{
+		"status": {Status: "FAILED", Message: message},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Error().Err(err).Msg("Error writing fake API response")
+	}
+}
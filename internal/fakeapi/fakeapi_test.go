@@ -0,0 +1,235 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package fakeapi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/scanoss/scanoss.cc/backend/entities"
+	"github.com/scanoss/scanoss.cc/internal/fakeapi"
+	"github.com/scanoss/scanoss.cc/internal/wfp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const helloMD5 = "c48d764d65801d6545037921baea24b0"
+
+func startServer(t *testing.T, opts fakeapi.Options) (*fakeapi.Server, string) {
+	t.Helper()
+	s, server, err := fakeapi.Start(opts)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	return s, server.URL
+}
+
+func get(t *testing.T, url string, headers map[string]string) (*http.Response, []byte) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, body
+}
+
+func postWFP(t *testing.T, url, fingerprints string) (*http.Response, []byte) {
+	t.Helper()
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", "scan.wfp")
+	require.NoError(t, err)
+	_, err = part.Write([]byte(fingerprints))
+	require.NoError(t, err)
+	require.NoError(t, form.Close())
+
+	resp, err := http.Post(url+fakeapi.ScanDirectEndpoint, form.FormDataContentType(), &body)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, data
+}
+
+func TestComponentSearch(t *testing.T) {
+	_, url := startServer(t, fakeapi.Options{})
+
+	t.Run("Filters by search term and package", func(t *testing.T) {
+		resp, body := get(t, url+fakeapi.ComponentSearchEndpoint+"?search=scanoss&package=github", nil)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result entities.ComponentSearchResponse
+		require.NoError(t, json.Unmarshal(body, &result))
+		assert.Equal(t, "SUCCESS", result.Status.Status)
+		assert.Len(t, result.Components, 4)
+		for _, c := range result.Components {
+			assert.Contains(t, c.Purl, "pkg:github/scanoss/")
+		}
+	})
+
+	t.Run("Paginates with limit and offset", func(t *testing.T) {
+		_, body := get(t, url+fakeapi.ComponentSearchEndpoint+"?search=scanoss&limit=2&offset=1", nil)
+
+		var result entities.ComponentSearchResponse
+		require.NoError(t, json.Unmarshal(body, &result))
+		require.Len(t, result.Components, 2)
+		assert.Equal(t, "scanoss.py", result.Components[0].Component)
+	})
+
+	t.Run("Requires a search term", func(t *testing.T) {
+		resp, _ := get(t, url+fakeapi.ComponentSearchEndpoint, nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestComponentLicense(t *testing.T) {
+	_, url := startServer(t, fakeapi.Options{})
+
+	resp, body := get(t, url+fakeapi.ComponentLicenseEndpoint+"?purl=pkg:npm/lodash", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var result entities.GetLicensesByPurlResponse
+	require.NoError(t, json.Unmarshal(body, &result))
+	assert.Equal(t, "pkg:npm/lodash", result.Component.Purl)
+	require.Len(t, result.Component.Licenses, 1)
+	assert.Equal(t, "MIT", result.Component.Licenses[0].Id)
+
+	resp, _ = get(t, url+fakeapi.ComponentLicenseEndpoint+"?purl=pkg:npm/unknown", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestFileContents(t *testing.T) {
+	_, url := startServer(t, fakeapi.Options{})
+
+	resp, body := get(t, url+fakeapi.FileContentsEndpoint+helloMD5, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "Hello, SCANOSS!")
+
+	resp, _ = get(t, url+fakeapi.FileContentsEndpoint+"00000000000000000000000000000000", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, _ = get(t, url+fakeapi.FileContentsEndpoint+"../licenses/licenses.json", nil)
+	assert.NotEqual(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestScanDirect(t *testing.T) {
+	_, url := startServer(t, fakeapi.Options{})
+
+	hello, err := fakeapi.Fixture("file_contents/" + helloMD5)
+	require.NoError(t, err)
+	fingerprints := wfp.Fingerprint("src/hello.c", hello) + wfp.Fingerprint("src/other.c", []byte("int other(void) { return 1; }\n"))
+
+	resp, body := postWFP(t, url, fingerprints)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var results map[string][]entities.ComponentDTO
+	require.NoError(t, json.Unmarshal(body, &results))
+	require.Len(t, results, 2)
+	require.Len(t, results["src/hello.c"], 1)
+	assert.Equal(t, "file", results["src/hello.c"][0].ID)
+	assert.Equal(t, []string{"pkg:github/scanoss/hello"}, results["src/hello.c"][0].Purl)
+	assert.Equal(t, "none", results["src/other.c"][0].ID)
+}
+
+func TestFixturesDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "licenses"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "licenses", "licenses.json"), []byte(`{"pkg:npm/custom":{"purl":"pkg:npm/custom","licenses":[{"id":"BSD-3-Clause"}]}}`), 0o644))
+
+	_, url := startServer(t, fakeapi.Options{FixturesDir: dir})
+
+	resp, _ := get(t, url+fakeapi.ComponentLicenseEndpoint+"?purl=pkg:npm/custom", nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, _ = get(t, url+fakeapi.ComponentLicenseEndpoint+"?purl=pkg:npm/lodash", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode, "the fixtures directory replaces the embedded file")
+
+	resp, _ = get(t, url+fakeapi.FileContentsEndpoint+helloMD5, nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "missing fixtures fall back to the embedded ones")
+
+	_, err := fakeapi.New(fakeapi.Options{FixturesDir: filepath.Join(dir, "missing")})
+	assert.Error(t, err)
+}
+
+func TestAPIKey(t *testing.T) {
+	_, url := startServer(t, fakeapi.Options{APIKey: "secret"})
+
+	resp, _ := get(t, url+fakeapi.FileContentsEndpoint+helloMD5, nil)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, _ = get(t, url+fakeapi.FileContentsEndpoint+helloMD5, map[string]string{"x-api-key": "secret"})
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestFaults(t *testing.T) {
+	t.Run("Fails the first requests", func(t *testing.T) {
+		s, url := startServer(t, fakeapi.Options{Faults: fakeapi.Faults{FailFirst: 2, ErrorStatus: http.StatusTooManyRequests}})
+
+		for i := 0; i < 2; i++ {
+			resp, _ := get(t, url+fakeapi.FileContentsEndpoint+helloMD5, nil)
+			assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		}
+		resp, _ := get(t, url+fakeapi.FileContentsEndpoint+helloMD5, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 3, s.Requests(fakeapi.FileContentsEndpoint))
+	})
+
+	t.Run("Fails every request at full error rate", func(t *testing.T) {
+		_, url := startServer(t, fakeapi.Options{Faults: fakeapi.Faults{ErrorRate: 1}})
+
+		resp, _ := get(t, url+fakeapi.ComponentSearchEndpoint+"?search=engine", nil)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	})
+
+	t.Run("Adds latency", func(t *testing.T) {
+		s, url := startServer(t, fakeapi.Options{})
+		require.NoError(t, s.SetFaults(fakeapi.Faults{Latency: 50 * time.Millisecond}))
+
+		start := time.Now()
+		resp, _ := get(t, url+fakeapi.FileContentsEndpoint+helloMD5, nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("Rejects invalid faults", func(t *testing.T) {
+		_, err := fakeapi.New(fakeapi.Options{Faults: fakeapi.Faults{ErrorRate: 1.5}})
+		assert.Error(t, err)
+		_, err = fakeapi.New(fakeapi.Options{Faults: fakeapi.Faults{ErrorStatus: http.StatusOK}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package fetch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// IdempotencyKeyHeader marks a request that is safe to send again although its method is not idempotent.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// Client sends requests to the SCANOSS API, retrying transient failures with backoff and failing fast while
+// the circuit breaker of the host is open. Circuit breakers are shared by every Client of the process.
+type Client struct {
+	client *http.Client
+	policy Policy
+}
+
+func NewClient(client *http.Client, policy Policy) *Client {
+	if client == nil {
+		client = &http.Client{}
+	}
+	return &Client{
+		client: client,
+		policy: policy,
+	}
+}
+
+// Do sends a request like http.Client.Do. Network errors and transient statuses are retried up to MaxRetries
+// times when the request can safely be sent again: idempotent methods, requests with an Idempotency-Key
+// header, and requests rejected with 429 Too Many Requests, which were not processed.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	breaker := breakerFor(req.URL.Host)
+
+	for attempt := 0; ; attempt++ {
+		if err := breaker.allow(c.policy); err != nil {
+			return nil, err
+		}
+
+		attemptReq, err := rewind(req, attempt)
+		if err != nil {
+			breaker.release()
+			return nil, err
+		}
+
+		resp, err := c.client.Do(attemptReq)
+		if req.Context().Err() != nil {
+			breaker.release()
+			return resp, err
+		}
+
+		failed := err != nil || IsRetryableStatus(resp.StatusCode)
+		// Rate limiting and timeouts say nothing about the health of the host, only errors and 5xx open the circuit
+		breaker.record(c.policy, err != nil || resp.StatusCode >= http.StatusInternalServerError)
+		if !failed || attempt >= c.policy.MaxRetries || !canRetry(req, resp) {
+			return resp, err
+		}
+
+		delay, ok := c.policy.RetryDelay(attempt, RetryAfter(resp))
+		if !ok {
+			return resp, err
+		}
+
+		if err != nil {
+			log.Debug().Err(err).Msgf("%s %s failed, retrying in %s (attempt %d of %d)", req.Method, req.URL.Path, delay, attempt+1, c.policy.MaxRetries+1)
+		} else {
+			log.Debug().Msgf("%s %s returned %d, retrying in %s (attempt %d of %d)", req.Method, req.URL.Path, resp.StatusCode, delay, attempt+1, c.policy.MaxRetries+1)
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+			resp.Body.Close()
+		}
+
+		if err := sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func canRetry(req *http.Request, resp *http.Response) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	if req.Header.Get(IdempotencyKeyHeader) != "" {
+		return true
+	}
+	return resp != nil && resp.StatusCode == http.StatusTooManyRequests
+}
+
+// rewind returns the request to send for an attempt, with a fresh body for retries.
+func rewind(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.GetBody == nil {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("error rewinding request body: %w", err)
+	}
+	retry := req.Clone(req.Context())
+	retry.Body = body
+	return retry, nil
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
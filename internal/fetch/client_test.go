@@ -0,0 +1,288 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package fetch_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/scanoss/scanoss.cc/internal/fetch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyServer fails the first requests with the given statuses, then answers 200 with the request body.
+type flakyServer struct {
+	mu         sync.Mutex
+	failures   []int
+	retryAfter string
+	requests   int
+	bodies     []string
+}
+
+func (s *flakyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	s.requests++
+	s.bodies = append(s.bodies, string(body))
+
+	if len(s.failures) > 0 {
+		status := s.failures[0]
+		s.failures = s.failures[1:]
+		if s.retryAfter != "" {
+			w.Header().Set("Retry-After", s.retryAfter)
+		}
+		http.Error(w, "try again", status)
+		return
+	}
+	_, _ = w.Write(body)
+}
+
+func (s *flakyServer) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+var testPolicy = fetch.Policy{
+	MaxRetries:    3,
+	BaseDelay:     time.Millisecond,
+	MaxDelay:      5 * time.Millisecond,
+	MaxRetryAfter: 2 * time.Second,
+}
+
+func TestClient_Retries(t *testing.T) {
+	defer fetch.ResetBreakers()
+
+	t.Run("Retries transient failures of idempotent requests", func(t *testing.T) {
+		flaky := &flakyServer{failures: []int{http.StatusBadGateway, http.StatusServiceUnavailable}}
+		server := httptest.NewServer(flaky)
+		defer server.Close()
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		resp, err := fetch.NewClient(nil, testPolicy).Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 3, flaky.count())
+	})
+
+	t.Run("Returns the last response once retries are exhausted", func(t *testing.T) {
+		flaky := &flakyServer{failures: []int{502, 502, 502, 502, 502}}
+		server := httptest.NewServer(flaky)
+		defer server.Close()
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		resp, err := fetch.NewClient(nil, testPolicy).Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+		assert.Equal(t, 4, flaky.count())
+	})
+
+	t.Run("Does not retry client errors", func(t *testing.T) {
+		flaky := &flakyServer{failures: []int{http.StatusUnauthorized}}
+		server := httptest.NewServer(flaky)
+		defer server.Close()
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		resp, err := fetch.NewClient(nil, testPolicy).Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		assert.Equal(t, 1, flaky.count())
+	})
+
+	t.Run("Only retries a POST with an idempotency key or rejected with 429", func(t *testing.T) {
+		flaky := &flakyServer{failures: []int{http.StatusServiceUnavailable}}
+		server := httptest.NewServer(flaky)
+		defer server.Close()
+		client := fetch.NewClient(nil, testPolicy)
+
+		req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("payload"))
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, 1, flaky.count())
+
+		flaky.failures = []int{http.StatusServiceUnavailable}
+		req, err = http.NewRequest(http.MethodPost, server.URL, strings.NewReader("payload"))
+		require.NoError(t, err)
+		req.Header.Set(fetch.IdempotencyKeyHeader, "batch-1")
+		resp, err = client.Do(req)
+		require.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.Equal(t, "payload", string(body), "the body is sent again")
+		assert.Equal(t, 3, flaky.count())
+
+		flaky.failures = []int{http.StatusTooManyRequests}
+		req, err = http.NewRequest(http.MethodPost, server.URL, strings.NewReader("payload"))
+		require.NoError(t, err)
+		resp, err = client.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, []string{"payload", "payload", "payload", "payload", "payload"}, flaky.bodies)
+	})
+
+	t.Run("Waits for Retry-After", func(t *testing.T) {
+		flaky := &flakyServer{failures: []int{http.StatusTooManyRequests}, retryAfter: "1"}
+		server := httptest.NewServer(flaky)
+		defer server.Close()
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		start := time.Now()
+		resp, err := fetch.NewClient(nil, testPolicy).Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+
+	t.Run("Gives up when Retry-After is too long", func(t *testing.T) {
+		flaky := &flakyServer{failures: []int{http.StatusServiceUnavailable}, retryAfter: "3600"}
+		server := httptest.NewServer(flaky)
+		defer server.Close()
+
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		resp, err := fetch.NewClient(nil, testPolicy).Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, "3600", resp.Header.Get("Retry-After"))
+		assert.Equal(t, 1, flaky.count())
+	})
+
+	t.Run("Stops waiting when the context is canceled", func(t *testing.T) {
+		flaky := &flakyServer{failures: []int{http.StatusServiceUnavailable}, retryAfter: "1"}
+		server := httptest.NewServer(flaky)
+		defer server.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		_, err = fetch.NewClient(nil, testPolicy).Do(req)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 1, flaky.count())
+	})
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	defer fetch.ResetBreakers()
+
+	flaky := &flakyServer{failures: []int{500, 500, 500}}
+	server := httptest.NewServer(flaky)
+	defer server.Close()
+
+	policy := fetch.Policy{BreakerThreshold: 3, BreakerCooldown: 100 * time.Millisecond}
+	get := func(client *fetch.Client) (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return resp, err
+	}
+
+	client := fetch.NewClient(nil, policy)
+	for range 3 {
+		_, err := get(client)
+		require.NoError(t, err)
+	}
+
+	// The circuit is shared by every client of the host
+	_, err := get(fetch.NewClient(nil, policy))
+	assert.ErrorIs(t, err, fetch.ErrCircuitOpen)
+	assert.Equal(t, 3, flaky.count(), "an open circuit sends nothing")
+
+	time.Sleep(150 * time.Millisecond)
+	resp, err := get(client)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "a probe is let through after the cooldown")
+
+	_, err = get(client)
+	assert.NoError(t, err, "a successful probe closes the circuit")
+	assert.Equal(t, 5, flaky.count())
+}
+
+func TestPolicy_RetryDelay(t *testing.T) {
+	policy := fetch.Policy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, MaxRetryAfter: 10 * time.Second}
+
+	for attempt, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		delay, ok := policy.RetryDelay(attempt, 0)
+		assert.True(t, ok)
+		assert.GreaterOrEqual(t, delay, want/2, "attempt %d", attempt)
+		assert.LessOrEqual(t, delay, want, "attempt %d", attempt)
+	}
+
+	delay, ok := policy.RetryDelay(0, 5*time.Second)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, delay, "Retry-After wins over a shorter backoff")
+
+	_, ok = policy.RetryDelay(0, time.Minute)
+	assert.False(t, ok)
+
+	delay, ok = fetch.Policy{}.RetryDelay(3, 0)
+	assert.True(t, ok)
+	assert.Zero(t, delay)
+}
+
+func TestRetryAfter(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	assert.Zero(t, fetch.RetryAfter(resp))
+
+	resp.Header.Set("Retry-After", "120")
+	assert.Equal(t, 2*time.Minute, fetch.RetryAfter(resp))
+
+	resp.Header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.InDelta(t, time.Minute.Seconds(), fetch.RetryAfter(resp).Seconds(), 2)
+
+	resp.Header.Set("Retry-After", "soon")
+	assert.Zero(t, fetch.RetryAfter(resp))
+}
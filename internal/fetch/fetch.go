@@ -53,9 +53,8 @@ func Fetch(url string, options Options) (*http.Response, error) {
 		req.Header.Set(key, value)
 	}
 
-	// Execute the HTTP request
-	client := &http.Client{}
-	return client.Do(req)
+	// Execute the HTTP request, retrying transient failures as set in the config
+	return NewClient(&http.Client{}, PolicyFromConfig()).Do(req)
 }
 
 // FetchText is a convenience function to get the response body as a string.
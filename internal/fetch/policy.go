@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package fetch
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/scanoss/scanoss.cc/internal/config"
+)
+
+// Policy describes how a Client retries failed requests and when the circuit breaker of a host opens.
+// Zero values disable retries, the delay caps and the circuit breaker.
+type Policy struct {
+	MaxRetries       int
+	BaseDelay        time.Duration
+	MaxDelay         time.Duration
+	MaxRetryAfter    time.Duration // Longer Retry-After delays are not waited for, the response is returned
+	BreakerThreshold int           // Consecutive failures opening the circuit of a host
+	BreakerCooldown  time.Duration // How long an open circuit rejects requests before letting one through
+}
+
+// PolicyFromConfig returns the policy set in the "http" section of the config file.
+func PolicyFromConfig() Policy {
+	settings := config.GetInstance().GetHTTPSettings()
+	return Policy{
+		MaxRetries:       settings.MaxRetries,
+		BaseDelay:        time.Duration(settings.RetryBaseDelayMs) * time.Millisecond,
+		MaxDelay:         time.Duration(settings.RetryMaxDelayMs) * time.Millisecond,
+		MaxRetryAfter:    time.Duration(settings.MaxRetryAfterSeconds) * time.Second,
+		BreakerThreshold: settings.BreakerFailureThreshold,
+		BreakerCooldown:  time.Duration(settings.BreakerCooldownSeconds) * time.Second,
+	}
+}
+
+// RetryDelay returns how long to wait before retrying after the given 0-based attempt: an exponential backoff
+// with jitter, or the server's Retry-After when longer. It reports false when Retry-After asks to wait longer
+// than MaxRetryAfter.
+func (p Policy) RetryDelay(attempt int, retryAfter time.Duration) (time.Duration, bool) {
+	if p.MaxRetryAfter > 0 && retryAfter > p.MaxRetryAfter {
+		return 0, false
+	}
+
+	backoff := p.BaseDelay << min(attempt, 30)
+	if backoff <= 0 || (p.MaxDelay > 0 && backoff > p.MaxDelay) {
+		backoff = p.MaxDelay
+	}
+	// Equal jitter: half the backoff plus a random share of the other half, so clients retrying together spread out
+	if backoff > 0 {
+		backoff = backoff/2 + rand.N(backoff/2+1)
+	}
+
+	return max(backoff, retryAfter), true
+}
+
+// RetryAfter parses the Retry-After header of a response, given in seconds or as an HTTP date.
+func RetryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0)
+	}
+	return 0
+}
+
+// IsRetryableStatus reports whether a status code is a transient failure worth retrying.
+func IsRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusInternalServerError,
+		http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
@@ -57,6 +57,22 @@ func InitializeTestEnvironment(t *testing.T) func() {
 	}
 }
 
+// UseAPI points the global API settings at url with token until the test ends, then restores them so
+// later tests don't reach a server that is gone.
+func UseAPI(t *testing.T, url, token string) {
+	t.Helper()
+
+	cfg := config.GetInstance()
+	apiUrl, apiToken := cfg.GetApiUrl(), cfg.GetApiToken()
+	t.Cleanup(func() {
+		_ = cfg.SetApiUrl(apiUrl)
+		_ = cfg.SetApiToken(apiToken)
+	})
+
+	_ = cfg.SetApiUrl(url)
+	_ = cfg.SetApiToken(token)
+}
+
 type MockUtils struct {
 	mock.Mock
 }
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package utils
+
+import "strings"
+
+// RedactedValue replaces credentials in arguments, logs and scanner output.
+const RedactedValue = "****"
+
+// credentialArgs are the command line arguments whose value is a credential.
+var credentialArgs = []string{"--key", "-k", "--apiKey", "--api-key"}
+
+// RedactSecret replaces every occurrence of secret in text. An empty secret leaves the text unchanged.
+func RedactSecret(text, secret string) string {
+	if secret == "" {
+		return text
+	}
+	return strings.ReplaceAll(text, secret, RedactedValue)
+}
+
+// RedactArgs returns a copy of args with the values of credential arguments replaced,
+// whether given as "--key value" or "--key=value".
+func RedactArgs(args []string) []string {
+	redacted := make([]string, 0, len(args))
+	hideNext := false
+	for _, arg := range args {
+		if hideNext {
+			redacted = append(redacted, RedactedValue)
+			hideNext = false
+			continue
+		}
+
+		name, _, hasValue := strings.Cut(arg, "=")
+		isCredential := false
+		for _, credentialArg := range credentialArgs {
+			if name == credentialArg {
+				isCredential = true
+				break
+			}
+		}
+
+		switch {
+		case isCredential && hasValue:
+			redacted = append(redacted, name+"="+RedactedValue)
+		case isCredential:
+			redacted = append(redacted, arg)
+			hideNext = true
+		default:
+			redacted = append(redacted, arg)
+		}
+	}
+	return redacted
+}
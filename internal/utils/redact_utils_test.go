@@ -0,0 +1,75 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/scanoss/scanoss.cc/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactSecret(t *testing.T) {
+	assert.Equal(t, "using key ****, retrying with ****", utils.RedactSecret("using key abc123, retrying with abc123", "abc123"))
+	assert.Equal(t, "nothing to hide", utils.RedactSecret("nothing to hide", ""))
+}
+
+func TestRedactArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "separate value",
+			args: []string{"scan", "--key", "secret", "src"},
+			want: []string{"scan", "--key", "****", "src"},
+		},
+		{
+			name: "inline value",
+			args: []string{"--apiKey=secret", "--threads=4"},
+			want: []string{"--apiKey=****", "--threads=4"},
+		},
+		{
+			name: "shorthand",
+			args: []string{"-k", "secret"},
+			want: []string{"-k", "****"},
+		},
+		{
+			name: "no credentials",
+			args: []string{"--apiurl", "https://api.example.com"},
+			want: []string{"--apiurl", "https://api.example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := append([]string{}, tt.args...)
+			assert.Equal(t, tt.want, utils.RedactArgs(args))
+			assert.Equal(t, tt.args, args)
+		})
+	}
+}
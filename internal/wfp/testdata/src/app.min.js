@@ -0,0 +1,16 @@
+function checksum_block(buffer, length)
+{
+    unsigned int total = 0;
+    for (size_t index = 0; index < length; index++) {
+        total = (total << 5) + total + buffer[index];
+    }
+    return (int)(total & 0x7fffffff);
+}
+function checksum_block(buffer, length)
+{
+    unsigned int total = 0;
+    for (size_t index = 0; index < length; index++) {
+        total = (total << 5) + total + buffer[index];
+    }
+    return (int)(total & 0x7fffffff);
+}
@@ -0,0 +1,9 @@
+var modules = ["module_0_compiled_output","module_1_compiled_output","module_2_compiled_output","module_3_compiled_output","module_4_compiled_output","module_5_compiled_output","module_6_compiled_output","module_7_compiled_output","module_8_compiled_output","module_9_compiled_output","module_10_compiled_output","module_11_compiled_output","module_12_compiled_output","module_13_compiled_output","module_14_compiled_output","module_15_compiled_output","module_16_compiled_output","module_17_compiled_output","module_18_compiled_output","module_19_compiled_output","module_20_compiled_output","module_21_compiled_output","module_22_compiled_output","module_23_compiled_output","module_24_compiled_output","module_25_compiled_output","module_26_compiled_output","module_27_compiled_output","module_28_compiled_output","module_29_compiled_output","module_30_compiled_output","module_31_compiled_output","module_32_compiled_output","module_33_compiled_output","module_34_compiled_output","module_35_compiled_output","module_36_compiled_output","module_37_compiled_output","module_38_compiled_output","module_39_compiled_output"];
+static int checksum_block(const unsigned char *buffer, size_t length)
+{
+    unsigned int total = 0;
+    for (size_t index = 0; index < length; index++) {
+        total = (total << 5) + total + buffer[index];
+    }
+    return (int)(total & 0x7fffffff);
+}
@@ -0,0 +1,17 @@
+// Übersetzungen für die Benutzeroberfläche – keine Änderungen ohne Rücksprache
+export const messages = {
+  greeting: 'Grüß Gott, willkommen zurück!',
+  farewell: 'Auf Wiedersehen, bis zum nächsten Mal.',
+  error: 'Ein unerwarteter Fehler ist aufgetreten. Bitte versuchen Sie es später erneut.',
+  retry: 'Erneut versuchen',
+  cancel: 'Abbrechen',
+};
+
+export function translate(key, fallback = '…') {
+  const message = messages[key];
+  if (message === undefined) {
+    console.warn(`Fehlende Übersetzung für „${key}“`);
+    return fallback;
+  }
+  return message;
+}
@@ -0,0 +1,10 @@
+	<HTML>
+<body>
+<?php echo render_widget("widget_0", $context, $options); ?>
+<?php echo render_widget("widget_1", $context, $options); ?>
+<?php echo render_widget("widget_2", $context, $options); ?>
+<?php echo render_widget("widget_3", $context, $options); ?>
+<?php echo render_widget("widget_4", $context, $options); ?>
+<?php echo render_widget("widget_5", $context, $options); ?>
+</body>
+</HTML>
@@ -0,0 +1,49 @@
+/* Ring buffer used by the packet scheduler */
+#include <stdint.h>
+#include <string.h>
+
+#define RING_SIZE 256
+
+typedef struct {
+    uint8_t data[RING_SIZE];
+    uint16_t head;
+    uint16_t tail;
+    uint16_t count;
+} ring_t;
+
+void ring_init(ring_t *ring)
+{
+    memset(ring, 0, sizeof(*ring));
+}
+
+int ring_push(ring_t *ring, uint8_t value)
+{
+    if (ring->count == RING_SIZE) {
+        return -1;
+    }
+    ring->data[ring->head] = value;
+    ring->head = (ring->head + 1) % RING_SIZE;
+    ring->count++;
+    return 0;
+}
+
+int ring_pop(ring_t *ring, uint8_t *value)
+{
+    if (ring->count == 0) {
+        return -1;
+    }
+    *value = ring->data[ring->tail];
+    ring->tail = (ring->tail + 1) % RING_SIZE;
+    ring->count--;
+    return 0;
+}
+
+uint16_t ring_checksum(const ring_t *ring)
+{
+    uint16_t sum = 0;
+    for (uint16_t i = 0; i < ring->count; i++) {
+        sum = (uint16_t)((sum << 1) | (sum >> 15));
+        sum ^= ring->data[(ring->tail + i) % RING_SIZE];
+    }
+    return sum;
+}
@@ -0,0 +1,9 @@
+
+  [
+    { path: "/section/0", component: "SectionPage0", exact: true },
+    { path: "/section/1", component: "SectionPage1", exact: true },
+    { path: "/section/2", component: "SectionPage2", exact: true },
+    { path: "/section/3", component: "SectionPage3", exact: true },
+    { path: "/section/4", component: "SectionPage4", exact: true },
+    { path: "/section/5", component: "SectionPage5", exact: true }
+  ]
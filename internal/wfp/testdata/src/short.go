@@ -0,0 +1,3 @@
+package short
+
+func One() int { return 1 }
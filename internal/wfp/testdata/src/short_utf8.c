@@ -0,0 +1,4 @@
+/* Prüfsumme über die Einträge – für Größenänderungen. Übergänge größer, Äöü ßßß éèê ñ ç */
+int sum(int a, int b) { return a + b; }
+int diff(int a, int b) { return a - b; }
+/* ééééééééééééééééééééééééééééééééééééééééééééééééééééééééééééchecksum10 */
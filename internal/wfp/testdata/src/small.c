@@ -0,0 +1,3 @@
+int add(int left, int right) { return left + right; }
+int sub(int left, int right) { return left - right; }
+int mul(int left, int right) { return left * right; }
@@ -0,0 +1,17 @@
+#!/bin/sh
+# Regenerates the golden WFP files from the fixture sources with scanoss-py, the reference the native
+# fingerprinting must match byte for byte, and records the scanoss-py version in README.md. Run from any folder
+# with scanoss-py on the PATH.
+set -e
+
+cd "$(dirname "$0")"
+version=$(scanoss-py version 2>&1 | head -n 1)
+
+cd src
+for file in *; do
+  scanoss-py fingerprint -o "../golden/$file.wfp" "$file"
+done
+cd ..
+
+sed -i.bak "s|^Generated with: .*|Generated with: $version|" README.md
+rm -f README.md.bak
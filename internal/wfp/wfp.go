@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+// Package wfp generates SCANOSS winnowing fingerprints (WFP) for files, in the same format as scanoss-py.
+package wfp
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	// gramSize is the number of normalised characters hashed together
+	gramSize = 30
+	// windowSize is the number of consecutive gram hashes a snippet fingerprint is selected from
+	windowSize = 64
+	// maxLongLineChars skips snippets for minified or generated files whose first line is longer than this
+	maxLongLineChars = 1000
+	// minFileSize skips snippets for files of this many characters or fewer
+	minFileSize = 256
+	// binaryProbeSize is how many leading bytes are checked for a NUL byte to detect binary files
+	binaryProbeSize = 8000
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// skipSnippetExtensions are endings of files fingerprinted as a whole file only, as listed by scanoss-py.
+var skipSnippetExtensions = []string{
+	".exe", ".zip", ".tar", ".tgz", ".gz", ".7z", ".rar", ".jar", ".war", ".ear", ".class", ".pyc",
+	".o", ".a", ".so", ".obj", ".dll", ".lib", ".out", ".app", ".bin",
+	".lst", ".dat", ".json", ".htm", ".html", ".xml", ".md", ".txt",
+	".doc", ".docx", ".xls", ".xlsx", ".ppt", ".pptx", ".odt", ".ods", ".odp", ".pages", ".key", ".numbers",
+	".pdf", ".min.js", ".mf", ".sum", ".woff", ".woff2", ".xsd", ".pom", ".whl",
+}
+
+// skipSnippetPrefixes are starts of XML, HTML and AC3D files, whose snippets are skipped whatever their name.
+var skipSnippetPrefixes = []string{"<?xml", "<html", "<ac3d", "<!doc"}
+
+// Fingerprint returns the WFP block of a file: a "file=" line with the MD5, size and path, followed by
+// "line=hash,hash" snippet lines. path is written as given and should be relative to the scan root.
+func Fingerprint(path string, contents []byte) string {
+	var wfp strings.Builder
+	fmt.Fprintf(&wfp, "file=%x,%d,%s\n", md5.Sum(contents), len(contents), path)
+
+	if skipSnippets(path, contents) {
+		return wfp.String()
+	}
+
+	var (
+		gram     []byte
+		window   []uint32
+		line     = 1
+		lastHash = uint32(0xffffffff)
+		lastLine = 0
+		output   strings.Builder
+		crcBytes = make([]byte, 4)
+	)
+
+	for _, b := range contents {
+		if b == '\n' {
+			line++
+			continue
+		}
+
+		normalized := normalize(b)
+		if normalized == 0 {
+			continue
+		}
+
+		gram = append(gram, normalized)
+		if len(gram) < gramSize {
+			continue
+		}
+
+		window = append(window, crc32.Checksum(gram, castagnoli))
+		if len(window) >= windowSize {
+			minHash := window[0]
+			for _, h := range window[1:] {
+				minHash = min(minHash, h)
+			}
+
+			if minHash != lastHash {
+				binary.LittleEndian.PutUint32(crcBytes, minHash)
+				hash := fmt.Sprintf("%08x", crc32.Checksum(crcBytes, castagnoli))
+
+				if lastLine != line {
+					if output.Len() > 0 {
+						wfp.WriteString(output.String())
+						wfp.WriteByte('\n')
+						output.Reset()
+					}
+					fmt.Fprintf(&output, "%d=%s", line, hash)
+				} else {
+					output.WriteString("," + hash)
+				}
+
+				lastLine = line
+				lastHash = minHash
+			}
+			window = window[1:]
+		}
+		gram = gram[1:]
+	}
+
+	if output.Len() > 0 {
+		wfp.WriteString(output.String())
+		wfp.WriteByte('\n')
+	}
+
+	return wfp.String()
+}
+
+// normalize keeps digits and lowercase letters, lowercases ASCII uppercase letters and drops everything else.
+func normalize(b byte) byte {
+	switch {
+	case b >= '0' && b <= '9', b >= 'a' && b <= 'z':
+		return b
+	case b >= 'A' && b <= 'Z':
+		return b + 32
+	default:
+		return 0
+	}
+}
+
+// IsBinary reports whether the contents look like a binary file.
+func IsBinary(contents []byte) bool {
+	return bytes.IndexByte(contents[:min(len(contents), binaryProbeSize)], 0) != -1
+}
+
+// skipSnippets follows scanoss-py, which checks the name and then the contents decoded as UTF-8 with invalid
+// bytes dropped, so sizes and line lengths are counted in characters.
+func skipSnippets(path string, contents []byte) bool {
+	if IsBinary(contents) {
+		return true
+	}
+
+	lower := strings.ToLower(path)
+	for _, ext := range skipSnippetExtensions {
+		if strings.HasSuffix(lower, ext) {
+			return true
+		}
+	}
+
+	src := []rune(strings.ToValidUTF8(string(contents), ""))
+	if len(src) <= minFileSize {
+		return true
+	}
+
+	prefix := strings.TrimFunc(strings.ToLower(string(src[:minFileSize-1])), isPythonSpace)
+	if strings.HasPrefix(prefix, "{") || strings.HasPrefix(prefix, "[") {
+		return true
+	}
+	for _, p := range skipSnippetPrefixes {
+		if strings.HasPrefix(prefix, p) {
+			return true
+		}
+	}
+
+	firstLine := src
+	if i := slices.Index(src, '\n'); i != -1 {
+		firstLine = src[:i]
+	}
+	return len(firstLine) > maxLongLineChars
+}
+
+// isPythonSpace reports the characters Python's str.strip removes, which include the ASCII separators
+// unicode.IsSpace leaves.
+func isPythonSpace(r rune) bool {
+	return unicode.IsSpace(r) || (r >= 0x1c && r <= 0x1f)
+}
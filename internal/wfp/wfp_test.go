@@ -0,0 +1,142 @@
+//go:build unit
+
+// SPDX-License-Identifier: MIT
+/*
+ * Copyright (C) 2018-2026 SCANOSS.COM
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package wfp
+
+import (
+	"crypto/md5"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sampleSource(lines int) []byte {
+	var source strings.Builder
+	for i := range lines {
+		fmt.Fprintf(&source, "int value_%d = compute_checksum(buffer_%d, length_%d);\n", i, i*7, i*13)
+	}
+	return []byte(source.String())
+}
+
+func TestFingerprint(t *testing.T) {
+	contents := sampleSource(40)
+
+	fingerprint := Fingerprint("src/main.c", contents)
+	lines := strings.Split(strings.TrimSuffix(fingerprint, "\n"), "\n")
+
+	assert.Equal(t, fmt.Sprintf("file=%x,%d,src/main.c", md5.Sum(contents), len(contents)), lines[0])
+	require.Greater(t, len(lines), 1, "expected snippet fingerprints")
+
+	snippetLine := regexp.MustCompile(`^\d+=[0-9a-f]{8}(,[0-9a-f]{8})*$`)
+	previousLine := 0
+	for _, line := range lines[1:] {
+		require.Regexp(t, snippetLine, line)
+
+		var lineNumber int
+		_, err := fmt.Sscanf(line, "%d=", &lineNumber)
+		require.NoError(t, err)
+		assert.Greater(t, lineNumber, previousLine, "snippet lines must be in ascending order")
+		assert.LessOrEqual(t, lineNumber, 40)
+		previousLine = lineNumber
+	}
+
+	assert.Equal(t, fingerprint, Fingerprint("src/main.c", contents), "fingerprints must be deterministic")
+}
+
+func TestFingerprint_Normalization(t *testing.T) {
+	lower := sampleSource(20)
+	upper := []byte(strings.ToUpper(string(lower)))
+	spaced := []byte(strings.ReplaceAll(string(lower), " ", "\t "))
+
+	snippets := func(contents []byte) string {
+		_, rest, _ := strings.Cut(Fingerprint("a.c", contents), "\n")
+		return rest
+	}
+
+	assert.Equal(t, snippets(lower), snippets(upper))
+	assert.Equal(t, snippets(lower), snippets(spaced))
+}
+
+func TestFingerprint_FileOnly(t *testing.T) {
+	tests := map[string]struct {
+		path     string
+		contents []byte
+	}{
+		"binary file":    {"lib/blob.so", append(sampleSource(40), 0, 1, 2)},
+		"skipped ext":    {"data/config.json", sampleSource(40)},
+		"minified line":  {"dist/app.js", []byte(strings.Repeat("a=b;", 300) + "\n")},
+		"too short file": {"src/short.c", []byte("int main() { return 0; }\n")},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fingerprint := Fingerprint(tt.path, tt.contents)
+			assert.Equal(t, fmt.Sprintf("file=%x,%d,%s\n", md5.Sum(tt.contents), len(tt.contents), tt.path), fingerprint)
+		})
+	}
+}
+
+func TestFingerprint_Snippets(t *testing.T) {
+	tests := map[string]struct {
+		path     string
+		contents []byte
+	}{
+		"yaml file":             {"config/app.yml", sampleSource(40)},
+		"json after the prefix": {"src/main.c", append(sampleSource(10), []byte("{\"a\": 1}\n")...)},
+		"json in a comment":     {"src/main.c", append([]byte("// {\"a\": 1}\n"), sampleSource(10)...)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, snippets, _ := strings.Cut(Fingerprint(tt.path, tt.contents), "\n")
+			assert.NotEmpty(t, snippets)
+		})
+	}
+}
+
+// TestFingerprint_Golden compares the fingerprints of the fixtures in testdata/src with the output of
+// scanoss-py in testdata/golden. Run testdata/update-golden.sh to regenerate them, see testdata/README.md.
+func TestFingerprint_Golden(t *testing.T) {
+	sources, err := os.ReadDir(filepath.Join("testdata", "src"))
+	require.NoError(t, err)
+	require.NotEmpty(t, sources)
+
+	for _, source := range sources {
+		t.Run(source.Name(), func(t *testing.T) {
+			contents, err := os.ReadFile(filepath.Join("testdata", "src", source.Name()))
+			require.NoError(t, err)
+			golden, err := os.ReadFile(filepath.Join("testdata", "golden", source.Name()+".wfp"))
+			require.NoError(t, err)
+
+			assert.Equal(t, string(golden), Fingerprint(source.Name(), contents))
+		})
+	}
+}
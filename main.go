@@ -38,6 +38,7 @@ import (
 	"github.com/scanoss/scanoss.cc/backend/repository"
 	"github.com/scanoss/scanoss.cc/backend/service"
 	"github.com/scanoss/scanoss.cc/cmd"
+	"github.com/scanoss/scanoss.cc/internal/archive"
 	"github.com/scanoss/scanoss.cc/internal/utils"
 
 	"github.com/wailsapp/wails/v2"
@@ -75,20 +76,29 @@ func run() error {
 	// Repositories
 	scanossSettingsRepository := repository.NewScanossSettingsJsonRepository(fr)
 	scanossSettingsRepository.Init()
-	resultRepository, err := repository.NewResultRepositoryJsonImpl(fr)
+	resultRepository, err := repository.NewResultRepository(fr)
 	if err != nil {
 		return fmt.Errorf("error initializing results repository")
 	}
 	componentRepository := repository.NewJSONComponentRepository(fr, resultRepository)
-	fileRepository := repository.NewFileRepositoryImpl()
+	scanGitSourceRepository := repository.NewScanGitSourceRepositoryJsonImpl(fr)
+	fileRepository := repository.NewFileRepositoryImpl(scanGitSourceRepository)
 	licenseRepository := repository.NewLicenseJsonRepository(fr)
+	dependencyRepository := repository.NewDependencyRepositoryJsonImpl(resultRepository)
+	resultViewRepository := repository.NewResultViewRepositoryJsonImpl(fr)
+	scanHistoryRepository := repository.NewScanHistoryRepositoryJsonImpl(fr)
+	scanPresetRepository := repository.NewScanPresetRepositoryJsonImpl(fr)
+	postScanHookRepository := repository.NewPostScanHookRepositoryJsonImpl(fr)
+	apiCacheRepository := repository.NewAPICacheRepositoryFileImpl(fr)
 
 	// Mappers
 	resultMapper := mappers.NewResultMapper(entities.ScanossSettingsJson)
 	componentMapper := mappers.NewComponentMapper()
+	dependencyMapper := mappers.NewDependencyMapper(entities.ScanossSettingsJson)
 
 	// Services
-	scanossApiService, err := service.NewScanossApiServiceHttpImpl()
+	apiCacheService := service.NewAPICacheServiceImpl(apiCacheRepository)
+	scanossApiService, err := service.NewScanossApiServiceHttpImpl(apiCacheService)
 	if err != nil {
 		return fmt.Errorf("error initializing scanoss api service: %v", err)
 	}
@@ -98,8 +108,19 @@ func run() error {
 	resultService := service.NewResultServiceImpl(resultRepository, resultMapper)
 	scanossSettingsService := service.NewScanossSettingsServiceImpl(scanossSettingsRepository)
 	licenseService := service.NewLicenseServiceImpl(licenseRepository, scanossApiService)
-	scanService := service.NewScanServicePythonImpl()
+	scanHistoryService := service.NewScanHistoryServiceImpl(scanHistoryRepository, resultRepository)
+	cryptographyService := service.NewCryptographyServiceImpl(resultRepository)
+	postScanHookService := service.NewPostScanHookServiceImpl(postScanHookRepository, resultRepository, componentService, scanossSettingsService, resultService, cryptographyService)
+	scanService := service.NewScanServicePythonImpl(scanHistoryService, postScanHookService)
+	nativeScanService := service.NewScanServiceNativeImpl(scanossSettingsRepository, scanHistoryService, postScanHookService)
 	treeService := service.NewTreeServiceImpl(resultService, scanossSettingsRepository)
+	dependencyService := service.NewDependencyServiceImpl(dependencyRepository, componentService, dependencyMapper)
+	resultViewService := service.NewResultViewServiceImpl(resultViewRepository)
+	scanPresetService := service.NewScanPresetServiceImpl(scanPresetRepository)
+
+	if _, err := resultViewService.GetStartupView(); err != nil {
+		return fmt.Errorf("error loading view: %v", err)
+	}
 
 	// Create application with options
 	err = wails.Run(&options.App{
@@ -111,12 +132,17 @@ func run() error {
 		OnStartup: func(ctx context.Context) {
 			app.Init(ctx, scanossSettingsService, keyboardService)
 			scanService.SetContext(ctx)
+			nativeScanService.SetContext(ctx)
+			postScanHookService.SetContext(ctx)
 			resultService.SetContext(ctx)
 			scanossApiService.SetContext(ctx)
 		},
 		OnBeforeClose: func(ctx context.Context) (prevent bool) {
 			return app.BeforeClose(ctx)
 		},
+		OnShutdown: func(ctx context.Context) {
+			archive.Cleanup()
+		},
 		Bind: []any{
 			app,
 			componentService,
@@ -126,10 +152,18 @@ func run() error {
 			scanossSettingsService,
 			licenseService,
 			scanService,
+			nativeScanService,
 			treeService,
+			dependencyService,
+			cryptographyService,
+			resultViewService,
+			scanHistoryService,
+			scanPresetService,
+			postScanHookService,
 		},
 		EnumBind: []any{
 			entities.AllShortcutActions,
+			entities.AllScanPresetScopes,
 		},
 		Linux: &linux.Options{
 			Icon:        icon,
@@ -142,7 +176,7 @@ func run() error {
 				Icon:    icon,
 			},
 		},
-		Menu: app.BuildMenu(keyboardService),
+		Menu: app.BuildMenu(keyboardService, cryptographyService),
 	})
 	if err != nil {
 		return fmt.Errorf("error: %v", err)